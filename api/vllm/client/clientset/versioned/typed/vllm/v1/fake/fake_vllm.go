@@ -0,0 +1,37 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	vllmv1 "connect-go/api/vllm/client/clientset/versioned/typed/vllm/v1"
+	v1 "connect-go/api/vllm/v1"
+
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVLLMs implements VLLMInterface
+type fakeVLLMs struct {
+	*gentype.FakeClientWithList[*v1.VLLM, *v1.VLLMList]
+	Fake *FakeVllmV1
+}
+
+func newFakeVLLMs(fake *FakeVllmV1, namespace string) vllmv1.VLLMInterface {
+	return &fakeVLLMs{
+		gentype.NewFakeClientWithList[*v1.VLLM, *v1.VLLMList](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("vllms"),
+			v1.SchemeGroupVersion.WithKind("VLLM"),
+			func() *v1.VLLM { return &v1.VLLM{} },
+			func() *v1.VLLMList { return &v1.VLLMList{} },
+			func(dst, src *v1.VLLMList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.VLLMList) []*v1.VLLM { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.VLLMList, items []*v1.VLLM) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	scheme "connect-go/api/vllm/client/clientset/versioned/scheme"
+	vllmv1 "connect-go/api/vllm/v1"
+	context "context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VLLMsGetter has a method to return a VLLMInterface.
+// A group's client should implement this interface.
+type VLLMsGetter interface {
+	VLLMs(namespace string) VLLMInterface
+}
+
+// VLLMInterface has methods to work with VLLM resources.
+type VLLMInterface interface {
+	Create(ctx context.Context, vLLM *vllmv1.VLLM, opts metav1.CreateOptions) (*vllmv1.VLLM, error)
+	Update(ctx context.Context, vLLM *vllmv1.VLLM, opts metav1.UpdateOptions) (*vllmv1.VLLM, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, vLLM *vllmv1.VLLM, opts metav1.UpdateOptions) (*vllmv1.VLLM, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*vllmv1.VLLM, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*vllmv1.VLLMList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *vllmv1.VLLM, err error)
+	VLLMExpansion
+}
+
+// vLLMs implements VLLMInterface
+type vLLMs struct {
+	*gentype.ClientWithList[*vllmv1.VLLM, *vllmv1.VLLMList]
+}
+
+// newVLLMs returns a VLLMs
+func newVLLMs(c *VllmV1Client, namespace string) *vLLMs {
+	return &vLLMs{
+		gentype.NewClientWithList[*vllmv1.VLLM, *vllmv1.VLLMList](
+			"vllms",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *vllmv1.VLLM { return &vllmv1.VLLM{} },
+			func() *vllmv1.VLLMList { return &vllmv1.VLLMList{} },
+		),
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	vllmv2 "connect-go/api/vllm/client/clientset/versioned/typed/vllm/v2"
+	v2 "connect-go/api/vllm/v2"
+
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVLLMs implements VLLMInterface
+type fakeVLLMs struct {
+	*gentype.FakeClientWithList[*v2.VLLM, *v2.VLLMList]
+	Fake *FakeVllmV2
+}
+
+func newFakeVLLMs(fake *FakeVllmV2, namespace string) vllmv2.VLLMInterface {
+	return &fakeVLLMs{
+		gentype.NewFakeClientWithList[*v2.VLLM, *v2.VLLMList](
+			fake.Fake,
+			namespace,
+			v2.SchemeGroupVersion.WithResource("vllms"),
+			v2.SchemeGroupVersion.WithKind("VLLM"),
+			func() *v2.VLLM { return &v2.VLLM{} },
+			func() *v2.VLLMList { return &v2.VLLMList{} },
+			func(dst, src *v2.VLLMList) { dst.ListMeta = src.ListMeta },
+			func(list *v2.VLLMList) []*v2.VLLM { return gentype.ToPointerSlice(list.Items) },
+			func(list *v2.VLLMList, items []*v2.VLLM) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
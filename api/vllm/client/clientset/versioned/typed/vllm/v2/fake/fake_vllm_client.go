@@ -0,0 +1,29 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v2 "connect-go/api/vllm/client/clientset/versioned/typed/vllm/v2"
+
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeVllmV2 struct {
+	*testing.Fake
+}
+
+func (c *FakeVllmV2) VLLMs(namespace string) v2.VLLMInterface {
+	return newFakeVLLMs(c, namespace)
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeVllmV2) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,58 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	scheme "connect-go/api/vllm/client/clientset/versioned/scheme"
+	vllmv2 "connect-go/api/vllm/v2"
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VLLMsGetter has a method to return a VLLMInterface.
+// A group's client should implement this interface.
+type VLLMsGetter interface {
+	VLLMs(namespace string) VLLMInterface
+}
+
+// VLLMInterface has methods to work with VLLM resources.
+type VLLMInterface interface {
+	Create(ctx context.Context, vLLM *vllmv2.VLLM, opts v1.CreateOptions) (*vllmv2.VLLM, error)
+	Update(ctx context.Context, vLLM *vllmv2.VLLM, opts v1.UpdateOptions) (*vllmv2.VLLM, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, vLLM *vllmv2.VLLM, opts v1.UpdateOptions) (*vllmv2.VLLM, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*vllmv2.VLLM, error)
+	List(ctx context.Context, opts v1.ListOptions) (*vllmv2.VLLMList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *vllmv2.VLLM, err error)
+	VLLMExpansion
+}
+
+// vLLMs implements VLLMInterface
+type vLLMs struct {
+	*gentype.ClientWithList[*vllmv2.VLLM, *vllmv2.VLLMList]
+}
+
+// newVLLMs returns a VLLMs
+func newVLLMs(c *VllmV2Client, namespace string) *vLLMs {
+	return &vLLMs{
+		gentype.NewClientWithList[*vllmv2.VLLM, *vllmv2.VLLMList](
+			"vllms",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *vllmv2.VLLM { return &vllmv2.VLLM{} },
+			func() *vllmv2.VLLMList { return &vllmv2.VLLMList{} },
+		),
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	scheme "connect-go/api/vllm/client/clientset/versioned/scheme"
+	vllmv2 "connect-go/api/vllm/v2"
+	http "net/http"
+
+	rest "k8s.io/client-go/rest"
+)
+
+type VllmV2Interface interface {
+	RESTClient() rest.Interface
+	VLLMsGetter
+}
+
+// VllmV2Client is used to interact with features provided by the vllm.ai group.
+type VllmV2Client struct {
+	restClient rest.Interface
+}
+
+func (c *VllmV2Client) VLLMs(namespace string) VLLMInterface {
+	return newVLLMs(c, namespace)
+}
+
+// NewForConfig creates a new VllmV2Client for the given config.
+// NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewForConfig(c *rest.Config) (*VllmV2Client, error) {
+	config := *c
+	setConfigDefaults(&config)
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewForConfigAndClient(&config, httpClient)
+}
+
+// NewForConfigAndClient creates a new VllmV2Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewForConfigAndClient(c *rest.Config, h *http.Client) (*VllmV2Client, error) {
+	config := *c
+	setConfigDefaults(&config)
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &VllmV2Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new VllmV2Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *VllmV2Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new VllmV2Client for the given RESTClient.
+func New(c rest.Interface) *VllmV2Client {
+	return &VllmV2Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) {
+	gv := vllmv2.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = rest.CodecFactoryForGeneratedClient(scheme.Scheme, scheme.Codecs).WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *VllmV2Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
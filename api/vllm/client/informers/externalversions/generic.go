@@ -0,0 +1,55 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package externalversions
+
+import (
+	v1 "connect-go/api/vllm/v1"
+	v2 "connect-go/api/vllm/v2"
+	fmt "fmt"
+
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// GenericInformer is type of SharedIndexInformer which will locate and delegate to other
+// sharedInformers based on type
+type GenericInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() cache.GenericLister
+}
+
+type genericInformer struct {
+	informer cache.SharedIndexInformer
+	resource schema.GroupResource
+}
+
+// Informer returns the SharedIndexInformer.
+func (f *genericInformer) Informer() cache.SharedIndexInformer {
+	return f.informer
+}
+
+// Lister returns the GenericLister.
+func (f *genericInformer) Lister() cache.GenericLister {
+	return cache.NewGenericLister(f.Informer().GetIndexer(), f.resource)
+}
+
+// ForResource gives generic access to a shared informer of the matching type
+// TODO extend this to unknown resources with a client pool
+func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
+	switch resource {
+	// Group=vllm.ai, Version=v1
+	case v1.SchemeGroupVersion.WithResource("vllms"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Vllm().V1().VLLMs().Informer()}, nil
+
+		// Group=vllm.ai, Version=v2
+	case v2.SchemeGroupVersion.WithResource("vllms"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Vllm().V2().VLLMs().Informer()}, nil
+
+	}
+
+	return nil, fmt.Errorf("no informer found for %v", resource)
+}
@@ -0,0 +1,42 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package vllm
+
+import (
+	internalinterfaces "connect-go/api/vllm/client/informers/externalversions/internalinterfaces"
+	v1 "connect-go/api/vllm/client/informers/externalversions/vllm/v1"
+	v2 "connect-go/api/vllm/client/informers/externalversions/vllm/v2"
+)
+
+// Interface provides access to each of this group's versions.
+type Interface interface {
+	// V1 provides access to shared informers for resources in V1.
+	V1() v1.Interface
+	// V2 provides access to shared informers for resources in V2.
+	V2() v2.Interface
+}
+
+type group struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &group{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// V1 returns a new v1.Interface.
+func (g *group) V1() v1.Interface {
+	return v1.New(g.factory, g.namespace, g.tweakListOptions)
+}
+
+// V2 returns a new v2.Interface.
+func (g *group) V2() v2.Interface {
+	return v2.New(g.factory, g.namespace, g.tweakListOptions)
+}
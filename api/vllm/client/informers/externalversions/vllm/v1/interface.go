@@ -0,0 +1,33 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	internalinterfaces "connect-go/api/vllm/client/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// VLLMs returns a VLLMInformer.
+	VLLMs() VLLMInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// VLLMs returns a VLLMInformer.
+func (v *version) VLLMs() VLLMInformer {
+	return &vLLMInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
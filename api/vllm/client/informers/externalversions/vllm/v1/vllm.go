@@ -0,0 +1,90 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	versioned "connect-go/api/vllm/client/clientset/versioned"
+	internalinterfaces "connect-go/api/vllm/client/informers/externalversions/internalinterfaces"
+	vllmv1 "connect-go/api/vllm/client/listers/vllm/v1"
+	apivllmv1 "connect-go/api/vllm/v1"
+	context "context"
+	time "time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VLLMInformer provides access to a shared informer and lister for
+// VLLMs.
+type VLLMInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() vllmv1.VLLMLister
+}
+
+type vLLMInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewVLLMInformer constructs a new informer for VLLM type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewVLLMInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredVLLMInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredVLLMInformer constructs a new informer for VLLM type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredVLLMInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VllmV1().VLLMs(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VllmV1().VLLMs(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VllmV1().VLLMs(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VllmV1().VLLMs(namespace).Watch(ctx, options)
+			},
+		},
+		&apivllmv1.VLLM{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *vLLMInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredVLLMInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *vLLMInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apivllmv1.VLLM{}, f.defaultInformer)
+}
+
+func (f *vLLMInformer) Lister() vllmv1.VLLMLister {
+	return vllmv1.NewVLLMLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,90 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	versioned "connect-go/api/vllm/client/clientset/versioned"
+	internalinterfaces "connect-go/api/vllm/client/informers/externalversions/internalinterfaces"
+	vllmv2 "connect-go/api/vllm/client/listers/vllm/v2"
+	apivllmv2 "connect-go/api/vllm/v2"
+	context "context"
+	time "time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VLLMInformer provides access to a shared informer and lister for
+// VLLMs.
+type VLLMInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() vllmv2.VLLMLister
+}
+
+type vLLMInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewVLLMInformer constructs a new informer for VLLM type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewVLLMInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredVLLMInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredVLLMInformer constructs a new informer for VLLM type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredVLLMInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VllmV2().VLLMs(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VllmV2().VLLMs(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VllmV2().VLLMs(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.VllmV2().VLLMs(namespace).Watch(ctx, options)
+			},
+		},
+		&apivllmv2.VLLM{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *vLLMInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredVLLMInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *vLLMInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apivllmv2.VLLM{}, f.defaultInformer)
+}
+
+func (f *vLLMInformer) Lister() vllmv2.VLLMLister {
+	return vllmv2.NewVLLMLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,15 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+// VLLMListerExpansion allows custom methods to be added to
+// VLLMLister.
+type VLLMListerExpansion interface{}
+
+// VLLMNamespaceListerExpansion allows custom methods to be added to
+// VLLMNamespaceLister.
+type VLLMNamespaceListerExpansion interface{}
@@ -0,0 +1,59 @@
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VLLMLister helps list VLLMs.
+// All objects returned here must be treated as read-only.
+type VLLMLister interface {
+	// List lists all VLLMs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*vllmv1.VLLM, err error)
+	// VLLMs returns an object that can list and get VLLMs.
+	VLLMs(namespace string) VLLMNamespaceLister
+	VLLMListerExpansion
+}
+
+// vLLMLister implements the VLLMLister interface.
+type vLLMLister struct {
+	listers.ResourceIndexer[*vllmv1.VLLM]
+}
+
+// NewVLLMLister returns a new VLLMLister.
+func NewVLLMLister(indexer cache.Indexer) VLLMLister {
+	return &vLLMLister{listers.New[*vllmv1.VLLM](indexer, vllmv1.Resource("vllm"))}
+}
+
+// VLLMs returns an object that can list and get VLLMs.
+func (s *vLLMLister) VLLMs(namespace string) VLLMNamespaceLister {
+	return vLLMNamespaceLister{listers.NewNamespaced[*vllmv1.VLLM](s.ResourceIndexer, namespace)}
+}
+
+// VLLMNamespaceLister helps list and get VLLMs.
+// All objects returned here must be treated as read-only.
+type VLLMNamespaceLister interface {
+	// List lists all VLLMs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*vllmv1.VLLM, err error)
+	// Get retrieves the VLLM from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*vllmv1.VLLM, error)
+	VLLMNamespaceListerExpansion
+}
+
+// vLLMNamespaceLister implements the VLLMNamespaceLister
+// interface.
+type vLLMNamespaceLister struct {
+	listers.ResourceIndexer[*vllmv1.VLLM]
+}
@@ -0,0 +1,6 @@
+// Package v1 contains the v1 API types of the vllm.ai group.
+//
+// +k8s:deepcopy-gen=package
+// +kubebuilder:object:generate=true
+// +groupName=vllm.ai
+package v1
@@ -0,0 +1,331 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Action is the lifecycle action requested for a runtime.
+// +kubebuilder:validation:Enum=start;stop;update
+type Action string
+
+const (
+	ActionStart  Action = "start"
+	ActionStop   Action = "stop"
+	ActionUpdate Action = "update"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vllm
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Model",type=string,JSONPath=`.spec.model`
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VLLM is a vLLM model runtime.
+type VLLM struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VLLMSpec   `json:"spec,omitempty"`
+	Status VLLMStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// VLLMList is a list of VLLM runtimes.
+type VLLMList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VLLM `json:"items"`
+}
+
+type VLLMSpec struct {
+	// Kubernetes namespace for the vLLM model
+	Namespace string `json:"namespace"`
+	// Name of the vLLM runtime or model
+	RuntimeName string `json:"runtimeName"`
+	// Number of replicas for the model (optional)
+	// +kubebuilder:validation:Minimum=0
+	// +nullable
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Scale replicas on engine load while the runtime is Running (optional)
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+	// Start and stop the runtime on a cron schedule (optional)
+	// +optional
+	Schedule *ScheduleSpec `json:"schedule,omitempty"`
+	// Model name (e.g., meta-llama/Llama-2-7b-hf)
+	Model string `json:"model"`
+	// Model artifact: file:///path, pvc://claim/path, s3://bucket/prefix or hf://org/model[@revision]
+	// +optional
+	StorageURI string `json:"storageUri,omitempty"`
+	// Path of the resolved artifact inside the runtime container (set by the control plane)
+	// +optional
+	ModelPath string `json:"modelPath,omitempty"`
+	// vLLM runtime arguments (e.g., --max-model-len=512)
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// LoRA adapters served on top of the base model; kept in sync with --lora-modules in args
+	// +optional
+	Adapters []AdapterSpec `json:"adapters,omitempty"`
+	// Action to perform on the model
+	Action Action `json:"action"`
+	// +optional
+	VLLMConfig VLLMConfig `json:"vllmConfig,omitempty"`
+	// +optional
+	DeploymentConfig DeploymentConfig `json:"deploymentConfig,omitempty"`
+}
+
+type AutoscalingSpec struct {
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas int32 `json:"minReplicas"`
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// queueDepth is waiting requests per replica, kvCacheUsage is the KV-cache fraction (0-1)
+	// +kubebuilder:validation:Enum=queueDepth;kvCacheUsage
+	// +optional
+	Metric string `json:"metric,omitempty"`
+	// Desired value of the metric
+	Target float64 `json:"target"`
+	// Minimum time between scale-ups (default 60)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ScaleUpCooldownSeconds *int64 `json:"scaleUpCooldownSeconds,omitempty"`
+	// Minimum time between scale-downs (default 300)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ScaleDownCooldownSeconds *int64 `json:"scaleDownCooldownSeconds,omitempty"`
+}
+
+type ScheduleSpec struct {
+	// IANA time zone the expressions are evaluated in (default UTC)
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Five-field cron expression for starts (e.g., 0 8 * * 1-5)
+	// +optional
+	Start string `json:"start,omitempty"`
+	// Five-field cron expression for stops (e.g., 0 19 * * 1-5)
+	// +optional
+	Stop string `json:"stop,omitempty"`
+	// Pause scheduled transitions until cleared
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+	// Skip scheduled transitions up to this time, keeping a manual start or stop in place
+	// +optional
+	OverrideUntil *metav1.Time `json:"overrideUntil,omitempty"`
+}
+
+type AdapterSpec struct {
+	// Model name clients use to select the adapter
+	Name string `json:"name"`
+	// Adapter weights (e.g., file:///usr/local/models/adapters/sql)
+	StorageURI string `json:"storageUri"`
+}
+
+type VLLMConfig struct {
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// +optional
+	V1 bool `json:"v1,omitempty"`
+	// +optional
+	Env []EnvVar `json:"env,omitempty"`
+}
+
+type EnvVar struct {
+	Name string `json:"name"`
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+type DeploymentConfig struct {
+	// Labels of the nodes the runtime may run on; also the default warm-up target
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// +optional
+	DeviceRequests []DeviceRequest `json:"deviceRequests,omitempty"`
+	// +optional
+	Image Image `json:"image,omitempty"`
+	// +optional
+	DeploymentStrategy string `json:"deploymentStrategy,omitempty"`
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// Init containers, e.g. the model download generated for s3:// and hf:// artifacts
+	// +optional
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+}
+
+// DeviceRequest pins a runtime to specific GPUs.
+type DeviceRequest struct {
+	// +optional
+	Driver string `json:"driver,omitempty"`
+	// +optional
+	Count int32 `json:"count,omitempty"`
+	// +optional
+	Capabilities []string `json:"capabilities,omitempty"`
+	// +optional
+	DeviceIDs []string `json:"deviceIDs,omitempty"`
+}
+
+type Image struct {
+	// +optional
+	Registry string `json:"registry,omitempty"`
+	// +optional
+	Name string `json:"name,omitempty"`
+	// +optional
+	PullPolicy corev1.PullPolicy `json:"pullPolicy,omitempty"`
+}
+
+type VLLMStatus struct {
+	// Current phase of vLLM (Starting, Running, Stopped, Updating, etc)
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// Status message
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Timestamp when the current phase started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Service endpoint (e.g., http://llama-2-7b.default.svc.cluster.local:8000)
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// Current number of running replicas
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+	// Timestamp of the last autoscaler change to spec.replicas
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+	// Scheduler state
+	// +optional
+	Schedule *ScheduleStatus `json:"schedule,omitempty"`
+	// Model artifact resolved at the last start
+	// +optional
+	Artifact *ArtifactStatus `json:"artifact,omitempty"`
+	// Declared LoRA adapters and whether the engine serves them
+	// +optional
+	Adapters []AdapterStatus `json:"adapters,omitempty"`
+	// Latest image pre-pull and weight cache warm-up
+	// +optional
+	Warmup *WarmupStatus `json:"warmup,omitempty"`
+	// Latest rollout from this resource to a new revision
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+	// Ready, Progressing, Degraded, Scheduled, ModelLoaded and Autoscaling
+	// conditions
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+type ScheduleStatus struct {
+	// Time of the last scheduled transition handled
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// Next scheduled action
+	// +kubebuilder:validation:Enum=start;stop
+	// +optional
+	NextAction Action `json:"nextAction,omitempty"`
+	// When the next scheduled action will be issued
+	// +optional
+	NextTransitionTime *metav1.Time `json:"nextTransitionTime,omitempty"`
+}
+
+type ArtifactStatus struct {
+	// +optional
+	StorageURI string `json:"storageUri,omitempty"`
+	// Artifact size, or claim capacity for pvc://; -1 if unknown
+	// +optional
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
+	// +optional
+	Files int64 `json:"files,omitempty"`
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+type AdapterStatus struct {
+	Name string `json:"name"`
+	// +optional
+	StorageURI string `json:"storageUri,omitempty"`
+	// +optional
+	Loaded bool `json:"loaded,omitempty"`
+}
+
+type WarmupStatus struct {
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Node-local directory holding the cached weights; empty if only the image is pulled
+	// +optional
+	CachePath string `json:"cachePath,omitempty"`
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// +kubebuilder:validation:Enum=Pending;PullingImage;CopyingWeights;Completed;Failed
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	NodesDesired int32 `json:"nodesDesired,omitempty"`
+	// +optional
+	NodesPulled int32 `json:"nodesPulled,omitempty"`
+	// +optional
+	NodesReady int32 `json:"nodesReady,omitempty"`
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// +nullable
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+}
+
+type RolloutStatus struct {
+	// Name of the new revision
+	// +optional
+	Canary string `json:"canary,omitempty"`
+	// +kubebuilder:validation:Enum=Canary;BlueGreen
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+	// HTTPRoute whose backend weights are shifted
+	// +optional
+	Route string `json:"route,omitempty"`
+	// Traffic percentages for the new revision
+	// +optional
+	Steps []int32 `json:"steps,omitempty"`
+	// +optional
+	StepSeconds int64 `json:"stepSeconds,omitempty"`
+	// +optional
+	MaxErrorRate float64 `json:"maxErrorRate,omitempty"`
+	// +optional
+	MaxTTFTSeconds float64 `json:"maxTTFTSeconds,omitempty"`
+	// +optional
+	ReadyTimeoutSeconds int64 `json:"readyTimeoutSeconds,omitempty"`
+	// Index into steps; -1 while the new revision starts
+	// +optional
+	Step int32 `json:"step,omitempty"`
+	// Traffic percentage currently sent to the new revision
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+	// +kubebuilder:validation:Enum=Progressing;Succeeded;RolledBack;Aborted
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// +optional
+	StepStartedAt *metav1.Time `json:"stepStartedAt,omitempty"`
+}
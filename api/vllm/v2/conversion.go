@@ -0,0 +1,236 @@
+package v2
+
+import (
+	v1 "connect-go/api/vllm/v1"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// Annotations that carry the v1 fields v2 cannot express, so an object read as
+// v2 and written back converts to the same v1 object.
+const (
+	// AnnotationV1Action holds a v1 action other than start and stop.
+	AnnotationV1Action = "conversion.vllm.ai/v1-action"
+	// AnnotationV1Args holds the v1 args when rendering the engine options
+	// would not reproduce them, for example flags in another order.
+	AnnotationV1Args = "conversion.vllm.ai/v1-args"
+	// AnnotationV1Namespace holds a v1 spec.namespace that differs from the
+	// object's namespace.
+	AnnotationV1Namespace = "conversion.vllm.ai/v1-namespace"
+)
+
+var _ conversion.Convertible = &VLLM{}
+
+// ConvertTo converts this VLLM to the v1 hub version.
+func (src *VLLM) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1.VLLM)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", dstRaw)
+	}
+	in := src.DeepCopy()
+	annotations := in.GetAnnotations()
+
+	dst.ObjectMeta = in.ObjectMeta
+	dst.Status = in.Status
+	dst.Spec = v1.VLLMSpec{
+		Namespace:        in.Namespace,
+		RuntimeName:      in.Spec.RuntimeName,
+		Replicas:         in.Spec.Replicas,
+		Autoscaling:      in.Spec.Autoscaling,
+		Schedule:         in.Spec.Schedule,
+		Model:            in.Spec.Model,
+		StorageURI:       in.Spec.StorageURI,
+		ModelPath:        in.Spec.ModelPath,
+		Args:             in.Spec.Engine.Args(),
+		Adapters:         in.Spec.Adapters,
+		Action:           v1.ActionStop,
+		VLLMConfig:       in.Spec.VLLMConfig,
+		DeploymentConfig: in.Spec.DeploymentConfig,
+	}
+	if ns := annotations[AnnotationV1Namespace]; ns != "" {
+		dst.Spec.Namespace = ns
+	}
+	if in.Spec.Running {
+		dst.Spec.Action = v1.ActionStart
+		if action := v1.Action(annotations[AnnotationV1Action]); action == v1.ActionUpdate {
+			dst.Spec.Action = action
+		}
+	}
+	// Keep the original args only while the engine options still say the same.
+	if raw, ok := annotations[AnnotationV1Args]; ok {
+		var args []string
+		if err := json.Unmarshal([]byte(raw), &args); err == nil && slices.Equal(ParseEngineOptions(args).Args(), dst.Spec.Args) {
+			dst.Spec.Args = args
+		}
+	}
+
+	for _, key := range []string{AnnotationV1Action, AnnotationV1Args, AnnotationV1Namespace} {
+		delete(annotations, key)
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	dst.SetAnnotations(annotations)
+	return nil
+}
+
+// ConvertFrom converts from the v1 hub version to this version.
+func (dst *VLLM) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1.VLLM)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", srcRaw)
+	}
+	in := src.DeepCopy()
+
+	dst.ObjectMeta = in.ObjectMeta
+	dst.Status = in.Status
+	dst.Spec = VLLMSpec{
+		RuntimeName:      in.Spec.RuntimeName,
+		Model:            in.Spec.Model,
+		Running:          in.Spec.Action == v1.ActionStart || in.Spec.Action == v1.ActionUpdate,
+		Replicas:         in.Spec.Replicas,
+		Autoscaling:      in.Spec.Autoscaling,
+		Schedule:         in.Spec.Schedule,
+		StorageURI:       in.Spec.StorageURI,
+		ModelPath:        in.Spec.ModelPath,
+		Engine:           ParseEngineOptions(in.Spec.Args),
+		Adapters:         in.Spec.Adapters,
+		VLLMConfig:       in.Spec.VLLMConfig,
+		DeploymentConfig: in.Spec.DeploymentConfig,
+	}
+
+	annotations := dst.GetAnnotations()
+	setAnnotation := func(key, value string) {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key] = value
+	}
+	if in.Spec.Action == v1.ActionUpdate {
+		setAnnotation(AnnotationV1Action, string(in.Spec.Action))
+	}
+	if in.Spec.Namespace != "" && in.Spec.Namespace != in.Namespace {
+		setAnnotation(AnnotationV1Namespace, in.Spec.Namespace)
+	}
+	if !slices.Equal(dst.Spec.Engine.Args(), in.Spec.Args) {
+		raw, err := json.Marshal(in.Spec.Args)
+		if err != nil {
+			return err
+		}
+		setAnnotation(AnnotationV1Args, string(raw))
+	}
+	dst.SetAnnotations(annotations)
+	return nil
+}
+
+// ParseEngineOptions reads vLLM arguments into engine options. Flags may be
+// written as --flag=value or --flag value; flags without a typed field, a
+// repeated flag and values that don't parse are kept in ExtraArgs.
+func ParseEngineOptions(args []string) EngineOptions {
+	var opts EngineOptions
+	seen := map[string]bool{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		flag, value, hasValue := strings.Cut(arg, "=")
+		next := func() (string, bool) {
+			if hasValue {
+				return value, true
+			}
+			if i+1 < len(args) && !strings.HasPrefix(args[i+1], "--") {
+				i++
+				return args[i], true
+			}
+			return "", false
+		}
+		start := i
+		ok := false
+		if !seen[flag] {
+			switch flag {
+			case "--disable-log-requests":
+				ok = !hasValue
+				opts.DisableLogRequests = ok
+			case "--enable-prompt-tokens-details":
+				ok = !hasValue
+				opts.EnablePromptTokensDetails = ok
+			case "--dtype":
+				if v, found := next(); found && v != "" {
+					opts.DType, ok = v, true
+				}
+			case "--max-model-len":
+				if v, found := next(); found {
+					n, err := strconv.ParseInt(v, 10, 64)
+					if ok = err == nil; ok {
+						opts.MaxModelLen = &n
+					}
+				}
+			case "--max-num-seqs":
+				opts.MaxNumSeqs, ok = parseInt32(next())
+			case "--tensor-parallel-size":
+				opts.TensorParallelSize, ok = parseInt32(next())
+			case "--pipeline-parallel-size":
+				opts.PipelineParallelSize, ok = parseInt32(next())
+			case "--gpu-memory-utilization":
+				if v, found := next(); found {
+					f, err := strconv.ParseFloat(v, 64)
+					if ok = err == nil; ok {
+						opts.GPUMemoryUtilization = &f
+					}
+				}
+			}
+		}
+		if ok {
+			seen[flag] = true
+			continue
+		}
+		opts.ExtraArgs = append(opts.ExtraArgs, args[start:i+1]...)
+	}
+	return opts
+}
+
+func parseInt32(v string, found bool) (*int32, bool) {
+	if !found {
+		return nil, false
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return nil, false
+	}
+	n32 := int32(n)
+	return &n32, true
+}
+
+// Args renders the engine options as vLLM arguments, typed fields first in a
+// fixed order and then ExtraArgs.
+func (o EngineOptions) Args() []string {
+	var args []string
+	if o.DisableLogRequests {
+		args = append(args, "--disable-log-requests")
+	}
+	if o.MaxModelLen != nil {
+		args = append(args, "--max-model-len="+strconv.FormatInt(*o.MaxModelLen, 10))
+	}
+	if o.DType != "" {
+		args = append(args, "--dtype="+o.DType)
+	}
+	if o.MaxNumSeqs != nil {
+		args = append(args, "--max-num-seqs="+strconv.Itoa(int(*o.MaxNumSeqs)))
+	}
+	if o.TensorParallelSize != nil {
+		args = append(args, "--tensor-parallel-size="+strconv.Itoa(int(*o.TensorParallelSize)))
+	}
+	if o.PipelineParallelSize != nil {
+		args = append(args, "--pipeline-parallel-size="+strconv.Itoa(int(*o.PipelineParallelSize)))
+	}
+	if o.GPUMemoryUtilization != nil {
+		args = append(args, "--gpu-memory-utilization="+strconv.FormatFloat(*o.GPUMemoryUtilization, 'f', -1, 64))
+	}
+	if o.EnablePromptTokensDetails {
+		args = append(args, "--enable-prompt-tokens-details")
+	}
+	return append(args, o.ExtraArgs...)
+}
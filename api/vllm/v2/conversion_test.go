@@ -0,0 +1,190 @@
+package v2
+
+import (
+	v1 "connect-go/api/vllm/v1"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func v1VLLM(action v1.Action, args ...string) *v1.VLLM {
+	replicas := int32(2)
+	return &v1.VLLM{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama"},
+		Spec: v1.VLLMSpec{
+			Namespace:   "default",
+			RuntimeName: "llama-3.1-8b",
+			Model:       "meta-llama/Llama-3.1-8B",
+			Replicas:    &replicas,
+			Args:        args,
+			Action:      action,
+			Adapters:    []v1.AdapterSpec{{Name: "sql", StorageURI: "s3://adapters/sql"}},
+			VLLMConfig:  v1.VLLMConfig{Port: 8000},
+		},
+		Status: v1.VLLMStatus{Phase: "Running"},
+	}
+}
+
+func TestConvertFromV1RoundTrip(t *testing.T) {
+	otherNamespace := v1VLLM(v1.ActionStart, "--dtype=bfloat16")
+	otherNamespace.Spec.Namespace = "team-a"
+
+	tests := []struct {
+		name string
+		in   *v1.VLLM
+		// wantAnnotations are the v1 fields v2 could not express.
+		wantAnnotations []string
+	}{
+		{"canonical args", v1VLLM(v1.ActionStart, "--disable-log-requests", "--max-model-len=4096", "--dtype=bfloat16", "--gpu-memory-utilization=0.9"), nil},
+		{"stopped", v1VLLM(v1.ActionStop, "--max-num-seqs=32"), nil},
+		{"update action", v1VLLM(v1.ActionUpdate), []string{AnnotationV1Action}},
+		{"reordered args", v1VLLM(v1.ActionStart, "--dtype=bfloat16", "--max-model-len=4096"), []string{AnnotationV1Args}},
+		{"space separated value", v1VLLM(v1.ActionStart, "--max-model-len", "4096"), []string{AnnotationV1Args}},
+		{"unknown and repeated flags", v1VLLM(v1.ActionStart, "--enable-lora", "--lora-modules", "a=/a", "--lora-modules", "b=/b"), nil},
+		{"unparsable value", v1VLLM(v1.ActionStart, "--max-model-len=auto"), nil},
+		{"spec namespace differs", otherNamespace, []string{AnnotationV1Namespace}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := tt.in.DeepCopy()
+			var spoke VLLM
+			if err := spoke.ConvertFrom(hub); err != nil {
+				t.Fatalf("ConvertFrom: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(hub, tt.in) {
+				t.Fatal("ConvertFrom modified its source")
+			}
+			var keys []string
+			for k := range spoke.Annotations {
+				keys = append(keys, k)
+			}
+			slices.Sort(keys)
+			if !slices.Equal(keys, tt.wantAnnotations) {
+				t.Errorf("annotations = %v, want %v", keys, tt.wantAnnotations)
+			}
+
+			var back v1.VLLM
+			if err := spoke.ConvertTo(&back); err != nil {
+				t.Fatalf("ConvertTo: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(&back, tt.in) {
+				t.Errorf("round trip changed the object:\n got %+v\nwant %+v", back.Spec, tt.in.Spec)
+			}
+		})
+	}
+}
+
+func TestConvertToV1RoundTrip(t *testing.T) {
+	maxModelLen, seqs, util := int64(4096), int32(16), 0.85
+	in := &VLLM{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama", Labels: map[string]string{"team": "a"}},
+		Spec: VLLMSpec{
+			RuntimeName: "llama-3.1-8b",
+			Model:       "meta-llama/Llama-3.1-8B",
+			Running:     true,
+			Engine: EngineOptions{
+				MaxModelLen:          &maxModelLen,
+				MaxNumSeqs:           &seqs,
+				GPUMemoryUtilization: &util,
+				DType:                "float16",
+				DisableLogRequests:   true,
+				ExtraArgs:            []string{"--enable-lora"},
+			},
+		},
+	}
+	var hub v1.VLLM
+	if err := in.DeepCopy().ConvertTo(&hub); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	if hub.Spec.Action != v1.ActionStart || hub.Spec.Namespace != "default" {
+		t.Errorf("action %q, namespace %q; want start in default", hub.Spec.Action, hub.Spec.Namespace)
+	}
+	var back VLLM
+	if err := back.ConvertFrom(&hub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+	if !equality.Semantic.DeepEqual(&back, in) {
+		t.Errorf("round trip changed the object:\n got %+v\nwant %+v", back.Spec, in.Spec)
+	}
+}
+
+// A v2 client editing a converted object drops args the engine options no
+// longer describe instead of writing stale ones back.
+func TestConvertToDropsStaleArgs(t *testing.T) {
+	var spoke VLLM
+	if err := spoke.ConvertFrom(v1VLLM(v1.ActionStart, "--dtype=bfloat16", "--max-model-len=4096")); err != nil {
+		t.Fatal(err)
+	}
+	spoke.Spec.Engine.DType = "float16"
+	var hub v1.VLLM
+	if err := spoke.ConvertTo(&hub); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"--max-model-len=4096", "--dtype=float16"}
+	if !slices.Equal(hub.Spec.Args, want) {
+		t.Errorf("args = %v, want %v", hub.Spec.Args, want)
+	}
+	if len(hub.Annotations) != 0 {
+		t.Errorf("conversion annotations leaked into v1: %v", hub.Annotations)
+	}
+}
+
+func TestSamplesRoundTrip(t *testing.T) {
+	files, err := filepath.Glob("../../../config/samples/*.yaml")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no samples found: %v", err)
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var meta metav1.TypeMeta
+			if err := yaml.Unmarshal(data, &meta); err != nil {
+				t.Fatal(err)
+			}
+			switch meta.APIVersion {
+			case v1.SchemeGroupVersion.String():
+				var in v1.VLLM
+				if err := yaml.UnmarshalStrict(data, &in); err != nil {
+					t.Fatal(err)
+				}
+				var spoke VLLM
+				if err := spoke.ConvertFrom(in.DeepCopy()); err != nil {
+					t.Fatal(err)
+				}
+				var back v1.VLLM
+				if err := spoke.ConvertTo(&back); err != nil {
+					t.Fatal(err)
+				}
+				if !equality.Semantic.DeepEqual(back.ObjectMeta, in.ObjectMeta) || !equality.Semantic.DeepEqual(back.Spec, in.Spec) {
+					t.Errorf("round trip changed the sample:\n got %+v\nwant %+v", back.Spec, in.Spec)
+				}
+			case SchemeGroupVersion.String():
+				var in VLLM
+				if err := yaml.UnmarshalStrict(data, &in); err != nil {
+					t.Fatal(err)
+				}
+				var hub v1.VLLM
+				if err := in.DeepCopy().ConvertTo(&hub); err != nil {
+					t.Fatal(err)
+				}
+				var back VLLM
+				if err := back.ConvertFrom(&hub); err != nil {
+					t.Fatal(err)
+				}
+				if !equality.Semantic.DeepEqual(back.ObjectMeta, in.ObjectMeta) || !equality.Semantic.DeepEqual(back.Spec, in.Spec) {
+					t.Errorf("round trip changed the sample:\n got %+v\nwant %+v", back.Spec, in.Spec)
+				}
+			default:
+				t.Fatalf("unexpected apiVersion %q", meta.APIVersion)
+			}
+		})
+	}
+}
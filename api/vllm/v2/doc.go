@@ -0,0 +1,9 @@
+// Package v2 contains the v2 API types of the vllm.ai group. v2 describes the
+// desired state declaratively: running instead of the v1 action, typed engine
+// options instead of raw args, and no spec.namespace. v1 stays the storage
+// version; see conversion.go.
+//
+// +k8s:deepcopy-gen=package
+// +kubebuilder:object:generate=true
+// +groupName=vllm.ai
+package v2
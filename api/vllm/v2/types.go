@@ -0,0 +1,113 @@
+package v2
+
+import (
+	v1 "connect-go/api/vllm/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vllm
+// +kubebuilder:printcolumn:name="Model",type=string,JSONPath=`.spec.model`
+// +kubebuilder:printcolumn:name="Running",type=boolean,JSONPath=`.spec.running`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// VLLM is a vLLM model runtime.
+type VLLM struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VLLMSpec      `json:"spec,omitempty"`
+	Status v1.VLLMStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// VLLMList is a list of VLLM runtimes.
+type VLLMList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VLLM `json:"items"`
+}
+
+type VLLMSpec struct {
+	// Name of the vLLM runtime or model
+	// +optional
+	RuntimeName string `json:"runtimeName,omitempty"`
+	// Model name (e.g., meta-llama/Llama-2-7b-hf)
+	Model string `json:"model"`
+	// Whether the runtime should be serving
+	Running bool `json:"running"`
+	// Number of replicas for the model (optional)
+	// +kubebuilder:validation:Minimum=0
+	// +nullable
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Scale replicas on engine load while the runtime is Running (optional)
+	// +optional
+	Autoscaling *v1.AutoscalingSpec `json:"autoscaling,omitempty"`
+	// Start and stop the runtime on a cron schedule (optional)
+	// +optional
+	Schedule *v1.ScheduleSpec `json:"schedule,omitempty"`
+	// Model artifact: file:///path, pvc://claim/path, s3://bucket/prefix or hf://org/model[@revision]
+	// +optional
+	StorageURI string `json:"storageUri,omitempty"`
+	// Path of the resolved artifact inside the runtime container (set by the control plane)
+	// +optional
+	ModelPath string `json:"modelPath,omitempty"`
+	// Engine options, rendered as vLLM arguments
+	// +optional
+	Engine EngineOptions `json:"engine,omitempty"`
+	// LoRA adapters served on top of the base model
+	// +optional
+	Adapters []v1.AdapterSpec `json:"adapters,omitempty"`
+	// +optional
+	VLLMConfig v1.VLLMConfig `json:"vllmConfig,omitempty"`
+	// +optional
+	DeploymentConfig v1.DeploymentConfig `json:"deploymentConfig,omitempty"`
+}
+
+// EngineOptions are the vLLM engine arguments with a typed field each. Anything
+// else goes in ExtraArgs.
+type EngineOptions struct {
+	// --max-model-len
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxModelLen *int64 `json:"maxModelLen,omitempty"`
+	// --max-num-seqs
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxNumSeqs *int32 `json:"maxNumSeqs,omitempty"`
+	// --tensor-parallel-size
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TensorParallelSize *int32 `json:"tensorParallelSize,omitempty"`
+	// --pipeline-parallel-size
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PipelineParallelSize *int32 `json:"pipelineParallelSize,omitempty"`
+	// --gpu-memory-utilization, the fraction of GPU memory the engine may use
+	// +kubebuilder:validation:ExclusiveMinimum=true
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	// +optional
+	GPUMemoryUtilization *float64 `json:"gpuMemoryUtilization,omitempty"`
+	// --dtype (e.g., auto, bfloat16, float16)
+	// +optional
+	DType string `json:"dtype,omitempty"`
+	// --enable-prompt-tokens-details
+	// +optional
+	EnablePromptTokensDetails bool `json:"enablePromptTokensDetails,omitempty"`
+	// --disable-log-requests
+	// +optional
+	DisableLogRequests bool `json:"disableLogRequests,omitempty"`
+	// Further vLLM arguments, passed through unchanged
+	// +optional
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+}
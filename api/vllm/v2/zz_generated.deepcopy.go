@@ -0,0 +1,156 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright The connect-go Authors.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"connect-go/api/vllm/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineOptions) DeepCopyInto(out *EngineOptions) {
+	*out = *in
+	if in.MaxModelLen != nil {
+		in, out := &in.MaxModelLen, &out.MaxModelLen
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxNumSeqs != nil {
+		in, out := &in.MaxNumSeqs, &out.MaxNumSeqs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TensorParallelSize != nil {
+		in, out := &in.TensorParallelSize, &out.TensorParallelSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PipelineParallelSize != nil {
+		in, out := &in.PipelineParallelSize, &out.PipelineParallelSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GPUMemoryUtilization != nil {
+		in, out := &in.GPUMemoryUtilization, &out.GPUMemoryUtilization
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineOptions.
+func (in *EngineOptions) DeepCopy() *EngineOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLLM) DeepCopyInto(out *VLLM) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLLM.
+func (in *VLLM) DeepCopy() *VLLM {
+	if in == nil {
+		return nil
+	}
+	out := new(VLLM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VLLM) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLLMList) DeepCopyInto(out *VLLMList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VLLM, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLLMList.
+func (in *VLLMList) DeepCopy() *VLLMList {
+	if in == nil {
+		return nil
+	}
+	out := new(VLLMList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VLLMList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLLMSpec) DeepCopyInto(out *VLLMSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(v1.AutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(v1.ScheduleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Engine.DeepCopyInto(&out.Engine)
+	if in.Adapters != nil {
+		in, out := &in.Adapters, &out.Adapters
+		*out = make([]v1.AdapterSpec, len(*in))
+		copy(*out, *in)
+	}
+	in.VLLMConfig.DeepCopyInto(&out.VLLMConfig)
+	in.DeploymentConfig.DeepCopyInto(&out.DeploymentConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLLMSpec.
+func (in *VLLMSpec) DeepCopy() *VLLMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VLLMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
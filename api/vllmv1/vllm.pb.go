@@ -8,6 +8,7 @@ package vllmv1
 
 import (
 	any1 "github.com/golang/protobuf/ptypes/any"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -24,12 +25,22 @@ const (
 )
 
 type LLMRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	RuntimeName   string                 `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
-	Replicas      *int32                 `protobuf:"varint,3,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Namespace   string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RuntimeName string                 `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	Replicas    *int32                 `protobuf:"varint,3,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	// wait makes StartLLM return only once the runtime serves requests.
+	Wait bool `protobuf:"varint,4,opt,name=wait,proto3" json:"wait,omitempty"`
+	// timeout_seconds bounds the wait (default 600, at most 1800).
+	TimeoutSeconds int32 `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// dry_run renders the change with a server-side dry run and returns it in
+	// LLMResponse.object and changes without applying it.
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// idempotency_key makes retries of the request return the first result
+	// instead of applying it again; the Idempotency-Key header does the same.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *LLMRequest) Reset() {
@@ -83,14 +94,48 @@ func (x *LLMRequest) GetReplicas() int32 {
 	return 0
 }
 
+func (x *LLMRequest) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *LLMRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *LLMRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *LLMRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type UpdateLLMRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	RuntimeName   string                 `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
-	Replicas      *int32                 `protobuf:"varint,3,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
-	Spec          map[string]*any1.Any   `protobuf:"bytes,4,rep,name=spec,proto3" json:"spec,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// runtime_name is the VLLM resource to update.
+	RuntimeName string `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	Replicas    *int32 `protobuf:"varint,3,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	// spec is merged into the live spec like a JSON merge patch.
+	Spec   map[string]*any1.Any `protobuf:"bytes,4,rep,name=spec,proto3" json:"spec,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DryRun bool                 `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// idempotency_key makes retries of the request return the first result
+	// instead of applying it again; the Idempotency-Key header does the same.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateLLMRequest) Reset() {
@@ -151,14 +196,35 @@ func (x *UpdateLLMRequest) GetSpec() map[string]*any1.Any {
 	return nil
 }
 
+func (x *UpdateLLMRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateLLMRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreateLLMRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	RuntimeName   string                 `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
-	Replicas      *int32                 `protobuf:"varint,3,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
-	Spec          map[string]*any1.Any   `protobuf:"bytes,4,rep,name=spec,proto3" json:"spec,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// runtime_name names the new VLLM resource.
+	RuntimeName string `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	Replicas    *int32 `protobuf:"varint,3,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	// spec is merged over the generated spec.
+	Spec   map[string]*any1.Any `protobuf:"bytes,4,rep,name=spec,proto3" json:"spec,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DryRun bool                 `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Model  string               `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	// idempotency_key makes retries of the request return the first result
+	// instead of applying it again; the Idempotency-Key header does the same.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateLLMRequest) Reset() {
@@ -219,27 +285,51 @@ func (x *CreateLLMRequest) GetSpec() map[string]*any1.Any {
 	return nil
 }
 
-type ListLLMsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+func (x *CreateLLMRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateLLMRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *CreateLLMRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type DeleteLLMRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// runtime_name is the VLLM resource to delete.
+	RuntimeName   string `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	DryRun        bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLLMsRequest) Reset() {
-	*x = ListLLMsRequest{}
+func (x *DeleteLLMRequest) Reset() {
+	*x = DeleteLLMRequest{}
 	mi := &file_vllm_v1_vllm_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLLMsRequest) String() string {
+func (x *DeleteLLMRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLLMsRequest) ProtoMessage() {}
+func (*DeleteLLMRequest) ProtoMessage() {}
 
-func (x *ListLLMsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteLLMRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vllm_v1_vllm_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -251,40 +341,66 @@ func (x *ListLLMsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLLMsRequest.ProtoReflect.Descriptor instead.
-func (*ListLLMsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteLLMRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLLMRequest) Descriptor() ([]byte, []int) {
 	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListLLMsRequest) GetNamespace() string {
+func (x *DeleteLLMRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-type LLMResponse struct {
+func (x *DeleteLLMRequest) GetRuntimeName() string {
+	if x != nil {
+		return x.RuntimeName
+	}
+	return ""
+}
+
+func (x *DeleteLLMRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// BatchLLMsRequest picks VLLM resources of one namespace by name or by label;
+// exactly one of runtime_names and label_selector is required.
+type BatchLLMsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Spec          map[string]*any1.Any   `protobuf:"bytes,2,rep,name=spec,proto3" json:"spec,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RuntimeNames  []string               `protobuf:"bytes,2,rep,name=runtime_names,json=runtimeNames,proto3" json:"runtime_names,omitempty"`
+	LabelSelector string                 `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// concurrency bounds how many runtimes change at once (default 4, at most 32).
+	Concurrency int32 `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// atomic stops the batch at the first failure and reverts the runtimes it
+	// already changed.
+	Atomic bool `protobuf:"varint,5,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// idempotency_key makes retries of the batch return the first result
+	// instead of applying it again; the Idempotency-Key header does the same.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *LLMResponse) Reset() {
-	*x = LLMResponse{}
+func (x *BatchLLMsRequest) Reset() {
+	*x = BatchLLMsRequest{}
 	mi := &file_vllm_v1_vllm_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LLMResponse) String() string {
+func (x *BatchLLMsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LLMResponse) ProtoMessage() {}
+func (*BatchLLMsRequest) ProtoMessage() {}
 
-func (x *LLMResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchLLMsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vllm_v1_vllm_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,46 +412,92 @@ func (x *LLMResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LLMResponse.ProtoReflect.Descriptor instead.
-func (*LLMResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchLLMsRequest.ProtoReflect.Descriptor instead.
+func (*BatchLLMsRequest) Descriptor() ([]byte, []int) {
 	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *LLMResponse) GetMessage() string {
+func (x *BatchLLMsRequest) GetNamespace() string {
 	if x != nil {
-		return x.Message
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *LLMResponse) GetSpec() map[string]*any1.Any {
+func (x *BatchLLMsRequest) GetRuntimeNames() []string {
 	if x != nil {
-		return x.Spec
+		return x.RuntimeNames
 	}
 	return nil
 }
 
-type ListLLMsResponse struct {
+func (x *BatchLLMsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *BatchLLMsRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *BatchLLMsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *BatchLLMsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BatchLLMsRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type BatchUpdateLLMsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Llms          []*LLMInfo             `protobuf:"bytes,1,rep,name=llms,proto3" json:"llms,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RuntimeNames  []string               `protobuf:"bytes,2,rep,name=runtime_names,json=runtimeNames,proto3" json:"runtime_names,omitempty"`
+	LabelSelector string                 `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	Concurrency   int32                  `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Atomic        bool                   `protobuf:"varint,5,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Replicas      *int32                 `protobuf:"varint,7,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	// spec is merged into the live spec of each runtime like a JSON merge patch.
+	Spec map[string]*any1.Any `protobuf:"bytes,8,rep,name=spec,proto3" json:"spec,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// idempotency_key makes retries of the batch return the first result
+	// instead of applying it again; the Idempotency-Key header does the same.
+	IdempotencyKey string `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListLLMsResponse) Reset() {
-	*x = ListLLMsResponse{}
+func (x *BatchUpdateLLMsRequest) Reset() {
+	*x = BatchUpdateLLMsRequest{}
 	mi := &file_vllm_v1_vllm_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLLMsResponse) String() string {
+func (x *BatchUpdateLLMsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLLMsResponse) ProtoMessage() {}
+func (*BatchUpdateLLMsRequest) ProtoMessage() {}
 
-func (x *ListLLMsResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchUpdateLLMsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vllm_v1_vllm_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -347,42 +509,97 @@ func (x *ListLLMsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLLMsResponse.ProtoReflect.Descriptor instead.
-func (*ListLLMsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchUpdateLLMsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateLLMsRequest) Descriptor() ([]byte, []int) {
 	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListLLMsResponse) GetLlms() []*LLMInfo {
+func (x *BatchUpdateLLMsRequest) GetNamespace() string {
 	if x != nil {
-		return x.Llms
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BatchUpdateLLMsRequest) GetRuntimeNames() []string {
+	if x != nil {
+		return x.RuntimeNames
 	}
 	return nil
 }
 
-type LLMInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
-	Replicas      int32                  `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
-	Status        map[string]*any1.Any   `protobuf:"bytes,4,rep,name=status,proto3" json:"status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *BatchUpdateLLMsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *BatchUpdateLLMsRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *BatchUpdateLLMsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *BatchUpdateLLMsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BatchUpdateLLMsRequest) GetReplicas() int32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+func (x *BatchUpdateLLMsRequest) GetSpec() map[string]*any1.Any {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *BatchUpdateLLMsRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type BatchLLMsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// reverted is true when an atomic batch failed and undid its changes.
+	Reverted      bool `protobuf:"varint,2,opt,name=reverted,proto3" json:"reverted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LLMInfo) Reset() {
-	*x = LLMInfo{}
+func (x *BatchLLMsResponse) Reset() {
+	*x = BatchLLMsResponse{}
 	mi := &file_vllm_v1_vllm_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LLMInfo) String() string {
+func (x *BatchLLMsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LLMInfo) ProtoMessage() {}
+func (*BatchLLMsResponse) ProtoMessage() {}
 
-func (x *LLMInfo) ProtoReflect() protoreflect.Message {
+func (x *BatchLLMsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_vllm_v1_vllm_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,93 +611,2489 @@ func (x *LLMInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LLMInfo.ProtoReflect.Descriptor instead.
-func (*LLMInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchLLMsResponse.ProtoReflect.Descriptor instead.
+func (*BatchLLMsResponse) Descriptor() ([]byte, []int) {
 	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *LLMInfo) GetName() string {
+func (x *BatchLLMsResponse) GetResults() []*BatchItemResult {
 	if x != nil {
-		return x.Name
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchLLMsResponse) GetReverted() bool {
+	if x != nil {
+		return x.Reverted
+	}
+	return false
+}
+
+type BatchItemResult struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RuntimeName string                 `protobuf:"bytes,1,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	// outcome is Succeeded, Unchanged, Failed, Skipped or Reverted.
+	Outcome string `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// code is the Connect error code of a failed item.
+	Code  string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// revert_error is why an atomic batch could not undo this item's change.
+	RevertError string `protobuf:"bytes,5,opt,name=revert_error,json=revertError,proto3" json:"revert_error,omitempty"`
+	// changes is how the spec changed, or on a dry run would change.
+	Changes []*SpecChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	// operation_id names the operation following a started or updated runtime
+	// until it serves, as for StartLLM and UpdateLLM; see GetOperation. Stopped,
+	// reverted and dry-run items have none.
+	OperationId   string `protobuf:"bytes,7,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchItemResult) GetRuntimeName() string {
+	if x != nil {
+		return x.RuntimeName
 	}
 	return ""
 }
 
-func (x *LLMInfo) GetModel() string {
+func (x *BatchItemResult) GetOutcome() string {
 	if x != nil {
-		return x.Model
+		return x.Outcome
 	}
 	return ""
 }
 
-func (x *LLMInfo) GetReplicas() int32 {
+func (x *BatchItemResult) GetCode() string {
 	if x != nil {
-		return x.Replicas
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *LLMInfo) GetStatus() map[string]*any1.Any {
+func (x *BatchItemResult) GetError() string {
 	if x != nil {
-		return x.Status
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetRevertError() string {
+	if x != nil {
+		return x.RevertError
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetChanges() []*SpecChange {
+	if x != nil {
+		return x.Changes
 	}
 	return nil
 }
 
-var File_vllm_v1_vllm_proto protoreflect.FileDescriptor
+func (x *BatchItemResult) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
 
-const file_vllm_v1_vllm_proto_rawDesc = "" +
-	"\n" +
-	"\x12vllm/v1/vllm.proto\x12\avllm.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/protobuf/any.proto\"{\n" +
-	"\n" +
-	"LLMRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
-	"\fruntime_name\x18\x02 \x01(\tR\vruntimeName\x12\x1f\n" +
-	"\breplicas\x18\x03 \x01(\x05H\x00R\breplicas\x88\x01\x01B\v\n" +
-	"\t_replicas\"\x89\x02\n" +
-	"\x10UpdateLLMRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
-	"\fruntime_name\x18\x02 \x01(\tR\vruntimeName\x12\x1f\n" +
-	"\breplicas\x18\x03 \x01(\x05H\x00R\breplicas\x88\x01\x01\x127\n" +
-	"\x04spec\x18\x04 \x03(\v2#.vllm.v1.UpdateLLMRequest.SpecEntryR\x04spec\x1aM\n" +
-	"\tSpecEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
-	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x01B\v\n" +
-	"\t_replicas\"\x89\x02\n" +
-	"\x10CreateLLMRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+type ListLLMsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLLMsRequest) Reset() {
+	*x = ListLLMsRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLLMsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLLMsRequest) ProtoMessage() {}
+
+func (x *ListLLMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLLMsRequest.ProtoReflect.Descriptor instead.
+func (*ListLLMsRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListLLMsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type LLMResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Message  string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Spec     map[string]*any1.Any   `protobuf:"bytes,2,rep,name=spec,proto3" json:"spec,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Progress []*ReadinessProgress   `protobuf:"bytes,3,rep,name=progress,proto3" json:"progress,omitempty"`
+	// object is the VLLM resource as the API server rendered it on a dry run.
+	Object map[string]*any1.Any `protobuf:"bytes,4,rep,name=object,proto3" json:"object,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// changes is how the dry-run spec differs from the live one.
+	Changes []*SpecChange `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	// operation_id names the operation following the runtime until it serves;
+	// see GetOperation.
+	OperationId   string `protobuf:"bytes,6,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LLMResponse) Reset() {
+	*x = LLMResponse{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LLMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLMResponse) ProtoMessage() {}
+
+func (x *LLMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLMResponse.ProtoReflect.Descriptor instead.
+func (*LLMResponse) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LLMResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LLMResponse) GetSpec() map[string]*any1.Any {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *LLMResponse) GetProgress() []*ReadinessProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *LLMResponse) GetObject() map[string]*any1.Any {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *LLMResponse) GetChanges() []*SpecChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *LLMResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type ReadinessProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// phase is one of Scheduling, PullingImage, LoadingWeights or Ready.
+	Phase         string               `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message       string               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Time          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadinessProgress) Reset() {
+	*x = ReadinessProgress{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadinessProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadinessProgress) ProtoMessage() {}
+
+func (x *ReadinessProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadinessProgress.ProtoReflect.Descriptor instead.
+func (*ReadinessProgress) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReadinessProgress) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ReadinessProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReadinessProgress) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type ListLLMsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Llms          []*LLMInfo             `protobuf:"bytes,1,rep,name=llms,proto3" json:"llms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLLMsResponse) Reset() {
+	*x = ListLLMsResponse{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLLMsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLLMsResponse) ProtoMessage() {}
+
+func (x *ListLLMsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLLMsResponse.ProtoReflect.Descriptor instead.
+func (*ListLLMsResponse) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListLLMsResponse) GetLlms() []*LLMInfo {
+	if x != nil {
+		return x.Llms
+	}
+	return nil
+}
+
+type LLMInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Replicas      int32                  `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Status        map[string]*any1.Any   `protobuf:"bytes,4,rep,name=status,proto3" json:"status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Stats         *EngineStats           `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LLMInfo) Reset() {
+	*x = LLMInfo{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LLMInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLMInfo) ProtoMessage() {}
+
+func (x *LLMInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLMInfo.ProtoReflect.Descriptor instead.
+func (*LLMInfo) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LLMInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LLMInfo) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *LLMInfo) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *LLMInfo) GetStatus() map[string]*any1.Any {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *LLMInfo) GetStats() *EngineStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type OperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationRequest) Reset() {
+	*x = OperationRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationRequest) ProtoMessage() {}
+
+func (x *OperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationRequest.ProtoReflect.Descriptor instead.
+func (*OperationRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OperationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *OperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WaitOperationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id        string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// timeout_seconds bounds the wait (default 60, at most 600).
+	TimeoutSeconds int32 `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WaitOperationRequest) Reset() {
+	*x = WaitOperationRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitOperationRequest) ProtoMessage() {}
+
+func (x *WaitOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitOperationRequest.ProtoReflect.Descriptor instead.
+func (*WaitOperationRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WaitOperationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WaitOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitOperationRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type ListOperationsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// runtime_name limits the list to operations on one VLLM resource.
+	RuntimeName   string `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationsRequest) Reset() {
+	*x = ListOperationsRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsRequest) ProtoMessage() {}
+
+func (x *ListOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOperationsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetRuntimeName() string {
+	if x != nil {
+		return x.RuntimeName
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListOperationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// operations are ordered newest first.
+	Operations    []*Operation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationsResponse) Reset() {
+	*x = ListOperationsResponse{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsResponse) ProtoMessage() {}
+
+func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListOperationsResponse) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type Operation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Namespace   string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RuntimeName string                 `protobuf:"bytes,3,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	// action is start, create or update.
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// state is Running, Succeeded, Failed or Cancelled.
+	State    string               `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Done     bool                 `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+	Message  string               `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Progress []*ReadinessProgress `protobuf:"bytes,8,rep,name=progress,proto3" json:"progress,omitempty"`
+	// error_code is the Connect code of a failed operation.
+	ErrorCode       string               `protobuf:"bytes,9,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Error           string               `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	CancelRequested bool                 `protobuf:"varint,11,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
+	CreatedAt       *timestamp.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamp.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DoneAt          *timestamp.Timestamp `protobuf:"bytes,14,opt,name=done_at,json=doneAt,proto3" json:"done_at,omitempty"`
+	Deadline        *timestamp.Timestamp `protobuf:"bytes,15,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Operation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Operation) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Operation) GetRuntimeName() string {
+	if x != nil {
+		return x.RuntimeName
+	}
+	return ""
+}
+
+func (x *Operation) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Operation) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Operation) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *Operation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Operation) GetProgress() []*ReadinessProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Operation) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *Operation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Operation) GetCancelRequested() bool {
+	if x != nil {
+		return x.CancelRequested
+	}
+	return false
+}
+
+func (x *Operation) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Operation) GetDoneAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DoneAt
+	}
+	return nil
+}
+
+func (x *Operation) GetDeadline() *timestamp.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// namespace is required; events are listed one namespace at a time.
+	Namespace     string               `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RuntimeName   string               `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	Action        string               `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Caller        string               `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	Since         *timestamp.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Limit         int32                `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListAuditEventsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetRuntimeName() string {
+	if x != nil {
+		return x.RuntimeName
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamp.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time          *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Caller        string                 `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RuntimeName   string                 `protobuf:"bytes,6,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	Resource      string                 `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+	Template      string                 `protobuf:"bytes,8,opt,name=template,proto3" json:"template,omitempty"`
+	Changes       []*SpecChange          `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty"`
+	Outcome       string                 `protobuf:"bytes,10,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error         string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,12,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRuntimeName() string {
+	if x != nil {
+		return x.RuntimeName
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetChanges() []*SpecChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+type SpecChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecChange) Reset() {
+	*x = SpecChange{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecChange) ProtoMessage() {}
+
+func (x *SpecChange) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecChange.ProtoReflect.Descriptor instead.
+func (*SpecChange) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SpecChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SpecChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *SpecChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type GetLLMStatsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// VLLM resource name or spec.runtimeName.
+	RuntimeName   string `protobuf:"bytes,2,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLLMStatsRequest) Reset() {
+	*x = GetLLMStatsRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLLMStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLLMStatsRequest) ProtoMessage() {}
+
+func (x *GetLLMStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLLMStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLLMStatsRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetLLMStatsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetLLMStatsRequest) GetRuntimeName() string {
+	if x != nil {
+		return x.RuntimeName
+	}
+	return ""
+}
+
+type GetLLMStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Stats         *EngineStats           `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLLMStatsResponse) Reset() {
+	*x = GetLLMStatsResponse{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLLMStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLLMStatsResponse) ProtoMessage() {}
+
+func (x *GetLLMStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLLMStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetLLMStatsResponse) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetLLMStatsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetLLMStatsResponse) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GetLLMStatsResponse) GetStats() *EngineStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// EngineStats is scraped from a running engine's /metrics endpoint. Rates and
+// time to first token cover the interval since the previous scrape.
+type EngineStats struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	KvCacheUsage              float64                `protobuf:"fixed64,1,opt,name=kv_cache_usage,json=kvCacheUsage,proto3" json:"kv_cache_usage,omitempty"`
+	RequestsRunning           float64                `protobuf:"fixed64,2,opt,name=requests_running,json=requestsRunning,proto3" json:"requests_running,omitempty"`
+	RequestsWaiting           float64                `protobuf:"fixed64,3,opt,name=requests_waiting,json=requestsWaiting,proto3" json:"requests_waiting,omitempty"`
+	PromptTokensPerSecond     float64                `protobuf:"fixed64,4,opt,name=prompt_tokens_per_second,json=promptTokensPerSecond,proto3" json:"prompt_tokens_per_second,omitempty"`
+	GenerationTokensPerSecond float64                `protobuf:"fixed64,5,opt,name=generation_tokens_per_second,json=generationTokensPerSecond,proto3" json:"generation_tokens_per_second,omitempty"`
+	TimeToFirstTokenSeconds   float64                `protobuf:"fixed64,6,opt,name=time_to_first_token_seconds,json=timeToFirstTokenSeconds,proto3" json:"time_to_first_token_seconds,omitempty"`
+	ScrapedAt                 *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=scraped_at,json=scrapedAt,proto3" json:"scraped_at,omitempty"`
+	ErrorRate                 float64                `protobuf:"fixed64,8,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *EngineStats) Reset() {
+	*x = EngineStats{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngineStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineStats) ProtoMessage() {}
+
+func (x *EngineStats) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineStats.ProtoReflect.Descriptor instead.
+func (*EngineStats) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EngineStats) GetKvCacheUsage() float64 {
+	if x != nil {
+		return x.KvCacheUsage
+	}
+	return 0
+}
+
+func (x *EngineStats) GetRequestsRunning() float64 {
+	if x != nil {
+		return x.RequestsRunning
+	}
+	return 0
+}
+
+func (x *EngineStats) GetRequestsWaiting() float64 {
+	if x != nil {
+		return x.RequestsWaiting
+	}
+	return 0
+}
+
+func (x *EngineStats) GetPromptTokensPerSecond() float64 {
+	if x != nil {
+		return x.PromptTokensPerSecond
+	}
+	return 0
+}
+
+func (x *EngineStats) GetGenerationTokensPerSecond() float64 {
+	if x != nil {
+		return x.GenerationTokensPerSecond
+	}
+	return 0
+}
+
+func (x *EngineStats) GetTimeToFirstTokenSeconds() float64 {
+	if x != nil {
+		return x.TimeToFirstTokenSeconds
+	}
+	return 0
+}
+
+func (x *EngineStats) GetScrapedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ScrapedAt
+	}
+	return nil
+}
+
+func (x *EngineStats) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+type StartRolloutRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name is the VLLM resource currently serving traffic.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// model and image override the current revision; at least one is required.
+	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Image string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	// strategy is Canary (default) or BlueGreen.
+	Strategy string `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// route is the Gateway API HTTPRoute whose backend weights are shifted.
+	Route string `protobuf:"bytes,6,opt,name=route,proto3" json:"route,omitempty"`
+	// steps are the traffic percentages for the new revision, ending at 100.
+	Steps               []int32 `protobuf:"varint,7,rep,packed,name=steps,proto3" json:"steps,omitempty"`
+	StepSeconds         int32   `protobuf:"varint,8,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+	MaxErrorRate        float64 `protobuf:"fixed64,9,opt,name=max_error_rate,json=maxErrorRate,proto3" json:"max_error_rate,omitempty"`
+	MaxTtftSeconds      float64 `protobuf:"fixed64,10,opt,name=max_ttft_seconds,json=maxTtftSeconds,proto3" json:"max_ttft_seconds,omitempty"`
+	ReadyTimeoutSeconds int32   `protobuf:"varint,11,opt,name=ready_timeout_seconds,json=readyTimeoutSeconds,proto3" json:"ready_timeout_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StartRolloutRequest) Reset() {
+	*x = StartRolloutRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRolloutRequest) ProtoMessage() {}
+
+func (x *StartRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRolloutRequest.ProtoReflect.Descriptor instead.
+func (*StartRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StartRolloutRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StartRolloutRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartRolloutRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *StartRolloutRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *StartRolloutRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *StartRolloutRequest) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *StartRolloutRequest) GetSteps() []int32 {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *StartRolloutRequest) GetStepSeconds() int32 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
+}
+
+func (x *StartRolloutRequest) GetMaxErrorRate() float64 {
+	if x != nil {
+		return x.MaxErrorRate
+	}
+	return 0
+}
+
+func (x *StartRolloutRequest) GetMaxTtftSeconds() float64 {
+	if x != nil {
+		return x.MaxTtftSeconds
+	}
+	return 0
+}
+
+func (x *StartRolloutRequest) GetReadyTimeoutSeconds() int32 {
+	if x != nil {
+		return x.ReadyTimeoutSeconds
+	}
+	return 0
+}
+
+type RolloutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloutRequest) Reset() {
+	*x = RolloutRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutRequest) ProtoMessage() {}
+
+func (x *RolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutRequest.ProtoReflect.Descriptor instead.
+func (*RolloutRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RolloutRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RolloutRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Rollout struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Stable    string                 `protobuf:"bytes,2,opt,name=stable,proto3" json:"stable,omitempty"`
+	Canary    string                 `protobuf:"bytes,3,opt,name=canary,proto3" json:"canary,omitempty"`
+	Strategy  string                 `protobuf:"bytes,4,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Route     string                 `protobuf:"bytes,5,opt,name=route,proto3" json:"route,omitempty"`
+	Steps     []int32                `protobuf:"varint,6,rep,packed,name=steps,proto3" json:"steps,omitempty"`
+	Weight    int32                  `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	// phase is Progressing, Succeeded, RolledBack or Aborted.
+	Phase         string               `protobuf:"bytes,8,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message       string               `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	StartedAt     *timestamp.Timestamp `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StepStartedAt *timestamp.Timestamp `protobuf:"bytes,11,opt,name=step_started_at,json=stepStartedAt,proto3" json:"step_started_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rollout) Reset() {
+	*x = Rollout{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rollout) ProtoMessage() {}
+
+func (x *Rollout) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rollout.ProtoReflect.Descriptor instead.
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Rollout) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Rollout) GetStable() string {
+	if x != nil {
+		return x.Stable
+	}
+	return ""
+}
+
+func (x *Rollout) GetCanary() string {
+	if x != nil {
+		return x.Canary
+	}
+	return ""
+}
+
+func (x *Rollout) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *Rollout) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *Rollout) GetSteps() []int32 {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Rollout) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Rollout) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Rollout) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Rollout) GetStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Rollout) GetStepStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StepStartedAt
+	}
+	return nil
+}
+
+type LoadAdapterRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// runtime is the VLLM resource serving the base model.
+	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// storage_uri locates the adapter weights, e.g. file:///models/adapters/sql.
+	StorageUri    string `protobuf:"bytes,4,opt,name=storage_uri,json=storageUri,proto3" json:"storage_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadAdapterRequest) Reset() {
+	*x = LoadAdapterRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadAdapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadAdapterRequest) ProtoMessage() {}
+
+func (x *LoadAdapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadAdapterRequest.ProtoReflect.Descriptor instead.
+func (*LoadAdapterRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LoadAdapterRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *LoadAdapterRequest) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *LoadAdapterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoadAdapterRequest) GetStorageUri() string {
+	if x != nil {
+		return x.StorageUri
+	}
+	return ""
+}
+
+type UnloadAdapterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Runtime       string                 `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnloadAdapterRequest) Reset() {
+	*x = UnloadAdapterRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnloadAdapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnloadAdapterRequest) ProtoMessage() {}
+
+func (x *UnloadAdapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnloadAdapterRequest.ProtoReflect.Descriptor instead.
+func (*UnloadAdapterRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnloadAdapterRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UnloadAdapterRequest) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *UnloadAdapterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListAdaptersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Runtime       string                 `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdaptersRequest) Reset() {
+	*x = ListAdaptersRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdaptersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdaptersRequest) ProtoMessage() {}
+
+func (x *ListAdaptersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdaptersRequest.ProtoReflect.Descriptor instead.
+func (*ListAdaptersRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAdaptersRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListAdaptersRequest) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+type AdaptersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adapters      []*Adapter             `protobuf:"bytes,1,rep,name=adapters,proto3" json:"adapters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdaptersResponse) Reset() {
+	*x = AdaptersResponse{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdaptersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptersResponse) ProtoMessage() {}
+
+func (x *AdaptersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptersResponse.ProtoReflect.Descriptor instead.
+func (*AdaptersResponse) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AdaptersResponse) GetAdapters() []*Adapter {
+	if x != nil {
+		return x.Adapters
+	}
+	return nil
+}
+
+type Adapter struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StorageUri string                 `protobuf:"bytes,2,opt,name=storage_uri,json=storageUri,proto3" json:"storage_uri,omitempty"`
+	// loaded reports whether the engine currently serves the adapter.
+	Loaded        bool `protobuf:"varint,3,opt,name=loaded,proto3" json:"loaded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Adapter) Reset() {
+	*x = Adapter{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Adapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adapter) ProtoMessage() {}
+
+func (x *Adapter) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adapter.ProtoReflect.Descriptor instead.
+func (*Adapter) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Adapter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Adapter) GetStorageUri() string {
+	if x != nil {
+		return x.StorageUri
+	}
+	return ""
+}
+
+func (x *Adapter) GetLoaded() bool {
+	if x != nil {
+		return x.Loaded
+	}
+	return false
+}
+
+type WarmUpRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// node_selector picks the nodes to warm; it defaults to the runtime's
+	// nodeSelector, then to GPU nodes.
+	NodeSelector  map[string]string `protobuf:"bytes,3,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmUpRequest) Reset() {
+	*x = WarmUpRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmUpRequest) ProtoMessage() {}
+
+func (x *WarmUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmUpRequest.ProtoReflect.Descriptor instead.
+func (*WarmUpRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WarmUpRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WarmUpRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WarmUpRequest) GetNodeSelector() map[string]string {
+	if x != nil {
+		return x.NodeSelector
+	}
+	return nil
+}
+
+type GetWarmUpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarmUpRequest) Reset() {
+	*x = GetWarmUpRequest{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarmUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarmUpRequest) ProtoMessage() {}
+
+func (x *GetWarmUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarmUpRequest.ProtoReflect.Descriptor instead.
+func (*GetWarmUpRequest) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetWarmUpRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetWarmUpRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Warmup struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image     string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	// cache_path is the node-local weight cache; empty when only the image is
+	// pre-pulled.
+	CachePath    string            `protobuf:"bytes,4,opt,name=cache_path,json=cachePath,proto3" json:"cache_path,omitempty"`
+	NodeSelector map[string]string `protobuf:"bytes,5,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// phase is Pending, PullingImage, CopyingWeights, Completed or Failed.
+	Phase         string               `protobuf:"bytes,6,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message       string               `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	NodesDesired  int32                `protobuf:"varint,8,opt,name=nodes_desired,json=nodesDesired,proto3" json:"nodes_desired,omitempty"`
+	NodesPulled   int32                `protobuf:"varint,9,opt,name=nodes_pulled,json=nodesPulled,proto3" json:"nodes_pulled,omitempty"`
+	NodesReady    int32                `protobuf:"varint,10,opt,name=nodes_ready,json=nodesReady,proto3" json:"nodes_ready,omitempty"`
+	StartedAt     *timestamp.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamp.Timestamp `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warmup) Reset() {
+	*x = Warmup{}
+	mi := &file_vllm_v1_vllm_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warmup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warmup) ProtoMessage() {}
+
+func (x *Warmup) ProtoReflect() protoreflect.Message {
+	mi := &file_vllm_v1_vllm_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warmup.ProtoReflect.Descriptor instead.
+func (*Warmup) Descriptor() ([]byte, []int) {
+	return file_vllm_v1_vllm_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Warmup) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Warmup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warmup) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Warmup) GetCachePath() string {
+	if x != nil {
+		return x.CachePath
+	}
+	return ""
+}
+
+func (x *Warmup) GetNodeSelector() map[string]string {
+	if x != nil {
+		return x.NodeSelector
+	}
+	return nil
+}
+
+func (x *Warmup) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Warmup) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Warmup) GetNodesDesired() int32 {
+	if x != nil {
+		return x.NodesDesired
+	}
+	return 0
+}
+
+func (x *Warmup) GetNodesPulled() int32 {
+	if x != nil {
+		return x.NodesPulled
+	}
+	return 0
+}
+
+func (x *Warmup) GetNodesReady() int32 {
+	if x != nil {
+		return x.NodesReady
+	}
+	return 0
+}
+
+func (x *Warmup) GetStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Warmup) GetCompletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+var File_vllm_v1_vllm_proto protoreflect.FileDescriptor
+
+const file_vllm_v1_vllm_proto_rawDesc = "" +
+	"\n" +
+	"\x12vllm/v1/vllm.proto\x12\avllm.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x01\n" +
+	"\n" +
+	"LLMRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fruntime_name\x18\x02 \x01(\tR\vruntimeName\x12\x1f\n" +
+	"\breplicas\x18\x03 \x01(\x05H\x00R\breplicas\x88\x01\x01\x12\x12\n" +
+	"\x04wait\x18\x04 \x01(\bR\x04wait\x12'\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x05R\x0etimeoutSeconds\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKeyB\v\n" +
+	"\t_replicas\"\xcb\x02\n" +
+	"\x10UpdateLLMRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fruntime_name\x18\x02 \x01(\tR\vruntimeName\x12\x1f\n" +
+	"\breplicas\x18\x03 \x01(\x05H\x00R\breplicas\x88\x01\x01\x127\n" +
+	"\x04spec\x18\x04 \x03(\v2#.vllm.v1.UpdateLLMRequest.SpecEntryR\x04spec\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x1aM\n" +
+	"\tSpecEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x01B\v\n" +
+	"\t_replicas\"\xe1\x02\n" +
+	"\x10CreateLLMRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
 	"\fruntime_name\x18\x02 \x01(\tR\vruntimeName\x12\x1f\n" +
 	"\breplicas\x18\x03 \x01(\x05H\x00R\breplicas\x88\x01\x01\x127\n" +
-	"\x04spec\x18\x04 \x03(\v2#.vllm.v1.CreateLLMRequest.SpecEntryR\x04spec\x1aM\n" +
+	"\x04spec\x18\x04 \x03(\v2#.vllm.v1.CreateLLMRequest.SpecEntryR\x04spec\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x1aM\n" +
+	"\tSpecEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x01B\v\n" +
+	"\t_replicas\"l\n" +
+	"\x10DeleteLLMRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fruntime_name\x18\x02 \x01(\tR\vruntimeName\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xf8\x01\n" +
+	"\x10BatchLLMsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12#\n" +
+	"\rruntime_names\x18\x02 \x03(\tR\fruntimeNames\x12%\n" +
+	"\x0elabel_selector\x18\x03 \x01(\tR\rlabelSelector\x12 \n" +
+	"\vconcurrency\x18\x04 \x01(\x05R\vconcurrency\x12\x16\n" +
+	"\x06atomic\x18\x05 \x01(\bR\x06atomic\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\"\xba\x03\n" +
+	"\x16BatchUpdateLLMsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12#\n" +
+	"\rruntime_names\x18\x02 \x03(\tR\fruntimeNames\x12%\n" +
+	"\x0elabel_selector\x18\x03 \x01(\tR\rlabelSelector\x12 \n" +
+	"\vconcurrency\x18\x04 \x01(\x05R\vconcurrency\x12\x16\n" +
+	"\x06atomic\x18\x05 \x01(\bR\x06atomic\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\x1f\n" +
+	"\breplicas\x18\a \x01(\x05H\x00R\breplicas\x88\x01\x01\x12=\n" +
+	"\x04spec\x18\b \x03(\v2).vllm.v1.BatchUpdateLLMsRequest.SpecEntryR\x04spec\x12'\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKey\x1aM\n" +
 	"\tSpecEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
 	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x01B\v\n" +
-	"\t_replicas\"/\n" +
+	"\t_replicas\"c\n" +
+	"\x11BatchLLMsResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.vllm.v1.BatchItemResultR\aresults\x12\x1a\n" +
+	"\breverted\x18\x02 \x01(\bR\breverted\"\xed\x01\n" +
+	"\x0fBatchItemResult\x12!\n" +
+	"\fruntime_name\x18\x01 \x01(\tR\vruntimeName\x12\x18\n" +
+	"\aoutcome\x18\x02 \x01(\tR\aoutcome\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12!\n" +
+	"\frevert_error\x18\x05 \x01(\tR\vrevertError\x12-\n" +
+	"\achanges\x18\x06 \x03(\v2\x13.vllm.v1.SpecChangeR\achanges\x12!\n" +
+	"\foperation_id\x18\a \x01(\tR\voperationId\"/\n" +
 	"\x0fListLLMsRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\xaa\x01\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\xbf\x03\n" +
 	"\vLLMResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x122\n" +
-	"\x04spec\x18\x02 \x03(\v2\x1e.vllm.v1.LLMResponse.SpecEntryR\x04spec\x1aM\n" +
+	"\x04spec\x18\x02 \x03(\v2\x1e.vllm.v1.LLMResponse.SpecEntryR\x04spec\x126\n" +
+	"\bprogress\x18\x03 \x03(\v2\x1a.vllm.v1.ReadinessProgressR\bprogress\x128\n" +
+	"\x06object\x18\x04 \x03(\v2 .vllm.v1.LLMResponse.ObjectEntryR\x06object\x12-\n" +
+	"\achanges\x18\x05 \x03(\v2\x13.vllm.v1.SpecChangeR\achanges\x12!\n" +
+	"\foperation_id\x18\x06 \x01(\tR\voperationId\x1aM\n" +
 	"\tSpecEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
-	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x01\"8\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x01\x1aO\n" +
+	"\vObjectEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x01\"s\n" +
+	"\x11ReadinessProgress\x12\x14\n" +
+	"\x05phase\x18\x01 \x01(\tR\x05phase\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"8\n" +
 	"\x10ListLLMsResponse\x12$\n" +
-	"\x04llms\x18\x01 \x03(\v2\x10.vllm.v1.LLMInfoR\x04llms\"\xd6\x01\n" +
+	"\x04llms\x18\x01 \x03(\v2\x10.vllm.v1.LLMInfoR\x04llms\"\x82\x02\n" +
 	"\aLLMInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05model\x18\x02 \x01(\tR\x05model\x12\x1a\n" +
 	"\breplicas\x18\x03 \x01(\x05R\breplicas\x124\n" +
-	"\x06status\x18\x04 \x03(\v2\x1c.vllm.v1.LLMInfo.StatusEntryR\x06status\x1aO\n" +
+	"\x06status\x18\x04 \x03(\v2\x1c.vllm.v1.LLMInfo.StatusEntryR\x06status\x12*\n" +
+	"\x05stats\x18\x05 \x01(\v2\x14.vllm.v1.EngineStatsR\x05stats\x1aO\n" +
 	"\vStatusEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
-	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x012\xa9\x03\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x01\"@\n" +
+	"\x10OperationRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"m\n" +
+	"\x14WaitOperationRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12'\n" +
+	"\x0ftimeout_seconds\x18\x03 \x01(\x05R\x0etimeoutSeconds\"n\n" +
+	"\x15ListOperationsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fruntime_name\x18\x02 \x01(\tR\vruntimeName\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"L\n" +
+	"\x16ListOperationsResponse\x122\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2\x12.vllm.v1.OperationR\n" +
+	"operations\"\xb3\x04\n" +
+	"\tOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12!\n" +
+	"\fruntime_name\x18\x03 \x01(\tR\vruntimeName\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12\x12\n" +
+	"\x04done\x18\x06 \x01(\bR\x04done\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x126\n" +
+	"\bprogress\x18\b \x03(\v2\x1a.vllm.v1.ReadinessProgressR\bprogress\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\t \x01(\tR\terrorCode\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12)\n" +
+	"\x10cancel_requested\x18\v \x01(\bR\x0fcancelRequested\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x123\n" +
+	"\adone_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x06doneAt\x126\n" +
+	"\bdeadline\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\"\xd1\x01\n" +
+	"\x16ListAuditEventsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fruntime_name\x18\x02 \x01(\tR\vruntimeName\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06caller\x18\x04 \x01(\tR\x06caller\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"F\n" +
+	"\x17ListAuditEventsResponse\x12+\n" +
+	"\x06events\x18\x01 \x03(\v2\x13.vllm.v1.AuditEventR\x06events\"\xf3\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x16\n" +
+	"\x06caller\x18\x03 \x01(\tR\x06caller\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12!\n" +
+	"\fruntime_name\x18\x06 \x01(\tR\vruntimeName\x12\x1a\n" +
+	"\bresource\x18\a \x01(\tR\bresource\x12\x1a\n" +
+	"\btemplate\x18\b \x01(\tR\btemplate\x12-\n" +
+	"\achanges\x18\t \x03(\v2\x13.vllm.v1.SpecChangeR\achanges\x12\x18\n" +
+	"\aoutcome\x18\n" +
+	" \x01(\tR\aoutcome\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\f \x01(\x03R\tlatencyMs\"N\n" +
+	"\n" +
+	"SpecChange\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"U\n" +
+	"\x12GetLLMStatsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fruntime_name\x18\x02 \x01(\tR\vruntimeName\"k\n" +
+	"\x13GetLLMStatsResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12*\n" +
+	"\x05stats\x18\x03 \x01(\v2\x14.vllm.v1.EngineStatsR\x05stats\"\x9b\x03\n" +
+	"\vEngineStats\x12$\n" +
+	"\x0ekv_cache_usage\x18\x01 \x01(\x01R\fkvCacheUsage\x12)\n" +
+	"\x10requests_running\x18\x02 \x01(\x01R\x0frequestsRunning\x12)\n" +
+	"\x10requests_waiting\x18\x03 \x01(\x01R\x0frequestsWaiting\x127\n" +
+	"\x18prompt_tokens_per_second\x18\x04 \x01(\x01R\x15promptTokensPerSecond\x12?\n" +
+	"\x1cgeneration_tokens_per_second\x18\x05 \x01(\x01R\x19generationTokensPerSecond\x12<\n" +
+	"\x1btime_to_first_token_seconds\x18\x06 \x01(\x01R\x17timeToFirstTokenSeconds\x129\n" +
+	"\n" +
+	"scraped_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tscrapedAt\x12\x1d\n" +
+	"\n" +
+	"error_rate\x18\b \x01(\x01R\terrorRate\"\xe2\x02\n" +
+	"\x13StartRolloutRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12\x14\n" +
+	"\x05image\x18\x04 \x01(\tR\x05image\x12\x1a\n" +
+	"\bstrategy\x18\x05 \x01(\tR\bstrategy\x12\x14\n" +
+	"\x05route\x18\x06 \x01(\tR\x05route\x12\x14\n" +
+	"\x05steps\x18\a \x03(\x05R\x05steps\x12!\n" +
+	"\fstep_seconds\x18\b \x01(\x05R\vstepSeconds\x12$\n" +
+	"\x0emax_error_rate\x18\t \x01(\x01R\fmaxErrorRate\x12(\n" +
+	"\x10max_ttft_seconds\x18\n" +
+	" \x01(\x01R\x0emaxTtftSeconds\x122\n" +
+	"\x15ready_timeout_seconds\x18\v \x01(\x05R\x13readyTimeoutSeconds\"B\n" +
+	"\x0eRolloutRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xe6\x02\n" +
+	"\aRollout\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06stable\x18\x02 \x01(\tR\x06stable\x12\x16\n" +
+	"\x06canary\x18\x03 \x01(\tR\x06canary\x12\x1a\n" +
+	"\bstrategy\x18\x04 \x01(\tR\bstrategy\x12\x14\n" +
+	"\x05route\x18\x05 \x01(\tR\x05route\x12\x14\n" +
+	"\x05steps\x18\x06 \x03(\x05R\x05steps\x12\x16\n" +
+	"\x06weight\x18\a \x01(\x05R\x06weight\x12\x14\n" +
+	"\x05phase\x18\b \x01(\tR\x05phase\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12B\n" +
+	"\x0fstep_started_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rstepStartedAt\"\x81\x01\n" +
+	"\x12LoadAdapterRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x18\n" +
+	"\aruntime\x18\x02 \x01(\tR\aruntime\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vstorage_uri\x18\x04 \x01(\tR\n" +
+	"storageUri\"b\n" +
+	"\x14UnloadAdapterRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x18\n" +
+	"\aruntime\x18\x02 \x01(\tR\aruntime\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"M\n" +
+	"\x13ListAdaptersRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x18\n" +
+	"\aruntime\x18\x02 \x01(\tR\aruntime\"@\n" +
+	"\x10AdaptersResponse\x12,\n" +
+	"\badapters\x18\x01 \x03(\v2\x10.vllm.v1.AdapterR\badapters\"V\n" +
+	"\aAdapter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vstorage_uri\x18\x02 \x01(\tR\n" +
+	"storageUri\x12\x16\n" +
+	"\x06loaded\x18\x03 \x01(\bR\x06loaded\"\xd1\x01\n" +
+	"\rWarmUpRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12M\n" +
+	"\rnode_selector\x18\x03 \x03(\v2(.vllm.v1.WarmUpRequest.NodeSelectorEntryR\fnodeSelector\x1a?\n" +
+	"\x11NodeSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
+	"\x10GetWarmUpRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x8b\x04\n" +
+	"\x06Warmup\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1d\n" +
+	"\n" +
+	"cache_path\x18\x04 \x01(\tR\tcachePath\x12F\n" +
+	"\rnode_selector\x18\x05 \x03(\v2!.vllm.v1.Warmup.NodeSelectorEntryR\fnodeSelector\x12\x14\n" +
+	"\x05phase\x18\x06 \x01(\tR\x05phase\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12#\n" +
+	"\rnodes_desired\x18\b \x01(\x05R\fnodesDesired\x12!\n" +
+	"\fnodes_pulled\x18\t \x01(\x05R\vnodesPulled\x12\x1f\n" +
+	"\vnodes_ready\x18\n" +
+	" \x01(\x05R\n" +
+	"nodesReady\x129\n" +
+	"\n" +
+	"started_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x1a?\n" +
+	"\x11NodeSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xd7\x10\n" +
 	"\rLLMApiService\x12L\n" +
 	"\bStartLLM\x12\x13.vllm.v1.LLMRequest\x1a\x14.vllm.v1.LLMResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/llm/start\x12J\n" +
 	"\aStopLLM\x12\x13.vllm.v1.LLMRequest\x1a\x14.vllm.v1.LLMResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/llm/stop\x12R\n" +
 	"\bListLLMs\x12\x18.vllm.v1.ListLLMsRequest\x1a\x19.vllm.v1.ListLLMsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/llm/list\x12T\n" +
 	"\tUpdateLLM\x12\x19.vllm.v1.UpdateLLMRequest\x1a\x14.vllm.v1.LLMResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*2\v/llm/update\x12T\n" +
-	"\tCreateLLM\x12\x19.vllm.v1.CreateLLMRequest\x1a\x14.vllm.v1.LLMResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/llm/createB\x1eZ\x1cconnect-go/api/vllmv1;vllmv1b\x06proto3"
+	"\tCreateLLM\x12\x19.vllm.v1.CreateLLMRequest\x1a\x14.vllm.v1.LLMResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/llm/create\x12T\n" +
+	"\tDeleteLLM\x12\x19.vllm.v1.DeleteLLMRequest\x1a\x14.vllm.v1.LLMResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/llm/delete\x12d\n" +
+	"\x0eBatchStartLLMs\x12\x19.vllm.v1.BatchLLMsRequest\x1a\x1a.vllm.v1.BatchLLMsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/llm/batch/start\x12b\n" +
+	"\rBatchStopLLMs\x12\x19.vllm.v1.BatchLLMsRequest\x1a\x1a.vllm.v1.BatchLLMsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/llm/batch/stop\x12l\n" +
+	"\x0fBatchUpdateLLMs\x12\x1f.vllm.v1.BatchUpdateLLMsRequest\x1a\x1a.vllm.v1.BatchLLMsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/llm/batch/update\x12U\n" +
+	"\fGetOperation\x12\x19.vllm.v1.OperationRequest\x1a\x12.vllm.v1.Operation\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/llm/operation\x12j\n" +
+	"\x0eListOperations\x12\x1e.vllm.v1.ListOperationsRequest\x1a\x1f.vllm.v1.ListOperationsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/llm/operations\x12b\n" +
+	"\x0fCancelOperation\x12\x19.vllm.v1.OperationRequest\x1a\x12.vllm.v1.Operation\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/llm/operation/cancel\x12b\n" +
+	"\rWaitOperation\x12\x1d.vllm.v1.WaitOperationRequest\x1a\x12.vllm.v1.Operation\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/llm/operation/wait\x12h\n" +
+	"\x0fListAuditEvents\x12\x1f.vllm.v1.ListAuditEventsRequest\x1a .vllm.v1.ListAuditEventsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/llm/audit\x12\\\n" +
+	"\vGetLLMStats\x12\x1b.vllm.v1.GetLLMStatsRequest\x1a\x1c.vllm.v1.GetLLMStatsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/llm/stats\x12W\n" +
+	"\fStartRollout\x12\x1c.vllm.v1.StartRolloutRequest\x1a\x10.vllm.v1.Rollout\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/llm/rollout\x12M\n" +
+	"\n" +
+	"GetRollout\x12\x17.vllm.v1.RolloutRequest\x1a\x10.vllm.v1.Rollout\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/llm/rollout\x12X\n" +
+	"\fAbortRollout\x12\x17.vllm.v1.RolloutRequest\x1a\x10.vllm.v1.Rollout\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/llm/rollout/abort\x12d\n" +
+	"\vLoadAdapter\x12\x1b.vllm.v1.LoadAdapterRequest\x1a\x19.vllm.v1.AdaptersResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/llm/adapters/load\x12j\n" +
+	"\rUnloadAdapter\x12\x1d.vllm.v1.UnloadAdapterRequest\x1a\x19.vllm.v1.AdaptersResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/llm/adapters/unload\x12^\n" +
+	"\fListAdapters\x12\x1c.vllm.v1.ListAdaptersRequest\x1a\x19.vllm.v1.AdaptersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/llm/adapters\x12I\n" +
+	"\x06WarmUp\x12\x16.vllm.v1.WarmUpRequest\x1a\x0f.vllm.v1.Warmup\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/llm/warmup\x12L\n" +
+	"\tGetWarmUp\x12\x19.vllm.v1.GetWarmUpRequest\x1a\x0f.vllm.v1.Warmup\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/llm/warmupB\x1eZ\x1cconnect-go/api/vllmv1;vllmv1b\x06proto3"
 
 var (
 	file_vllm_v1_vllm_proto_rawDescOnce sync.Once
@@ -494,46 +3107,145 @@ func file_vllm_v1_vllm_proto_rawDescGZIP() []byte {
 	return file_vllm_v1_vllm_proto_rawDescData
 }
 
-var file_vllm_v1_vllm_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_vllm_v1_vllm_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_vllm_v1_vllm_proto_goTypes = []any{
-	(*LLMRequest)(nil),       // 0: vllm.v1.LLMRequest
-	(*UpdateLLMRequest)(nil), // 1: vllm.v1.UpdateLLMRequest
-	(*CreateLLMRequest)(nil), // 2: vllm.v1.CreateLLMRequest
-	(*ListLLMsRequest)(nil),  // 3: vllm.v1.ListLLMsRequest
-	(*LLMResponse)(nil),      // 4: vllm.v1.LLMResponse
-	(*ListLLMsResponse)(nil), // 5: vllm.v1.ListLLMsResponse
-	(*LLMInfo)(nil),          // 6: vllm.v1.LLMInfo
-	nil,                      // 7: vllm.v1.UpdateLLMRequest.SpecEntry
-	nil,                      // 8: vllm.v1.CreateLLMRequest.SpecEntry
-	nil,                      // 9: vllm.v1.LLMResponse.SpecEntry
-	nil,                      // 10: vllm.v1.LLMInfo.StatusEntry
-	(*any1.Any)(nil),         // 11: google.protobuf.Any
+	(*LLMRequest)(nil),              // 0: vllm.v1.LLMRequest
+	(*UpdateLLMRequest)(nil),        // 1: vllm.v1.UpdateLLMRequest
+	(*CreateLLMRequest)(nil),        // 2: vllm.v1.CreateLLMRequest
+	(*DeleteLLMRequest)(nil),        // 3: vllm.v1.DeleteLLMRequest
+	(*BatchLLMsRequest)(nil),        // 4: vllm.v1.BatchLLMsRequest
+	(*BatchUpdateLLMsRequest)(nil),  // 5: vllm.v1.BatchUpdateLLMsRequest
+	(*BatchLLMsResponse)(nil),       // 6: vllm.v1.BatchLLMsResponse
+	(*BatchItemResult)(nil),         // 7: vllm.v1.BatchItemResult
+	(*ListLLMsRequest)(nil),         // 8: vllm.v1.ListLLMsRequest
+	(*LLMResponse)(nil),             // 9: vllm.v1.LLMResponse
+	(*ReadinessProgress)(nil),       // 10: vllm.v1.ReadinessProgress
+	(*ListLLMsResponse)(nil),        // 11: vllm.v1.ListLLMsResponse
+	(*LLMInfo)(nil),                 // 12: vllm.v1.LLMInfo
+	(*OperationRequest)(nil),        // 13: vllm.v1.OperationRequest
+	(*WaitOperationRequest)(nil),    // 14: vllm.v1.WaitOperationRequest
+	(*ListOperationsRequest)(nil),   // 15: vllm.v1.ListOperationsRequest
+	(*ListOperationsResponse)(nil),  // 16: vllm.v1.ListOperationsResponse
+	(*Operation)(nil),               // 17: vllm.v1.Operation
+	(*ListAuditEventsRequest)(nil),  // 18: vllm.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil), // 19: vllm.v1.ListAuditEventsResponse
+	(*AuditEvent)(nil),              // 20: vllm.v1.AuditEvent
+	(*SpecChange)(nil),              // 21: vllm.v1.SpecChange
+	(*GetLLMStatsRequest)(nil),      // 22: vllm.v1.GetLLMStatsRequest
+	(*GetLLMStatsResponse)(nil),     // 23: vllm.v1.GetLLMStatsResponse
+	(*EngineStats)(nil),             // 24: vllm.v1.EngineStats
+	(*StartRolloutRequest)(nil),     // 25: vllm.v1.StartRolloutRequest
+	(*RolloutRequest)(nil),          // 26: vllm.v1.RolloutRequest
+	(*Rollout)(nil),                 // 27: vllm.v1.Rollout
+	(*LoadAdapterRequest)(nil),      // 28: vllm.v1.LoadAdapterRequest
+	(*UnloadAdapterRequest)(nil),    // 29: vllm.v1.UnloadAdapterRequest
+	(*ListAdaptersRequest)(nil),     // 30: vllm.v1.ListAdaptersRequest
+	(*AdaptersResponse)(nil),        // 31: vllm.v1.AdaptersResponse
+	(*Adapter)(nil),                 // 32: vllm.v1.Adapter
+	(*WarmUpRequest)(nil),           // 33: vllm.v1.WarmUpRequest
+	(*GetWarmUpRequest)(nil),        // 34: vllm.v1.GetWarmUpRequest
+	(*Warmup)(nil),                  // 35: vllm.v1.Warmup
+	nil,                             // 36: vllm.v1.UpdateLLMRequest.SpecEntry
+	nil,                             // 37: vllm.v1.CreateLLMRequest.SpecEntry
+	nil,                             // 38: vllm.v1.BatchUpdateLLMsRequest.SpecEntry
+	nil,                             // 39: vllm.v1.LLMResponse.SpecEntry
+	nil,                             // 40: vllm.v1.LLMResponse.ObjectEntry
+	nil,                             // 41: vllm.v1.LLMInfo.StatusEntry
+	nil,                             // 42: vllm.v1.WarmUpRequest.NodeSelectorEntry
+	nil,                             // 43: vllm.v1.Warmup.NodeSelectorEntry
+	(*timestamp.Timestamp)(nil),     // 44: google.protobuf.Timestamp
+	(*any1.Any)(nil),                // 45: google.protobuf.Any
 }
 var file_vllm_v1_vllm_proto_depIdxs = []int32{
-	7,  // 0: vllm.v1.UpdateLLMRequest.spec:type_name -> vllm.v1.UpdateLLMRequest.SpecEntry
-	8,  // 1: vllm.v1.CreateLLMRequest.spec:type_name -> vllm.v1.CreateLLMRequest.SpecEntry
-	9,  // 2: vllm.v1.LLMResponse.spec:type_name -> vllm.v1.LLMResponse.SpecEntry
-	6,  // 3: vllm.v1.ListLLMsResponse.llms:type_name -> vllm.v1.LLMInfo
-	10, // 4: vllm.v1.LLMInfo.status:type_name -> vllm.v1.LLMInfo.StatusEntry
-	11, // 5: vllm.v1.UpdateLLMRequest.SpecEntry.value:type_name -> google.protobuf.Any
-	11, // 6: vllm.v1.CreateLLMRequest.SpecEntry.value:type_name -> google.protobuf.Any
-	11, // 7: vllm.v1.LLMResponse.SpecEntry.value:type_name -> google.protobuf.Any
-	11, // 8: vllm.v1.LLMInfo.StatusEntry.value:type_name -> google.protobuf.Any
-	0,  // 9: vllm.v1.LLMApiService.StartLLM:input_type -> vllm.v1.LLMRequest
-	0,  // 10: vllm.v1.LLMApiService.StopLLM:input_type -> vllm.v1.LLMRequest
-	3,  // 11: vllm.v1.LLMApiService.ListLLMs:input_type -> vllm.v1.ListLLMsRequest
-	1,  // 12: vllm.v1.LLMApiService.UpdateLLM:input_type -> vllm.v1.UpdateLLMRequest
-	2,  // 13: vllm.v1.LLMApiService.CreateLLM:input_type -> vllm.v1.CreateLLMRequest
-	4,  // 14: vllm.v1.LLMApiService.StartLLM:output_type -> vllm.v1.LLMResponse
-	4,  // 15: vllm.v1.LLMApiService.StopLLM:output_type -> vllm.v1.LLMResponse
-	5,  // 16: vllm.v1.LLMApiService.ListLLMs:output_type -> vllm.v1.ListLLMsResponse
-	4,  // 17: vllm.v1.LLMApiService.UpdateLLM:output_type -> vllm.v1.LLMResponse
-	4,  // 18: vllm.v1.LLMApiService.CreateLLM:output_type -> vllm.v1.LLMResponse
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	36, // 0: vllm.v1.UpdateLLMRequest.spec:type_name -> vllm.v1.UpdateLLMRequest.SpecEntry
+	37, // 1: vllm.v1.CreateLLMRequest.spec:type_name -> vllm.v1.CreateLLMRequest.SpecEntry
+	38, // 2: vllm.v1.BatchUpdateLLMsRequest.spec:type_name -> vllm.v1.BatchUpdateLLMsRequest.SpecEntry
+	7,  // 3: vllm.v1.BatchLLMsResponse.results:type_name -> vllm.v1.BatchItemResult
+	21, // 4: vllm.v1.BatchItemResult.changes:type_name -> vllm.v1.SpecChange
+	39, // 5: vllm.v1.LLMResponse.spec:type_name -> vllm.v1.LLMResponse.SpecEntry
+	10, // 6: vllm.v1.LLMResponse.progress:type_name -> vllm.v1.ReadinessProgress
+	40, // 7: vllm.v1.LLMResponse.object:type_name -> vllm.v1.LLMResponse.ObjectEntry
+	21, // 8: vllm.v1.LLMResponse.changes:type_name -> vllm.v1.SpecChange
+	44, // 9: vllm.v1.ReadinessProgress.time:type_name -> google.protobuf.Timestamp
+	12, // 10: vllm.v1.ListLLMsResponse.llms:type_name -> vllm.v1.LLMInfo
+	41, // 11: vllm.v1.LLMInfo.status:type_name -> vllm.v1.LLMInfo.StatusEntry
+	24, // 12: vllm.v1.LLMInfo.stats:type_name -> vllm.v1.EngineStats
+	17, // 13: vllm.v1.ListOperationsResponse.operations:type_name -> vllm.v1.Operation
+	10, // 14: vllm.v1.Operation.progress:type_name -> vllm.v1.ReadinessProgress
+	44, // 15: vllm.v1.Operation.created_at:type_name -> google.protobuf.Timestamp
+	44, // 16: vllm.v1.Operation.updated_at:type_name -> google.protobuf.Timestamp
+	44, // 17: vllm.v1.Operation.done_at:type_name -> google.protobuf.Timestamp
+	44, // 18: vllm.v1.Operation.deadline:type_name -> google.protobuf.Timestamp
+	44, // 19: vllm.v1.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	20, // 20: vllm.v1.ListAuditEventsResponse.events:type_name -> vllm.v1.AuditEvent
+	44, // 21: vllm.v1.AuditEvent.time:type_name -> google.protobuf.Timestamp
+	21, // 22: vllm.v1.AuditEvent.changes:type_name -> vllm.v1.SpecChange
+	24, // 23: vllm.v1.GetLLMStatsResponse.stats:type_name -> vllm.v1.EngineStats
+	44, // 24: vllm.v1.EngineStats.scraped_at:type_name -> google.protobuf.Timestamp
+	44, // 25: vllm.v1.Rollout.started_at:type_name -> google.protobuf.Timestamp
+	44, // 26: vllm.v1.Rollout.step_started_at:type_name -> google.protobuf.Timestamp
+	32, // 27: vllm.v1.AdaptersResponse.adapters:type_name -> vllm.v1.Adapter
+	42, // 28: vllm.v1.WarmUpRequest.node_selector:type_name -> vllm.v1.WarmUpRequest.NodeSelectorEntry
+	43, // 29: vllm.v1.Warmup.node_selector:type_name -> vllm.v1.Warmup.NodeSelectorEntry
+	44, // 30: vllm.v1.Warmup.started_at:type_name -> google.protobuf.Timestamp
+	44, // 31: vllm.v1.Warmup.completed_at:type_name -> google.protobuf.Timestamp
+	45, // 32: vllm.v1.UpdateLLMRequest.SpecEntry.value:type_name -> google.protobuf.Any
+	45, // 33: vllm.v1.CreateLLMRequest.SpecEntry.value:type_name -> google.protobuf.Any
+	45, // 34: vllm.v1.BatchUpdateLLMsRequest.SpecEntry.value:type_name -> google.protobuf.Any
+	45, // 35: vllm.v1.LLMResponse.SpecEntry.value:type_name -> google.protobuf.Any
+	45, // 36: vllm.v1.LLMResponse.ObjectEntry.value:type_name -> google.protobuf.Any
+	45, // 37: vllm.v1.LLMInfo.StatusEntry.value:type_name -> google.protobuf.Any
+	0,  // 38: vllm.v1.LLMApiService.StartLLM:input_type -> vllm.v1.LLMRequest
+	0,  // 39: vllm.v1.LLMApiService.StopLLM:input_type -> vllm.v1.LLMRequest
+	8,  // 40: vllm.v1.LLMApiService.ListLLMs:input_type -> vllm.v1.ListLLMsRequest
+	1,  // 41: vllm.v1.LLMApiService.UpdateLLM:input_type -> vllm.v1.UpdateLLMRequest
+	2,  // 42: vllm.v1.LLMApiService.CreateLLM:input_type -> vllm.v1.CreateLLMRequest
+	3,  // 43: vllm.v1.LLMApiService.DeleteLLM:input_type -> vllm.v1.DeleteLLMRequest
+	4,  // 44: vllm.v1.LLMApiService.BatchStartLLMs:input_type -> vllm.v1.BatchLLMsRequest
+	4,  // 45: vllm.v1.LLMApiService.BatchStopLLMs:input_type -> vllm.v1.BatchLLMsRequest
+	5,  // 46: vllm.v1.LLMApiService.BatchUpdateLLMs:input_type -> vllm.v1.BatchUpdateLLMsRequest
+	13, // 47: vllm.v1.LLMApiService.GetOperation:input_type -> vllm.v1.OperationRequest
+	15, // 48: vllm.v1.LLMApiService.ListOperations:input_type -> vllm.v1.ListOperationsRequest
+	13, // 49: vllm.v1.LLMApiService.CancelOperation:input_type -> vllm.v1.OperationRequest
+	14, // 50: vllm.v1.LLMApiService.WaitOperation:input_type -> vllm.v1.WaitOperationRequest
+	18, // 51: vllm.v1.LLMApiService.ListAuditEvents:input_type -> vllm.v1.ListAuditEventsRequest
+	22, // 52: vllm.v1.LLMApiService.GetLLMStats:input_type -> vllm.v1.GetLLMStatsRequest
+	25, // 53: vllm.v1.LLMApiService.StartRollout:input_type -> vllm.v1.StartRolloutRequest
+	26, // 54: vllm.v1.LLMApiService.GetRollout:input_type -> vllm.v1.RolloutRequest
+	26, // 55: vllm.v1.LLMApiService.AbortRollout:input_type -> vllm.v1.RolloutRequest
+	28, // 56: vllm.v1.LLMApiService.LoadAdapter:input_type -> vllm.v1.LoadAdapterRequest
+	29, // 57: vllm.v1.LLMApiService.UnloadAdapter:input_type -> vllm.v1.UnloadAdapterRequest
+	30, // 58: vllm.v1.LLMApiService.ListAdapters:input_type -> vllm.v1.ListAdaptersRequest
+	33, // 59: vllm.v1.LLMApiService.WarmUp:input_type -> vllm.v1.WarmUpRequest
+	34, // 60: vllm.v1.LLMApiService.GetWarmUp:input_type -> vllm.v1.GetWarmUpRequest
+	9,  // 61: vllm.v1.LLMApiService.StartLLM:output_type -> vllm.v1.LLMResponse
+	9,  // 62: vllm.v1.LLMApiService.StopLLM:output_type -> vllm.v1.LLMResponse
+	11, // 63: vllm.v1.LLMApiService.ListLLMs:output_type -> vllm.v1.ListLLMsResponse
+	9,  // 64: vllm.v1.LLMApiService.UpdateLLM:output_type -> vllm.v1.LLMResponse
+	9,  // 65: vllm.v1.LLMApiService.CreateLLM:output_type -> vllm.v1.LLMResponse
+	9,  // 66: vllm.v1.LLMApiService.DeleteLLM:output_type -> vllm.v1.LLMResponse
+	6,  // 67: vllm.v1.LLMApiService.BatchStartLLMs:output_type -> vllm.v1.BatchLLMsResponse
+	6,  // 68: vllm.v1.LLMApiService.BatchStopLLMs:output_type -> vllm.v1.BatchLLMsResponse
+	6,  // 69: vllm.v1.LLMApiService.BatchUpdateLLMs:output_type -> vllm.v1.BatchLLMsResponse
+	17, // 70: vllm.v1.LLMApiService.GetOperation:output_type -> vllm.v1.Operation
+	16, // 71: vllm.v1.LLMApiService.ListOperations:output_type -> vllm.v1.ListOperationsResponse
+	17, // 72: vllm.v1.LLMApiService.CancelOperation:output_type -> vllm.v1.Operation
+	17, // 73: vllm.v1.LLMApiService.WaitOperation:output_type -> vllm.v1.Operation
+	19, // 74: vllm.v1.LLMApiService.ListAuditEvents:output_type -> vllm.v1.ListAuditEventsResponse
+	23, // 75: vllm.v1.LLMApiService.GetLLMStats:output_type -> vllm.v1.GetLLMStatsResponse
+	27, // 76: vllm.v1.LLMApiService.StartRollout:output_type -> vllm.v1.Rollout
+	27, // 77: vllm.v1.LLMApiService.GetRollout:output_type -> vllm.v1.Rollout
+	27, // 78: vllm.v1.LLMApiService.AbortRollout:output_type -> vllm.v1.Rollout
+	31, // 79: vllm.v1.LLMApiService.LoadAdapter:output_type -> vllm.v1.AdaptersResponse
+	31, // 80: vllm.v1.LLMApiService.UnloadAdapter:output_type -> vllm.v1.AdaptersResponse
+	31, // 81: vllm.v1.LLMApiService.ListAdapters:output_type -> vllm.v1.AdaptersResponse
+	35, // 82: vllm.v1.LLMApiService.WarmUp:output_type -> vllm.v1.Warmup
+	35, // 83: vllm.v1.LLMApiService.GetWarmUp:output_type -> vllm.v1.Warmup
+	61, // [61:84] is the sub-list for method output_type
+	38, // [38:61] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_vllm_v1_vllm_proto_init() }
@@ -544,13 +3256,14 @@ func file_vllm_v1_vllm_proto_init() {
 	file_vllm_v1_vllm_proto_msgTypes[0].OneofWrappers = []any{}
 	file_vllm_v1_vllm_proto_msgTypes[1].OneofWrappers = []any{}
 	file_vllm_v1_vllm_proto_msgTypes[2].OneofWrappers = []any{}
+	file_vllm_v1_vllm_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vllm_v1_vllm_proto_rawDesc), len(file_vllm_v1_vllm_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
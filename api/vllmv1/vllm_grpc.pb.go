@@ -19,11 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LLMApiService_StartLLM_FullMethodName  = "/vllm.v1.LLMApiService/StartLLM"
-	LLMApiService_StopLLM_FullMethodName   = "/vllm.v1.LLMApiService/StopLLM"
-	LLMApiService_ListLLMs_FullMethodName  = "/vllm.v1.LLMApiService/ListLLMs"
-	LLMApiService_UpdateLLM_FullMethodName = "/vllm.v1.LLMApiService/UpdateLLM"
-	LLMApiService_CreateLLM_FullMethodName = "/vllm.v1.LLMApiService/CreateLLM"
+	LLMApiService_StartLLM_FullMethodName        = "/vllm.v1.LLMApiService/StartLLM"
+	LLMApiService_StopLLM_FullMethodName         = "/vllm.v1.LLMApiService/StopLLM"
+	LLMApiService_ListLLMs_FullMethodName        = "/vllm.v1.LLMApiService/ListLLMs"
+	LLMApiService_UpdateLLM_FullMethodName       = "/vllm.v1.LLMApiService/UpdateLLM"
+	LLMApiService_CreateLLM_FullMethodName       = "/vllm.v1.LLMApiService/CreateLLM"
+	LLMApiService_DeleteLLM_FullMethodName       = "/vllm.v1.LLMApiService/DeleteLLM"
+	LLMApiService_BatchStartLLMs_FullMethodName  = "/vllm.v1.LLMApiService/BatchStartLLMs"
+	LLMApiService_BatchStopLLMs_FullMethodName   = "/vllm.v1.LLMApiService/BatchStopLLMs"
+	LLMApiService_BatchUpdateLLMs_FullMethodName = "/vllm.v1.LLMApiService/BatchUpdateLLMs"
+	LLMApiService_GetOperation_FullMethodName    = "/vllm.v1.LLMApiService/GetOperation"
+	LLMApiService_ListOperations_FullMethodName  = "/vllm.v1.LLMApiService/ListOperations"
+	LLMApiService_CancelOperation_FullMethodName = "/vllm.v1.LLMApiService/CancelOperation"
+	LLMApiService_WaitOperation_FullMethodName   = "/vllm.v1.LLMApiService/WaitOperation"
+	LLMApiService_ListAuditEvents_FullMethodName = "/vllm.v1.LLMApiService/ListAuditEvents"
+	LLMApiService_GetLLMStats_FullMethodName     = "/vllm.v1.LLMApiService/GetLLMStats"
+	LLMApiService_StartRollout_FullMethodName    = "/vllm.v1.LLMApiService/StartRollout"
+	LLMApiService_GetRollout_FullMethodName      = "/vllm.v1.LLMApiService/GetRollout"
+	LLMApiService_AbortRollout_FullMethodName    = "/vllm.v1.LLMApiService/AbortRollout"
+	LLMApiService_LoadAdapter_FullMethodName     = "/vllm.v1.LLMApiService/LoadAdapter"
+	LLMApiService_UnloadAdapter_FullMethodName   = "/vllm.v1.LLMApiService/UnloadAdapter"
+	LLMApiService_ListAdapters_FullMethodName    = "/vllm.v1.LLMApiService/ListAdapters"
+	LLMApiService_WarmUp_FullMethodName          = "/vllm.v1.LLMApiService/WarmUp"
+	LLMApiService_GetWarmUp_FullMethodName       = "/vllm.v1.LLMApiService/GetWarmUp"
 )
 
 // LLMApiServiceClient is the client API for LLMApiService service.
@@ -35,6 +53,35 @@ type LLMApiServiceClient interface {
 	ListLLMs(ctx context.Context, in *ListLLMsRequest, opts ...grpc.CallOption) (*ListLLMsResponse, error)
 	UpdateLLM(ctx context.Context, in *UpdateLLMRequest, opts ...grpc.CallOption) (*LLMResponse, error)
 	CreateLLM(ctx context.Context, in *CreateLLMRequest, opts ...grpc.CallOption) (*LLMResponse, error)
+	// DeleteLLM deletes a VLLM resource. The resource is removed once its
+	// runtime is deregistered from the router and its workloads are deleted.
+	DeleteLLM(ctx context.Context, in *DeleteLLMRequest, opts ...grpc.CallOption) (*LLMResponse, error)
+	// BatchStartLLMs starts the selected runtimes that are stopped.
+	BatchStartLLMs(ctx context.Context, in *BatchLLMsRequest, opts ...grpc.CallOption) (*BatchLLMsResponse, error)
+	// BatchStopLLMs stops the selected runtimes that run.
+	BatchStopLLMs(ctx context.Context, in *BatchLLMsRequest, opts ...grpc.CallOption) (*BatchLLMsResponse, error)
+	// BatchUpdateLLMs merges the same spec into each selected runtime.
+	BatchUpdateLLMs(ctx context.Context, in *BatchUpdateLLMsRequest, opts ...grpc.CallOption) (*BatchLLMsResponse, error)
+	// GetOperation returns an operation started by StartLLM, CreateLLM,
+	// UpdateLLM, BatchStartLLMs or BatchUpdateLLMs.
+	GetOperation(ctx context.Context, in *OperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// CancelOperation stops following an operation. The change it follows has
+	// already been applied and is kept.
+	CancelOperation(ctx context.Context, in *OperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// WaitOperation returns the operation once it is done or the timeout has
+	// passed, whichever comes first.
+	WaitOperation(ctx context.Context, in *WaitOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	GetLLMStats(ctx context.Context, in *GetLLMStatsRequest, opts ...grpc.CallOption) (*GetLLMStatsResponse, error)
+	StartRollout(ctx context.Context, in *StartRolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
+	GetRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
+	AbortRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
+	LoadAdapter(ctx context.Context, in *LoadAdapterRequest, opts ...grpc.CallOption) (*AdaptersResponse, error)
+	UnloadAdapter(ctx context.Context, in *UnloadAdapterRequest, opts ...grpc.CallOption) (*AdaptersResponse, error)
+	ListAdapters(ctx context.Context, in *ListAdaptersRequest, opts ...grpc.CallOption) (*AdaptersResponse, error)
+	WarmUp(ctx context.Context, in *WarmUpRequest, opts ...grpc.CallOption) (*Warmup, error)
+	GetWarmUp(ctx context.Context, in *GetWarmUpRequest, opts ...grpc.CallOption) (*Warmup, error)
 }
 
 type lLMApiServiceClient struct {
@@ -95,6 +142,186 @@ func (c *lLMApiServiceClient) CreateLLM(ctx context.Context, in *CreateLLMReques
 	return out, nil
 }
 
+func (c *lLMApiServiceClient) DeleteLLM(ctx context.Context, in *DeleteLLMRequest, opts ...grpc.CallOption) (*LLMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LLMResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_DeleteLLM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) BatchStartLLMs(ctx context.Context, in *BatchLLMsRequest, opts ...grpc.CallOption) (*BatchLLMsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchLLMsResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_BatchStartLLMs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) BatchStopLLMs(ctx context.Context, in *BatchLLMsRequest, opts ...grpc.CallOption) (*BatchLLMsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchLLMsResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_BatchStopLLMs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) BatchUpdateLLMs(ctx context.Context, in *BatchUpdateLLMsRequest, opts ...grpc.CallOption) (*BatchLLMsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchLLMsResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_BatchUpdateLLMs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) GetOperation(ctx context.Context, in *OperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, LLMApiService_GetOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_ListOperations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) CancelOperation(ctx context.Context, in *OperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, LLMApiService_CancelOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) WaitOperation(ctx context.Context, in *WaitOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, LLMApiService_WaitOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) GetLLMStats(ctx context.Context, in *GetLLMStatsRequest, opts ...grpc.CallOption) (*GetLLMStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLLMStatsResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_GetLLMStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) StartRollout(ctx context.Context, in *StartRolloutRequest, opts ...grpc.CallOption) (*Rollout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, LLMApiService_StartRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) GetRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Rollout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, LLMApiService_GetRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) AbortRollout(ctx context.Context, in *RolloutRequest, opts ...grpc.CallOption) (*Rollout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, LLMApiService_AbortRollout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) LoadAdapter(ctx context.Context, in *LoadAdapterRequest, opts ...grpc.CallOption) (*AdaptersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdaptersResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_LoadAdapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) UnloadAdapter(ctx context.Context, in *UnloadAdapterRequest, opts ...grpc.CallOption) (*AdaptersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdaptersResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_UnloadAdapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) ListAdapters(ctx context.Context, in *ListAdaptersRequest, opts ...grpc.CallOption) (*AdaptersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdaptersResponse)
+	err := c.cc.Invoke(ctx, LLMApiService_ListAdapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) WarmUp(ctx context.Context, in *WarmUpRequest, opts ...grpc.CallOption) (*Warmup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Warmup)
+	err := c.cc.Invoke(ctx, LLMApiService_WarmUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lLMApiServiceClient) GetWarmUp(ctx context.Context, in *GetWarmUpRequest, opts ...grpc.CallOption) (*Warmup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Warmup)
+	err := c.cc.Invoke(ctx, LLMApiService_GetWarmUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LLMApiServiceServer is the server API for LLMApiService service.
 // All implementations must embed UnimplementedLLMApiServiceServer
 // for forward compatibility.
@@ -104,6 +331,35 @@ type LLMApiServiceServer interface {
 	ListLLMs(context.Context, *ListLLMsRequest) (*ListLLMsResponse, error)
 	UpdateLLM(context.Context, *UpdateLLMRequest) (*LLMResponse, error)
 	CreateLLM(context.Context, *CreateLLMRequest) (*LLMResponse, error)
+	// DeleteLLM deletes a VLLM resource. The resource is removed once its
+	// runtime is deregistered from the router and its workloads are deleted.
+	DeleteLLM(context.Context, *DeleteLLMRequest) (*LLMResponse, error)
+	// BatchStartLLMs starts the selected runtimes that are stopped.
+	BatchStartLLMs(context.Context, *BatchLLMsRequest) (*BatchLLMsResponse, error)
+	// BatchStopLLMs stops the selected runtimes that run.
+	BatchStopLLMs(context.Context, *BatchLLMsRequest) (*BatchLLMsResponse, error)
+	// BatchUpdateLLMs merges the same spec into each selected runtime.
+	BatchUpdateLLMs(context.Context, *BatchUpdateLLMsRequest) (*BatchLLMsResponse, error)
+	// GetOperation returns an operation started by StartLLM, CreateLLM,
+	// UpdateLLM, BatchStartLLMs or BatchUpdateLLMs.
+	GetOperation(context.Context, *OperationRequest) (*Operation, error)
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// CancelOperation stops following an operation. The change it follows has
+	// already been applied and is kept.
+	CancelOperation(context.Context, *OperationRequest) (*Operation, error)
+	// WaitOperation returns the operation once it is done or the timeout has
+	// passed, whichever comes first.
+	WaitOperation(context.Context, *WaitOperationRequest) (*Operation, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	GetLLMStats(context.Context, *GetLLMStatsRequest) (*GetLLMStatsResponse, error)
+	StartRollout(context.Context, *StartRolloutRequest) (*Rollout, error)
+	GetRollout(context.Context, *RolloutRequest) (*Rollout, error)
+	AbortRollout(context.Context, *RolloutRequest) (*Rollout, error)
+	LoadAdapter(context.Context, *LoadAdapterRequest) (*AdaptersResponse, error)
+	UnloadAdapter(context.Context, *UnloadAdapterRequest) (*AdaptersResponse, error)
+	ListAdapters(context.Context, *ListAdaptersRequest) (*AdaptersResponse, error)
+	WarmUp(context.Context, *WarmUpRequest) (*Warmup, error)
+	GetWarmUp(context.Context, *GetWarmUpRequest) (*Warmup, error)
 	mustEmbedUnimplementedLLMApiServiceServer()
 }
 
@@ -129,6 +385,60 @@ func (UnimplementedLLMApiServiceServer) UpdateLLM(context.Context, *UpdateLLMReq
 func (UnimplementedLLMApiServiceServer) CreateLLM(context.Context, *CreateLLMRequest) (*LLMResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLLM not implemented")
 }
+func (UnimplementedLLMApiServiceServer) DeleteLLM(context.Context, *DeleteLLMRequest) (*LLMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLLM not implemented")
+}
+func (UnimplementedLLMApiServiceServer) BatchStartLLMs(context.Context, *BatchLLMsRequest) (*BatchLLMsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchStartLLMs not implemented")
+}
+func (UnimplementedLLMApiServiceServer) BatchStopLLMs(context.Context, *BatchLLMsRequest) (*BatchLLMsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchStopLLMs not implemented")
+}
+func (UnimplementedLLMApiServiceServer) BatchUpdateLLMs(context.Context, *BatchUpdateLLMsRequest) (*BatchLLMsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateLLMs not implemented")
+}
+func (UnimplementedLLMApiServiceServer) GetOperation(context.Context, *OperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedLLMApiServiceServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedLLMApiServiceServer) CancelOperation(context.Context, *OperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedLLMApiServiceServer) WaitOperation(context.Context, *WaitOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitOperation not implemented")
+}
+func (UnimplementedLLMApiServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedLLMApiServiceServer) GetLLMStats(context.Context, *GetLLMStatsRequest) (*GetLLMStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLLMStats not implemented")
+}
+func (UnimplementedLLMApiServiceServer) StartRollout(context.Context, *StartRolloutRequest) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRollout not implemented")
+}
+func (UnimplementedLLMApiServiceServer) GetRollout(context.Context, *RolloutRequest) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRollout not implemented")
+}
+func (UnimplementedLLMApiServiceServer) AbortRollout(context.Context, *RolloutRequest) (*Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortRollout not implemented")
+}
+func (UnimplementedLLMApiServiceServer) LoadAdapter(context.Context, *LoadAdapterRequest) (*AdaptersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadAdapter not implemented")
+}
+func (UnimplementedLLMApiServiceServer) UnloadAdapter(context.Context, *UnloadAdapterRequest) (*AdaptersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnloadAdapter not implemented")
+}
+func (UnimplementedLLMApiServiceServer) ListAdapters(context.Context, *ListAdaptersRequest) (*AdaptersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdapters not implemented")
+}
+func (UnimplementedLLMApiServiceServer) WarmUp(context.Context, *WarmUpRequest) (*Warmup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmUp not implemented")
+}
+func (UnimplementedLLMApiServiceServer) GetWarmUp(context.Context, *GetWarmUpRequest) (*Warmup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarmUp not implemented")
+}
 func (UnimplementedLLMApiServiceServer) mustEmbedUnimplementedLLMApiServiceServer() {}
 func (UnimplementedLLMApiServiceServer) testEmbeddedByValue()                       {}
 
@@ -240,6 +550,330 @@ func _LLMApiService_CreateLLM_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LLMApiService_DeleteLLM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLLMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).DeleteLLM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_DeleteLLM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).DeleteLLM(ctx, req.(*DeleteLLMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_BatchStartLLMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchLLMsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).BatchStartLLMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_BatchStartLLMs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).BatchStartLLMs(ctx, req.(*BatchLLMsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_BatchStopLLMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchLLMsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).BatchStopLLMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_BatchStopLLMs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).BatchStopLLMs(ctx, req.(*BatchLLMsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_BatchUpdateLLMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateLLMsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).BatchUpdateLLMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_BatchUpdateLLMs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).BatchUpdateLLMs(ctx, req.(*BatchUpdateLLMsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).GetOperation(ctx, req.(*OperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_ListOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).CancelOperation(ctx, req.(*OperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_WaitOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).WaitOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_WaitOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).WaitOperation(ctx, req.(*WaitOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_GetLLMStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLLMStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).GetLLMStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_GetLLMStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).GetLLMStats(ctx, req.(*GetLLMStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_StartRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).StartRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_StartRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).StartRollout(ctx, req.(*StartRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_GetRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).GetRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_GetRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).GetRollout(ctx, req.(*RolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_AbortRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).AbortRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_AbortRollout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).AbortRollout(ctx, req.(*RolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_LoadAdapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadAdapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).LoadAdapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_LoadAdapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).LoadAdapter(ctx, req.(*LoadAdapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_UnloadAdapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnloadAdapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).UnloadAdapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_UnloadAdapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).UnloadAdapter(ctx, req.(*UnloadAdapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_ListAdapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdaptersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).ListAdapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_ListAdapters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).ListAdapters(ctx, req.(*ListAdaptersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_WarmUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).WarmUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_WarmUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).WarmUp(ctx, req.(*WarmUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LLMApiService_GetWarmUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWarmUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LLMApiServiceServer).GetWarmUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LLMApiService_GetWarmUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LLMApiServiceServer).GetWarmUp(ctx, req.(*GetWarmUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LLMApiService_ServiceDesc is the grpc.ServiceDesc for LLMApiService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +901,78 @@ var LLMApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateLLM",
 			Handler:    _LLMApiService_CreateLLM_Handler,
 		},
+		{
+			MethodName: "DeleteLLM",
+			Handler:    _LLMApiService_DeleteLLM_Handler,
+		},
+		{
+			MethodName: "BatchStartLLMs",
+			Handler:    _LLMApiService_BatchStartLLMs_Handler,
+		},
+		{
+			MethodName: "BatchStopLLMs",
+			Handler:    _LLMApiService_BatchStopLLMs_Handler,
+		},
+		{
+			MethodName: "BatchUpdateLLMs",
+			Handler:    _LLMApiService_BatchUpdateLLMs_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _LLMApiService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _LLMApiService_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _LLMApiService_CancelOperation_Handler,
+		},
+		{
+			MethodName: "WaitOperation",
+			Handler:    _LLMApiService_WaitOperation_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _LLMApiService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetLLMStats",
+			Handler:    _LLMApiService_GetLLMStats_Handler,
+		},
+		{
+			MethodName: "StartRollout",
+			Handler:    _LLMApiService_StartRollout_Handler,
+		},
+		{
+			MethodName: "GetRollout",
+			Handler:    _LLMApiService_GetRollout_Handler,
+		},
+		{
+			MethodName: "AbortRollout",
+			Handler:    _LLMApiService_AbortRollout_Handler,
+		},
+		{
+			MethodName: "LoadAdapter",
+			Handler:    _LLMApiService_LoadAdapter_Handler,
+		},
+		{
+			MethodName: "UnloadAdapter",
+			Handler:    _LLMApiService_UnloadAdapter_Handler,
+		},
+		{
+			MethodName: "ListAdapters",
+			Handler:    _LLMApiService_ListAdapters_Handler,
+		},
+		{
+			MethodName: "WarmUp",
+			Handler:    _LLMApiService_WarmUp_Handler,
+		},
+		{
+			MethodName: "GetWarmUp",
+			Handler:    _LLMApiService_GetWarmUp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vllm/v1/vllm.proto",
@@ -43,6 +43,57 @@ const (
 	LLMApiServiceUpdateLLMProcedure = "/vllm.v1.LLMApiService/UpdateLLM"
 	// LLMApiServiceCreateLLMProcedure is the fully-qualified name of the LLMApiService's CreateLLM RPC.
 	LLMApiServiceCreateLLMProcedure = "/vllm.v1.LLMApiService/CreateLLM"
+	// LLMApiServiceDeleteLLMProcedure is the fully-qualified name of the LLMApiService's DeleteLLM RPC.
+	LLMApiServiceDeleteLLMProcedure = "/vllm.v1.LLMApiService/DeleteLLM"
+	// LLMApiServiceBatchStartLLMsProcedure is the fully-qualified name of the LLMApiService's
+	// BatchStartLLMs RPC.
+	LLMApiServiceBatchStartLLMsProcedure = "/vllm.v1.LLMApiService/BatchStartLLMs"
+	// LLMApiServiceBatchStopLLMsProcedure is the fully-qualified name of the LLMApiService's
+	// BatchStopLLMs RPC.
+	LLMApiServiceBatchStopLLMsProcedure = "/vllm.v1.LLMApiService/BatchStopLLMs"
+	// LLMApiServiceBatchUpdateLLMsProcedure is the fully-qualified name of the LLMApiService's
+	// BatchUpdateLLMs RPC.
+	LLMApiServiceBatchUpdateLLMsProcedure = "/vllm.v1.LLMApiService/BatchUpdateLLMs"
+	// LLMApiServiceGetOperationProcedure is the fully-qualified name of the LLMApiService's
+	// GetOperation RPC.
+	LLMApiServiceGetOperationProcedure = "/vllm.v1.LLMApiService/GetOperation"
+	// LLMApiServiceListOperationsProcedure is the fully-qualified name of the LLMApiService's
+	// ListOperations RPC.
+	LLMApiServiceListOperationsProcedure = "/vllm.v1.LLMApiService/ListOperations"
+	// LLMApiServiceCancelOperationProcedure is the fully-qualified name of the LLMApiService's
+	// CancelOperation RPC.
+	LLMApiServiceCancelOperationProcedure = "/vllm.v1.LLMApiService/CancelOperation"
+	// LLMApiServiceWaitOperationProcedure is the fully-qualified name of the LLMApiService's
+	// WaitOperation RPC.
+	LLMApiServiceWaitOperationProcedure = "/vllm.v1.LLMApiService/WaitOperation"
+	// LLMApiServiceListAuditEventsProcedure is the fully-qualified name of the LLMApiService's
+	// ListAuditEvents RPC.
+	LLMApiServiceListAuditEventsProcedure = "/vllm.v1.LLMApiService/ListAuditEvents"
+	// LLMApiServiceGetLLMStatsProcedure is the fully-qualified name of the LLMApiService's GetLLMStats
+	// RPC.
+	LLMApiServiceGetLLMStatsProcedure = "/vllm.v1.LLMApiService/GetLLMStats"
+	// LLMApiServiceStartRolloutProcedure is the fully-qualified name of the LLMApiService's
+	// StartRollout RPC.
+	LLMApiServiceStartRolloutProcedure = "/vllm.v1.LLMApiService/StartRollout"
+	// LLMApiServiceGetRolloutProcedure is the fully-qualified name of the LLMApiService's GetRollout
+	// RPC.
+	LLMApiServiceGetRolloutProcedure = "/vllm.v1.LLMApiService/GetRollout"
+	// LLMApiServiceAbortRolloutProcedure is the fully-qualified name of the LLMApiService's
+	// AbortRollout RPC.
+	LLMApiServiceAbortRolloutProcedure = "/vllm.v1.LLMApiService/AbortRollout"
+	// LLMApiServiceLoadAdapterProcedure is the fully-qualified name of the LLMApiService's LoadAdapter
+	// RPC.
+	LLMApiServiceLoadAdapterProcedure = "/vllm.v1.LLMApiService/LoadAdapter"
+	// LLMApiServiceUnloadAdapterProcedure is the fully-qualified name of the LLMApiService's
+	// UnloadAdapter RPC.
+	LLMApiServiceUnloadAdapterProcedure = "/vllm.v1.LLMApiService/UnloadAdapter"
+	// LLMApiServiceListAdaptersProcedure is the fully-qualified name of the LLMApiService's
+	// ListAdapters RPC.
+	LLMApiServiceListAdaptersProcedure = "/vllm.v1.LLMApiService/ListAdapters"
+	// LLMApiServiceWarmUpProcedure is the fully-qualified name of the LLMApiService's WarmUp RPC.
+	LLMApiServiceWarmUpProcedure = "/vllm.v1.LLMApiService/WarmUp"
+	// LLMApiServiceGetWarmUpProcedure is the fully-qualified name of the LLMApiService's GetWarmUp RPC.
+	LLMApiServiceGetWarmUpProcedure = "/vllm.v1.LLMApiService/GetWarmUp"
 )
 
 // LLMApiServiceClient is a client for the vllm.v1.LLMApiService service.
@@ -52,6 +103,35 @@ type LLMApiServiceClient interface {
 	ListLLMs(context.Context, *connect.Request[vllmv1.ListLLMsRequest]) (*connect.Response[vllmv1.ListLLMsResponse], error)
 	UpdateLLM(context.Context, *connect.Request[vllmv1.UpdateLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error)
 	CreateLLM(context.Context, *connect.Request[vllmv1.CreateLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error)
+	// DeleteLLM deletes a VLLM resource. The resource is removed once its
+	// runtime is deregistered from the router and its workloads are deleted.
+	DeleteLLM(context.Context, *connect.Request[vllmv1.DeleteLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error)
+	// BatchStartLLMs starts the selected runtimes that are stopped.
+	BatchStartLLMs(context.Context, *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error)
+	// BatchStopLLMs stops the selected runtimes that run.
+	BatchStopLLMs(context.Context, *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error)
+	// BatchUpdateLLMs merges the same spec into each selected runtime.
+	BatchUpdateLLMs(context.Context, *connect.Request[vllmv1.BatchUpdateLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error)
+	// GetOperation returns an operation started by StartLLM, CreateLLM,
+	// UpdateLLM, BatchStartLLMs or BatchUpdateLLMs.
+	GetOperation(context.Context, *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error)
+	ListOperations(context.Context, *connect.Request[vllmv1.ListOperationsRequest]) (*connect.Response[vllmv1.ListOperationsResponse], error)
+	// CancelOperation stops following an operation. The change it follows has
+	// already been applied and is kept.
+	CancelOperation(context.Context, *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error)
+	// WaitOperation returns the operation once it is done or the timeout has
+	// passed, whichever comes first.
+	WaitOperation(context.Context, *connect.Request[vllmv1.WaitOperationRequest]) (*connect.Response[vllmv1.Operation], error)
+	ListAuditEvents(context.Context, *connect.Request[vllmv1.ListAuditEventsRequest]) (*connect.Response[vllmv1.ListAuditEventsResponse], error)
+	GetLLMStats(context.Context, *connect.Request[vllmv1.GetLLMStatsRequest]) (*connect.Response[vllmv1.GetLLMStatsResponse], error)
+	StartRollout(context.Context, *connect.Request[vllmv1.StartRolloutRequest]) (*connect.Response[vllmv1.Rollout], error)
+	GetRollout(context.Context, *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error)
+	AbortRollout(context.Context, *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error)
+	LoadAdapter(context.Context, *connect.Request[vllmv1.LoadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error)
+	UnloadAdapter(context.Context, *connect.Request[vllmv1.UnloadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error)
+	ListAdapters(context.Context, *connect.Request[vllmv1.ListAdaptersRequest]) (*connect.Response[vllmv1.AdaptersResponse], error)
+	WarmUp(context.Context, *connect.Request[vllmv1.WarmUpRequest]) (*connect.Response[vllmv1.Warmup], error)
+	GetWarmUp(context.Context, *connect.Request[vllmv1.GetWarmUpRequest]) (*connect.Response[vllmv1.Warmup], error)
 }
 
 // NewLLMApiServiceClient constructs a client for the vllm.v1.LLMApiService service. By default, it
@@ -95,16 +175,142 @@ func NewLLMApiServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(lLMApiServiceMethods.ByName("CreateLLM")),
 			connect.WithClientOptions(opts...),
 		),
+		deleteLLM: connect.NewClient[vllmv1.DeleteLLMRequest, vllmv1.LLMResponse](
+			httpClient,
+			baseURL+LLMApiServiceDeleteLLMProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("DeleteLLM")),
+			connect.WithClientOptions(opts...),
+		),
+		batchStartLLMs: connect.NewClient[vllmv1.BatchLLMsRequest, vllmv1.BatchLLMsResponse](
+			httpClient,
+			baseURL+LLMApiServiceBatchStartLLMsProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("BatchStartLLMs")),
+			connect.WithClientOptions(opts...),
+		),
+		batchStopLLMs: connect.NewClient[vllmv1.BatchLLMsRequest, vllmv1.BatchLLMsResponse](
+			httpClient,
+			baseURL+LLMApiServiceBatchStopLLMsProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("BatchStopLLMs")),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdateLLMs: connect.NewClient[vllmv1.BatchUpdateLLMsRequest, vllmv1.BatchLLMsResponse](
+			httpClient,
+			baseURL+LLMApiServiceBatchUpdateLLMsProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("BatchUpdateLLMs")),
+			connect.WithClientOptions(opts...),
+		),
+		getOperation: connect.NewClient[vllmv1.OperationRequest, vllmv1.Operation](
+			httpClient,
+			baseURL+LLMApiServiceGetOperationProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("GetOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		listOperations: connect.NewClient[vllmv1.ListOperationsRequest, vllmv1.ListOperationsResponse](
+			httpClient,
+			baseURL+LLMApiServiceListOperationsProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("ListOperations")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelOperation: connect.NewClient[vllmv1.OperationRequest, vllmv1.Operation](
+			httpClient,
+			baseURL+LLMApiServiceCancelOperationProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("CancelOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		waitOperation: connect.NewClient[vllmv1.WaitOperationRequest, vllmv1.Operation](
+			httpClient,
+			baseURL+LLMApiServiceWaitOperationProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("WaitOperation")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[vllmv1.ListAuditEventsRequest, vllmv1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+LLMApiServiceListAuditEventsProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("ListAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		getLLMStats: connect.NewClient[vllmv1.GetLLMStatsRequest, vllmv1.GetLLMStatsResponse](
+			httpClient,
+			baseURL+LLMApiServiceGetLLMStatsProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("GetLLMStats")),
+			connect.WithClientOptions(opts...),
+		),
+		startRollout: connect.NewClient[vllmv1.StartRolloutRequest, vllmv1.Rollout](
+			httpClient,
+			baseURL+LLMApiServiceStartRolloutProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("StartRollout")),
+			connect.WithClientOptions(opts...),
+		),
+		getRollout: connect.NewClient[vllmv1.RolloutRequest, vllmv1.Rollout](
+			httpClient,
+			baseURL+LLMApiServiceGetRolloutProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("GetRollout")),
+			connect.WithClientOptions(opts...),
+		),
+		abortRollout: connect.NewClient[vllmv1.RolloutRequest, vllmv1.Rollout](
+			httpClient,
+			baseURL+LLMApiServiceAbortRolloutProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("AbortRollout")),
+			connect.WithClientOptions(opts...),
+		),
+		loadAdapter: connect.NewClient[vllmv1.LoadAdapterRequest, vllmv1.AdaptersResponse](
+			httpClient,
+			baseURL+LLMApiServiceLoadAdapterProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("LoadAdapter")),
+			connect.WithClientOptions(opts...),
+		),
+		unloadAdapter: connect.NewClient[vllmv1.UnloadAdapterRequest, vllmv1.AdaptersResponse](
+			httpClient,
+			baseURL+LLMApiServiceUnloadAdapterProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("UnloadAdapter")),
+			connect.WithClientOptions(opts...),
+		),
+		listAdapters: connect.NewClient[vllmv1.ListAdaptersRequest, vllmv1.AdaptersResponse](
+			httpClient,
+			baseURL+LLMApiServiceListAdaptersProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("ListAdapters")),
+			connect.WithClientOptions(opts...),
+		),
+		warmUp: connect.NewClient[vllmv1.WarmUpRequest, vllmv1.Warmup](
+			httpClient,
+			baseURL+LLMApiServiceWarmUpProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("WarmUp")),
+			connect.WithClientOptions(opts...),
+		),
+		getWarmUp: connect.NewClient[vllmv1.GetWarmUpRequest, vllmv1.Warmup](
+			httpClient,
+			baseURL+LLMApiServiceGetWarmUpProcedure,
+			connect.WithSchema(lLMApiServiceMethods.ByName("GetWarmUp")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // lLMApiServiceClient implements LLMApiServiceClient.
 type lLMApiServiceClient struct {
-	startLLM  *connect.Client[vllmv1.LLMRequest, vllmv1.LLMResponse]
-	stopLLM   *connect.Client[vllmv1.LLMRequest, vllmv1.LLMResponse]
-	listLLMs  *connect.Client[vllmv1.ListLLMsRequest, vllmv1.ListLLMsResponse]
-	updateLLM *connect.Client[vllmv1.UpdateLLMRequest, vllmv1.LLMResponse]
-	createLLM *connect.Client[vllmv1.CreateLLMRequest, vllmv1.LLMResponse]
+	startLLM        *connect.Client[vllmv1.LLMRequest, vllmv1.LLMResponse]
+	stopLLM         *connect.Client[vllmv1.LLMRequest, vllmv1.LLMResponse]
+	listLLMs        *connect.Client[vllmv1.ListLLMsRequest, vllmv1.ListLLMsResponse]
+	updateLLM       *connect.Client[vllmv1.UpdateLLMRequest, vllmv1.LLMResponse]
+	createLLM       *connect.Client[vllmv1.CreateLLMRequest, vllmv1.LLMResponse]
+	deleteLLM       *connect.Client[vllmv1.DeleteLLMRequest, vllmv1.LLMResponse]
+	batchStartLLMs  *connect.Client[vllmv1.BatchLLMsRequest, vllmv1.BatchLLMsResponse]
+	batchStopLLMs   *connect.Client[vllmv1.BatchLLMsRequest, vllmv1.BatchLLMsResponse]
+	batchUpdateLLMs *connect.Client[vllmv1.BatchUpdateLLMsRequest, vllmv1.BatchLLMsResponse]
+	getOperation    *connect.Client[vllmv1.OperationRequest, vllmv1.Operation]
+	listOperations  *connect.Client[vllmv1.ListOperationsRequest, vllmv1.ListOperationsResponse]
+	cancelOperation *connect.Client[vllmv1.OperationRequest, vllmv1.Operation]
+	waitOperation   *connect.Client[vllmv1.WaitOperationRequest, vllmv1.Operation]
+	listAuditEvents *connect.Client[vllmv1.ListAuditEventsRequest, vllmv1.ListAuditEventsResponse]
+	getLLMStats     *connect.Client[vllmv1.GetLLMStatsRequest, vllmv1.GetLLMStatsResponse]
+	startRollout    *connect.Client[vllmv1.StartRolloutRequest, vllmv1.Rollout]
+	getRollout      *connect.Client[vllmv1.RolloutRequest, vllmv1.Rollout]
+	abortRollout    *connect.Client[vllmv1.RolloutRequest, vllmv1.Rollout]
+	loadAdapter     *connect.Client[vllmv1.LoadAdapterRequest, vllmv1.AdaptersResponse]
+	unloadAdapter   *connect.Client[vllmv1.UnloadAdapterRequest, vllmv1.AdaptersResponse]
+	listAdapters    *connect.Client[vllmv1.ListAdaptersRequest, vllmv1.AdaptersResponse]
+	warmUp          *connect.Client[vllmv1.WarmUpRequest, vllmv1.Warmup]
+	getWarmUp       *connect.Client[vllmv1.GetWarmUpRequest, vllmv1.Warmup]
 }
 
 // StartLLM calls vllm.v1.LLMApiService.StartLLM.
@@ -132,6 +338,96 @@ func (c *lLMApiServiceClient) CreateLLM(ctx context.Context, req *connect.Reques
 	return c.createLLM.CallUnary(ctx, req)
 }
 
+// DeleteLLM calls vllm.v1.LLMApiService.DeleteLLM.
+func (c *lLMApiServiceClient) DeleteLLM(ctx context.Context, req *connect.Request[vllmv1.DeleteLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error) {
+	return c.deleteLLM.CallUnary(ctx, req)
+}
+
+// BatchStartLLMs calls vllm.v1.LLMApiService.BatchStartLLMs.
+func (c *lLMApiServiceClient) BatchStartLLMs(ctx context.Context, req *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+	return c.batchStartLLMs.CallUnary(ctx, req)
+}
+
+// BatchStopLLMs calls vllm.v1.LLMApiService.BatchStopLLMs.
+func (c *lLMApiServiceClient) BatchStopLLMs(ctx context.Context, req *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+	return c.batchStopLLMs.CallUnary(ctx, req)
+}
+
+// BatchUpdateLLMs calls vllm.v1.LLMApiService.BatchUpdateLLMs.
+func (c *lLMApiServiceClient) BatchUpdateLLMs(ctx context.Context, req *connect.Request[vllmv1.BatchUpdateLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+	return c.batchUpdateLLMs.CallUnary(ctx, req)
+}
+
+// GetOperation calls vllm.v1.LLMApiService.GetOperation.
+func (c *lLMApiServiceClient) GetOperation(ctx context.Context, req *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error) {
+	return c.getOperation.CallUnary(ctx, req)
+}
+
+// ListOperations calls vllm.v1.LLMApiService.ListOperations.
+func (c *lLMApiServiceClient) ListOperations(ctx context.Context, req *connect.Request[vllmv1.ListOperationsRequest]) (*connect.Response[vllmv1.ListOperationsResponse], error) {
+	return c.listOperations.CallUnary(ctx, req)
+}
+
+// CancelOperation calls vllm.v1.LLMApiService.CancelOperation.
+func (c *lLMApiServiceClient) CancelOperation(ctx context.Context, req *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error) {
+	return c.cancelOperation.CallUnary(ctx, req)
+}
+
+// WaitOperation calls vllm.v1.LLMApiService.WaitOperation.
+func (c *lLMApiServiceClient) WaitOperation(ctx context.Context, req *connect.Request[vllmv1.WaitOperationRequest]) (*connect.Response[vllmv1.Operation], error) {
+	return c.waitOperation.CallUnary(ctx, req)
+}
+
+// ListAuditEvents calls vllm.v1.LLMApiService.ListAuditEvents.
+func (c *lLMApiServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[vllmv1.ListAuditEventsRequest]) (*connect.Response[vllmv1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// GetLLMStats calls vllm.v1.LLMApiService.GetLLMStats.
+func (c *lLMApiServiceClient) GetLLMStats(ctx context.Context, req *connect.Request[vllmv1.GetLLMStatsRequest]) (*connect.Response[vllmv1.GetLLMStatsResponse], error) {
+	return c.getLLMStats.CallUnary(ctx, req)
+}
+
+// StartRollout calls vllm.v1.LLMApiService.StartRollout.
+func (c *lLMApiServiceClient) StartRollout(ctx context.Context, req *connect.Request[vllmv1.StartRolloutRequest]) (*connect.Response[vllmv1.Rollout], error) {
+	return c.startRollout.CallUnary(ctx, req)
+}
+
+// GetRollout calls vllm.v1.LLMApiService.GetRollout.
+func (c *lLMApiServiceClient) GetRollout(ctx context.Context, req *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error) {
+	return c.getRollout.CallUnary(ctx, req)
+}
+
+// AbortRollout calls vllm.v1.LLMApiService.AbortRollout.
+func (c *lLMApiServiceClient) AbortRollout(ctx context.Context, req *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error) {
+	return c.abortRollout.CallUnary(ctx, req)
+}
+
+// LoadAdapter calls vllm.v1.LLMApiService.LoadAdapter.
+func (c *lLMApiServiceClient) LoadAdapter(ctx context.Context, req *connect.Request[vllmv1.LoadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error) {
+	return c.loadAdapter.CallUnary(ctx, req)
+}
+
+// UnloadAdapter calls vllm.v1.LLMApiService.UnloadAdapter.
+func (c *lLMApiServiceClient) UnloadAdapter(ctx context.Context, req *connect.Request[vllmv1.UnloadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error) {
+	return c.unloadAdapter.CallUnary(ctx, req)
+}
+
+// ListAdapters calls vllm.v1.LLMApiService.ListAdapters.
+func (c *lLMApiServiceClient) ListAdapters(ctx context.Context, req *connect.Request[vllmv1.ListAdaptersRequest]) (*connect.Response[vllmv1.AdaptersResponse], error) {
+	return c.listAdapters.CallUnary(ctx, req)
+}
+
+// WarmUp calls vllm.v1.LLMApiService.WarmUp.
+func (c *lLMApiServiceClient) WarmUp(ctx context.Context, req *connect.Request[vllmv1.WarmUpRequest]) (*connect.Response[vllmv1.Warmup], error) {
+	return c.warmUp.CallUnary(ctx, req)
+}
+
+// GetWarmUp calls vllm.v1.LLMApiService.GetWarmUp.
+func (c *lLMApiServiceClient) GetWarmUp(ctx context.Context, req *connect.Request[vllmv1.GetWarmUpRequest]) (*connect.Response[vllmv1.Warmup], error) {
+	return c.getWarmUp.CallUnary(ctx, req)
+}
+
 // LLMApiServiceHandler is an implementation of the vllm.v1.LLMApiService service.
 type LLMApiServiceHandler interface {
 	StartLLM(context.Context, *connect.Request[vllmv1.LLMRequest]) (*connect.Response[vllmv1.LLMResponse], error)
@@ -139,6 +435,35 @@ type LLMApiServiceHandler interface {
 	ListLLMs(context.Context, *connect.Request[vllmv1.ListLLMsRequest]) (*connect.Response[vllmv1.ListLLMsResponse], error)
 	UpdateLLM(context.Context, *connect.Request[vllmv1.UpdateLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error)
 	CreateLLM(context.Context, *connect.Request[vllmv1.CreateLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error)
+	// DeleteLLM deletes a VLLM resource. The resource is removed once its
+	// runtime is deregistered from the router and its workloads are deleted.
+	DeleteLLM(context.Context, *connect.Request[vllmv1.DeleteLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error)
+	// BatchStartLLMs starts the selected runtimes that are stopped.
+	BatchStartLLMs(context.Context, *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error)
+	// BatchStopLLMs stops the selected runtimes that run.
+	BatchStopLLMs(context.Context, *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error)
+	// BatchUpdateLLMs merges the same spec into each selected runtime.
+	BatchUpdateLLMs(context.Context, *connect.Request[vllmv1.BatchUpdateLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error)
+	// GetOperation returns an operation started by StartLLM, CreateLLM,
+	// UpdateLLM, BatchStartLLMs or BatchUpdateLLMs.
+	GetOperation(context.Context, *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error)
+	ListOperations(context.Context, *connect.Request[vllmv1.ListOperationsRequest]) (*connect.Response[vllmv1.ListOperationsResponse], error)
+	// CancelOperation stops following an operation. The change it follows has
+	// already been applied and is kept.
+	CancelOperation(context.Context, *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error)
+	// WaitOperation returns the operation once it is done or the timeout has
+	// passed, whichever comes first.
+	WaitOperation(context.Context, *connect.Request[vllmv1.WaitOperationRequest]) (*connect.Response[vllmv1.Operation], error)
+	ListAuditEvents(context.Context, *connect.Request[vllmv1.ListAuditEventsRequest]) (*connect.Response[vllmv1.ListAuditEventsResponse], error)
+	GetLLMStats(context.Context, *connect.Request[vllmv1.GetLLMStatsRequest]) (*connect.Response[vllmv1.GetLLMStatsResponse], error)
+	StartRollout(context.Context, *connect.Request[vllmv1.StartRolloutRequest]) (*connect.Response[vllmv1.Rollout], error)
+	GetRollout(context.Context, *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error)
+	AbortRollout(context.Context, *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error)
+	LoadAdapter(context.Context, *connect.Request[vllmv1.LoadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error)
+	UnloadAdapter(context.Context, *connect.Request[vllmv1.UnloadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error)
+	ListAdapters(context.Context, *connect.Request[vllmv1.ListAdaptersRequest]) (*connect.Response[vllmv1.AdaptersResponse], error)
+	WarmUp(context.Context, *connect.Request[vllmv1.WarmUpRequest]) (*connect.Response[vllmv1.Warmup], error)
+	GetWarmUp(context.Context, *connect.Request[vllmv1.GetWarmUpRequest]) (*connect.Response[vllmv1.Warmup], error)
 }
 
 // NewLLMApiServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -178,6 +503,114 @@ func NewLLMApiServiceHandler(svc LLMApiServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(lLMApiServiceMethods.ByName("CreateLLM")),
 		connect.WithHandlerOptions(opts...),
 	)
+	lLMApiServiceDeleteLLMHandler := connect.NewUnaryHandler(
+		LLMApiServiceDeleteLLMProcedure,
+		svc.DeleteLLM,
+		connect.WithSchema(lLMApiServiceMethods.ByName("DeleteLLM")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceBatchStartLLMsHandler := connect.NewUnaryHandler(
+		LLMApiServiceBatchStartLLMsProcedure,
+		svc.BatchStartLLMs,
+		connect.WithSchema(lLMApiServiceMethods.ByName("BatchStartLLMs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceBatchStopLLMsHandler := connect.NewUnaryHandler(
+		LLMApiServiceBatchStopLLMsProcedure,
+		svc.BatchStopLLMs,
+		connect.WithSchema(lLMApiServiceMethods.ByName("BatchStopLLMs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceBatchUpdateLLMsHandler := connect.NewUnaryHandler(
+		LLMApiServiceBatchUpdateLLMsProcedure,
+		svc.BatchUpdateLLMs,
+		connect.WithSchema(lLMApiServiceMethods.ByName("BatchUpdateLLMs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceGetOperationHandler := connect.NewUnaryHandler(
+		LLMApiServiceGetOperationProcedure,
+		svc.GetOperation,
+		connect.WithSchema(lLMApiServiceMethods.ByName("GetOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceListOperationsHandler := connect.NewUnaryHandler(
+		LLMApiServiceListOperationsProcedure,
+		svc.ListOperations,
+		connect.WithSchema(lLMApiServiceMethods.ByName("ListOperations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceCancelOperationHandler := connect.NewUnaryHandler(
+		LLMApiServiceCancelOperationProcedure,
+		svc.CancelOperation,
+		connect.WithSchema(lLMApiServiceMethods.ByName("CancelOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceWaitOperationHandler := connect.NewUnaryHandler(
+		LLMApiServiceWaitOperationProcedure,
+		svc.WaitOperation,
+		connect.WithSchema(lLMApiServiceMethods.ByName("WaitOperation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		LLMApiServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(lLMApiServiceMethods.ByName("ListAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceGetLLMStatsHandler := connect.NewUnaryHandler(
+		LLMApiServiceGetLLMStatsProcedure,
+		svc.GetLLMStats,
+		connect.WithSchema(lLMApiServiceMethods.ByName("GetLLMStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceStartRolloutHandler := connect.NewUnaryHandler(
+		LLMApiServiceStartRolloutProcedure,
+		svc.StartRollout,
+		connect.WithSchema(lLMApiServiceMethods.ByName("StartRollout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceGetRolloutHandler := connect.NewUnaryHandler(
+		LLMApiServiceGetRolloutProcedure,
+		svc.GetRollout,
+		connect.WithSchema(lLMApiServiceMethods.ByName("GetRollout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceAbortRolloutHandler := connect.NewUnaryHandler(
+		LLMApiServiceAbortRolloutProcedure,
+		svc.AbortRollout,
+		connect.WithSchema(lLMApiServiceMethods.ByName("AbortRollout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceLoadAdapterHandler := connect.NewUnaryHandler(
+		LLMApiServiceLoadAdapterProcedure,
+		svc.LoadAdapter,
+		connect.WithSchema(lLMApiServiceMethods.ByName("LoadAdapter")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceUnloadAdapterHandler := connect.NewUnaryHandler(
+		LLMApiServiceUnloadAdapterProcedure,
+		svc.UnloadAdapter,
+		connect.WithSchema(lLMApiServiceMethods.ByName("UnloadAdapter")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceListAdaptersHandler := connect.NewUnaryHandler(
+		LLMApiServiceListAdaptersProcedure,
+		svc.ListAdapters,
+		connect.WithSchema(lLMApiServiceMethods.ByName("ListAdapters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceWarmUpHandler := connect.NewUnaryHandler(
+		LLMApiServiceWarmUpProcedure,
+		svc.WarmUp,
+		connect.WithSchema(lLMApiServiceMethods.ByName("WarmUp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lLMApiServiceGetWarmUpHandler := connect.NewUnaryHandler(
+		LLMApiServiceGetWarmUpProcedure,
+		svc.GetWarmUp,
+		connect.WithSchema(lLMApiServiceMethods.ByName("GetWarmUp")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/vllm.v1.LLMApiService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case LLMApiServiceStartLLMProcedure:
@@ -190,6 +623,42 @@ func NewLLMApiServiceHandler(svc LLMApiServiceHandler, opts ...connect.HandlerOp
 			lLMApiServiceUpdateLLMHandler.ServeHTTP(w, r)
 		case LLMApiServiceCreateLLMProcedure:
 			lLMApiServiceCreateLLMHandler.ServeHTTP(w, r)
+		case LLMApiServiceDeleteLLMProcedure:
+			lLMApiServiceDeleteLLMHandler.ServeHTTP(w, r)
+		case LLMApiServiceBatchStartLLMsProcedure:
+			lLMApiServiceBatchStartLLMsHandler.ServeHTTP(w, r)
+		case LLMApiServiceBatchStopLLMsProcedure:
+			lLMApiServiceBatchStopLLMsHandler.ServeHTTP(w, r)
+		case LLMApiServiceBatchUpdateLLMsProcedure:
+			lLMApiServiceBatchUpdateLLMsHandler.ServeHTTP(w, r)
+		case LLMApiServiceGetOperationProcedure:
+			lLMApiServiceGetOperationHandler.ServeHTTP(w, r)
+		case LLMApiServiceListOperationsProcedure:
+			lLMApiServiceListOperationsHandler.ServeHTTP(w, r)
+		case LLMApiServiceCancelOperationProcedure:
+			lLMApiServiceCancelOperationHandler.ServeHTTP(w, r)
+		case LLMApiServiceWaitOperationProcedure:
+			lLMApiServiceWaitOperationHandler.ServeHTTP(w, r)
+		case LLMApiServiceListAuditEventsProcedure:
+			lLMApiServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case LLMApiServiceGetLLMStatsProcedure:
+			lLMApiServiceGetLLMStatsHandler.ServeHTTP(w, r)
+		case LLMApiServiceStartRolloutProcedure:
+			lLMApiServiceStartRolloutHandler.ServeHTTP(w, r)
+		case LLMApiServiceGetRolloutProcedure:
+			lLMApiServiceGetRolloutHandler.ServeHTTP(w, r)
+		case LLMApiServiceAbortRolloutProcedure:
+			lLMApiServiceAbortRolloutHandler.ServeHTTP(w, r)
+		case LLMApiServiceLoadAdapterProcedure:
+			lLMApiServiceLoadAdapterHandler.ServeHTTP(w, r)
+		case LLMApiServiceUnloadAdapterProcedure:
+			lLMApiServiceUnloadAdapterHandler.ServeHTTP(w, r)
+		case LLMApiServiceListAdaptersProcedure:
+			lLMApiServiceListAdaptersHandler.ServeHTTP(w, r)
+		case LLMApiServiceWarmUpProcedure:
+			lLMApiServiceWarmUpHandler.ServeHTTP(w, r)
+		case LLMApiServiceGetWarmUpProcedure:
+			lLMApiServiceGetWarmUpHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -218,3 +687,75 @@ func (UnimplementedLLMApiServiceHandler) UpdateLLM(context.Context, *connect.Req
 func (UnimplementedLLMApiServiceHandler) CreateLLM(context.Context, *connect.Request[vllmv1.CreateLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.CreateLLM is not implemented"))
 }
+
+func (UnimplementedLLMApiServiceHandler) DeleteLLM(context.Context, *connect.Request[vllmv1.DeleteLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.DeleteLLM is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) BatchStartLLMs(context.Context, *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.BatchStartLLMs is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) BatchStopLLMs(context.Context, *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.BatchStopLLMs is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) BatchUpdateLLMs(context.Context, *connect.Request[vllmv1.BatchUpdateLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.BatchUpdateLLMs is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) GetOperation(context.Context, *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.GetOperation is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) ListOperations(context.Context, *connect.Request[vllmv1.ListOperationsRequest]) (*connect.Response[vllmv1.ListOperationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.ListOperations is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) CancelOperation(context.Context, *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.CancelOperation is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) WaitOperation(context.Context, *connect.Request[vllmv1.WaitOperationRequest]) (*connect.Response[vllmv1.Operation], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.WaitOperation is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) ListAuditEvents(context.Context, *connect.Request[vllmv1.ListAuditEventsRequest]) (*connect.Response[vllmv1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) GetLLMStats(context.Context, *connect.Request[vllmv1.GetLLMStatsRequest]) (*connect.Response[vllmv1.GetLLMStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.GetLLMStats is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) StartRollout(context.Context, *connect.Request[vllmv1.StartRolloutRequest]) (*connect.Response[vllmv1.Rollout], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.StartRollout is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) GetRollout(context.Context, *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.GetRollout is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) AbortRollout(context.Context, *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.AbortRollout is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) LoadAdapter(context.Context, *connect.Request[vllmv1.LoadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.LoadAdapter is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) UnloadAdapter(context.Context, *connect.Request[vllmv1.UnloadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.UnloadAdapter is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) ListAdapters(context.Context, *connect.Request[vllmv1.ListAdaptersRequest]) (*connect.Response[vllmv1.AdaptersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.ListAdapters is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) WarmUp(context.Context, *connect.Request[vllmv1.WarmUpRequest]) (*connect.Response[vllmv1.Warmup], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.WarmUp is not implemented"))
+}
+
+func (UnimplementedLLMApiServiceHandler) GetWarmUp(context.Context, *connect.Request[vllmv1.GetWarmUpRequest]) (*connect.Response[vllmv1.Warmup], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vllm.v1.LLMApiService.GetWarmUp is not implemented"))
+}
@@ -1,23 +1,54 @@
 package main
 
 import (
+	"cmp"
 	"context"
+	"crypto/tls"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
+	_ "time/tzdata" // schedules may name any IANA time zone
 
 	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	greetv1 "connect-go/api/greetv1"
 	greetv1connect "connect-go/api/greetv1/greetv1connect"
+	"connect-go/api/vllm/client/clientset/versioned"
+	"connect-go/api/vllm/client/informers/externalversions"
+	"connect-go/api/vllmv1/vllmv1connect"
 	vllmApp "connect-go/internal/app/vllm"
+	authIface "connect-go/internal/cmd/auth"
+	logIface "connect-go/internal/cmd/logging"
+	metricsIface "connect-go/internal/cmd/metrics"
+	tracingIface "connect-go/internal/cmd/tracing"
 	vllmIface "connect-go/internal/cmd/vllm"
+	auditCore "connect-go/internal/core/audit"
+	authCore "connect-go/internal/core/auth"
+	logCore "connect-go/internal/core/logging"
+	metricsCore "connect-go/internal/core/metrics"
+	vllmCore "connect-go/internal/core/vllm"
+	auditInfra "connect-go/internal/data/audit"
+	authInfra "connect-go/internal/data/auth"
+	metricsInfra "connect-go/internal/data/metrics"
+	tracingInfra "connect-go/internal/data/tracing"
 	vllmInfra "connect-go/internal/data/vllm"
 )
 
@@ -27,7 +58,7 @@ func (s *GreetServer) Greet(
 	ctx context.Context,
 	req *connect.Request[greetv1.GreetRequest],
 ) (*connect.Response[greetv1.GreetResponse], error) {
-	log.Println("Request headers: ", req.Header())
+	slog.DebugContext(ctx, "greet request", "headers", logCore.RedactHeaders(req.Header()))
 	res := connect.NewResponse(&greetv1.GreetResponse{
 		Greeting: fmt.Sprintf("Hello, %s!", req.Msg.Name),
 	})
@@ -35,53 +66,487 @@ func (s *GreetServer) Greet(
 	return res, nil
 }
 
+// shutdownTimeout bounds how long a terminating server waits for its
+// controllers to stop and its requests to drain, within the pod's default
+// 30 second grace period.
+const shutdownTimeout = 25 * time.Second
+
 func main() {
+	slog.SetDefault(logCore.New(os.Stderr, os.Getenv("LOG_LEVEL"), os.Getenv("LOG_FORMAT")))
+
+	// ctx is cancelled on SIGTERM or an interrupt and stops everything the
+	// server runs in the background.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
 	// vllm production stack router endpoint
 	vllmAPIEndpoint := os.Getenv("VLLM_ROUTER_ENDPOINT")
 	if vllmAPIEndpoint == "" {
 		vllmAPIEndpoint = "http://vllm-router-service:80"
 	}
 
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "" {
+		exporter, err := tracingInfra.NewOTLPExporter(context.Background())
+		if err != nil {
+			fatal("failed to configure tracing", err)
+		}
+		tp, err := tracingInfra.NewProvider(context.Background(), "connect-go", exporter)
+		if err != nil {
+			fatal("failed to configure tracing", err)
+		}
+		otel.SetTracerProvider(tp)
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := tp.Shutdown(ctx); err != nil {
+				slog.Error("failed to flush traces", "error", err)
+			}
+		}()
+	}
+
 	config, err := rest.InClusterConfig()
 	if err != nil {
-		log.Fatalf("Failed to get in-cluster config: %v", err)
+		fatal("failed to get in-cluster config", err)
 	}
+	config.Wrap(metricsInfra.InstrumentTransport)
+	config.Wrap(logCore.RequestIDTransport)
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
-		log.Fatalf("Failed to create Kubernetes client: %v", err)
+		fatal("failed to create Kubernetes client", err)
 	}
 
-	vllmAPI := &vllmInfra.VLLMAPI{Endpoint: vllmAPIEndpoint}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		fatal("failed to create dynamic Kubernetes client", err)
+	}
+
+	auditRecorders, auditStore, err := newAudit(clientset, dynamicClient)
+	if err != nil {
+		fatal("failed to configure audit log", err)
+	}
+
+	storage, err := newStorageResolver(clientset)
+	if err != nil {
+		fatal("failed to configure model storage", err)
+	}
+	// The controllers and the scraper list VLLM resources from one shared
+	// informer cache instead of polling the API server.
+	vllmClientset, err := versioned.NewForConfig(config)
+	if err != nil {
+		fatal("failed to create VLLM client", err)
+	}
+	informers := externalversions.NewSharedInformerFactory(vllmClientset, 10*time.Minute)
+	vllms := informers.Vllm().V1().VLLMs()
+	vllmAPI := &vllmInfra.VLLMAPI{Endpoint: vllmAPIEndpoint, Client: dynamicClient, Storage: storage, Lister: vllms.Lister()}
+	runtimeWatcher, err := metricsInfra.NewRuntimeWatcher(vllms.Informer())
+	if err != nil {
+		fatal("failed to create VLLM runtime watcher", err)
+	}
+	metricsCore.Registry.MustRegister(runtimeWatcher)
+	informers.Start(ctx.Done())
+	for informer, synced := range informers.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			fatal("failed to sync VLLM informer", fmt.Errorf("%v did not sync", informer))
+		}
+	}
 	vllmRepo := vllmInfra.NewK8sVLLMRepository(clientset, config)
-	vllmService := vllmApp.NewVLLMServiceImpl(vllmAPI, vllmRepo)
-	vllmHandler := vllmIface.NewVLLMHandler(vllmService)
+	// The scraper only reads and serves stats to requests, so every replica
+	// runs it: GetStats answers from the replica's own samples. Engines are
+	// therefore scraped once per replica each interval. The scraper exports no
+	// series of its own, so nothing is counted twice on /metrics.
+	engineScraper := vllmInfra.NewEngineScraper(clientset, vllmAPI, nil, 15*time.Second)
+	go func() {
+		if err := engineScraper.Run(ctx); err != nil {
+			slog.Error("engine metrics scraper stopped", "error", err)
+		}
+	}()
+	// controllers change the VLLM resources and what they run, so only the
+	// replica holding the leader Lease runs them; every replica serves requests.
+	var controllers []controller
+	// Every replica sees the same runtimes; only the leader reports them.
+	controllers = append(controllers, controller{"runtime metrics", runtimeWatcher.Run})
+	autoscaler := vllmApp.NewAutoscaler(vllmAPI, engineScraper, 30*time.Second)
+	controllers = append(controllers, controller{"autoscaler", autoscaler.Run})
+	readiness := vllmInfra.NewPodReadinessChecker(clientset, dynamicClient, nil)
+	conditions := vllmApp.NewConditionsController(vllmAPI, readiness, 15*time.Second)
+	controllers = append(controllers, controller{"conditions controller", conditions.Run})
+	router, err := newRouter(clientset)
+	if err != nil {
+		fatal("failed to configure router integration", err)
+	}
+	if router != nil {
+		routers := vllmApp.NewRouterController(vllmAPI, router, 15*time.Second)
+		controllers = append(controllers, controller{"router controller", routers.Run})
+	}
+	vllmService := vllmApp.NewVLLMServiceImpl(vllmAPI, vllmRepo, auditRecorders, engineScraper, readiness, router)
+	scheduler := vllmApp.NewScheduler(vllmService, vllmAPI, 30*time.Second)
+	controllers = append(controllers, controller{"scheduler", scheduler.Run})
+	idempotency, err := newIdempotencyGuard(clientset)
+	if err != nil {
+		fatal("failed to configure idempotency keys", err)
+	}
+	controllers = append(controllers, controller{"idempotency key pruner", func(ctx context.Context) error {
+		return idempotency.Run(ctx, 10*time.Minute)
+	}})
+	vllmHandler := vllmIface.NewVLLMHandler(vllmService, idempotency)
+	rollouts := vllmApp.NewRolloutController(vllmAPI, vllmInfra.NewGatewayRouter(dynamicClient), readiness, engineScraper, auditRecorders, 30*time.Second)
+	controllers = append(controllers, controller{"rollout controller", rollouts.Run})
+	adapters := vllmApp.NewAdapterManager(vllmAPI, vllmInfra.NewLoRAClient(nil), auditRecorders)
+	warmer := vllmInfra.NewWarmer(clientset, vllmAPI, storage)
+	warmups := vllmApp.NewWarmupController(vllmAPI, warmer, auditRecorders, 15*time.Second)
+	controllers = append(controllers, controller{"warm-up controller", warmups.Run})
+	workloads := vllmApp.NewWorkloadController(vllmAPI, vllmInfra.NewWorkloadSyncer(clientset), 15*time.Second)
+	controllers = append(controllers, controller{"workload controller", workloads.Run})
+	cleanup := vllmApp.NewCleanupController(vllmAPI, vllmInfra.NewCleaner(clientset, warmer, router), 15*time.Second)
+	controllers = append(controllers, controller{"cleanup controller", cleanup.Run})
+	batch := vllmApp.NewBatchRunner(vllmService, vllmAPI)
+	hostname, err := os.Hostname()
+	if err != nil {
+		fatal("failed to determine replica name", err)
+	}
+	// Each replica follows the operations it began, and takeovers of orphaned
+	// ones are conditional writes, so the manager runs on every replica.
+	operations := vllmApp.NewOperationManager(vllmInfra.NewConfigMapOperationStore(clientset), readiness, hostname, 10*time.Second)
+	go func() {
+		if err := operations.Run(ctx); err != nil {
+			slog.Error("operation manager stopped", "error", err)
+		}
+	}()
+	controllersDone := make(chan struct{})
+	go func() {
+		defer close(controllersDone)
+		runControllers(ctx, clientset, hostname, controllers)
+	}()
+	llmServer := vllmIface.NewLLMApiServer(vllmService, auditStore, rollouts, adapters, warmups, batch, operations, idempotency)
+
+	authn, err := newAuthenticator(clientset)
+	if err != nil {
+		fatal("failed to configure authentication", err)
+	}
+	var policy *authCore.Policy
+	if policyFile := os.Getenv("AUTH_POLICY_FILE"); policyFile != "" {
+		if policy, err = authInfra.LoadPolicy(policyFile); err != nil {
+			fatal("failed to load authorization policy", err)
+		}
+	}
+	tracingInterceptor, err := tracingIface.NewInterceptor()
+	if err != nil {
+		fatal("failed to configure tracing", err)
+	}
+	handlerOpts := []connect.HandlerOption{connect.WithInterceptors(tracingInterceptor, metricsIface.NewInterceptor())}
+	if authn != nil {
+		handlerOpts = append(handlerOpts, connect.WithInterceptors(authIface.NewInterceptor(authn, policy)))
+	}
 
 	mux := http.NewServeMux()
 	greeter := &GreetServer{}
-	path, handler := greetv1connect.NewGreetServiceHandler(greeter)
-	log.Println("Registering gRPC handler for path: ", path)
+	path, handler := greetv1connect.NewGreetServiceHandler(greeter, handlerOpts...)
+	slog.Info("registering Connect handler", "path", path)
+	mux.Handle(path, handler)
+	path, handler = vllmv1connect.NewLLMApiServiceHandler(llmServer, handlerOpts...)
+	slog.Info("registering Connect handler", "path", path)
 	mux.Handle(path, handler)
 
-	mux.HandleFunc("/v1/vllm/start", vllmHandler.Start)
-	mux.HandleFunc("/v1/vllm/stop", vllmHandler.Stop)
-	mux.HandleFunc("/v1/vllm/get", vllmHandler.Get)
+	for route, h := range map[string]http.HandlerFunc{
+		"/v1/vllm/start":  vllmHandler.Start,
+		"/v1/vllm/stop":   vllmHandler.Stop,
+		"/v1/vllm/create": vllmHandler.Create,
+		"/v1/vllm/update": vllmHandler.Update,
+		"/v1/vllm/delete": vllmHandler.Delete,
+		"/v1/vllm/get":    vllmHandler.Get,
+	} {
+		mux.Handle(route, tracingIface.InstrumentHandler(route, metricsIface.InstrumentHandler(route, h)))
+	}
+
+	var api http.Handler = mux
+	if authn != nil {
+		api = authIface.NewMiddleware(authn, policy, map[string]authCore.Action{
+			"/v1/vllm/start":  authCore.ActionStart,
+			"/v1/vllm/stop":   authCore.ActionStop,
+			"/v1/vllm/create": authCore.ActionCreate,
+			"/v1/vllm/update": authCore.ActionUpdate,
+			"/v1/vllm/delete": authCore.ActionDelete,
+			"/v1/vllm/get":    authCore.ActionList,
+		}).Wrap(mux)
+		if policy == nil {
+			slog.Warn("AUTH_POLICY_FILE not set; every authenticated caller may perform every action")
+		}
+	} else {
+		slog.Warn("no authenticators configured; the management API is unauthenticated")
+	}
+
+	// /metrics is served outside the authenticated API so Prometheus can scrape it.
+	root := http.NewServeMux()
+	root.Handle("/metrics", metricsIface.Handler())
+	root.Handle("/", api)
 
 	server := &http.Server{
-		Addr:    "localhost:8799",
-		Handler: h2c.NewHandler(mux, &http2.Server{}),
+		Addr:     cmp.Or(os.Getenv("LISTEN_ADDR"), ":8080"),
+		Handler:  h2c.NewHandler(logIface.Middleware(root), &http2.Server{}),
+		ErrorLog: slog.NewLogLogger(slog.Default().Handler(), slog.LevelWarn),
+	}
+	certFile, keyFile := os.Getenv("TLS_CERT_FILE"), os.Getenv("TLS_KEY_FILE")
+	if caFile := os.Getenv("AUTH_CLIENT_CA_FILE"); caFile != "" {
+		// Client certificates only reach the server over TLS; without it no
+		// caller could ever authenticate with one.
+		if certFile == "" || keyFile == "" {
+			fatal("failed to configure client certificate authentication", fmt.Errorf("AUTH_CLIENT_CA_FILE requires TLS_CERT_FILE and TLS_KEY_FILE"))
+		}
+		clientCAs, err := authInfra.LoadClientCAs(caFile)
+		if err != nil {
+			fatal("failed to load client CAs", err)
+		}
+		server.TLSConfig = &tls.Config{
+			ClientCAs:  clientCAs,
+			ClientAuth: tls.VerifyClientCertIfGiven,
+		}
+	}
+	if certDir := os.Getenv("WEBHOOK_CERT_DIR"); certDir != "" {
+		ctrllog.SetLogger(logr.FromSlogHandler(slog.Default().Handler()))
+		hooks := webhook.NewServer(webhook.Options{Port: 9443, CertDir: certDir})
+		vllmIface.RegisterWebhooks(hooks)
+		slog.Info("starting admission webhooks", "port", 9443)
+		go func() {
+			if err := hooks.Start(ctx); err != nil {
+				fatal("admission webhook server stopped", err)
+			}
+		}()
 	}
-	log.Println("Starting server on localhost:8799")
+
+	slog.Info("starting server", "addr", server.Addr)
 	go func() {
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Error starting server: %v", err)
+		var err error
+		if certFile != "" && keyFile != "" {
+			err = server.ListenAndServeTLS(certFile, keyFile)
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			fatal("error starting server", err)
 		}
 	}()
 
-	// 優雅關閉（簡化示例，實際應處理信號）
-	time.Sleep(time.Hour)
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	<-ctx.Done()
+	stop()
+	slog.Info("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
-	if err := server.Shutdown(ctx); err != nil {
-		log.Printf("Server shutdown error: %v", err)
+	// The controllers stop first, so the Lease is released and another
+	// replica takes over while this one drains its requests.
+	select {
+	case <-controllersDone:
+	case <-shutdownCtx.Done():
+		slog.Warn("controllers did not stop in time")
+	}
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("server shutdown error", "error", err)
+	}
+}
+
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// newAuthenticator builds the authenticator chain from the AUTH_* environment
+// variables. It returns nil when no authentication method is configured.
+func newAuthenticator(clientset kubernetes.Interface) (authCore.Authenticator, error) {
+	var union authCore.Union
+	if caFile := os.Getenv("AUTH_CLIENT_CA_FILE"); caFile != "" {
+		union = append(union, authInfra.NewClientCertAuthenticator())
+	}
+	if tokenFile := os.Getenv("AUTH_TOKEN_FILE"); tokenFile != "" {
+		a, err := authInfra.NewStaticTokenAuthenticator(tokenFile)
+		if err != nil {
+			return nil, err
+		}
+		union = append(union, a)
+	}
+	if jwksFile := os.Getenv("AUTH_OIDC_JWKS_FILE"); jwksFile != "" {
+		a, err := authInfra.NewJWTAuthenticator(jwksFile, os.Getenv("AUTH_OIDC_ISSUER"), os.Getenv("AUTH_OIDC_AUDIENCE"))
+		if err != nil {
+			return nil, err
+		}
+		if claim := os.Getenv("AUTH_OIDC_USERNAME_CLAIM"); claim != "" {
+			a.UsernameClaim = claim
+		}
+		if claim := os.Getenv("AUTH_OIDC_GROUPS_CLAIM"); claim != "" {
+			a.GroupsClaim = claim
+		}
+		union = append(union, a)
+	}
+	if os.Getenv("AUTH_TOKEN_REVIEW") == "true" {
+		var audiences []string
+		if v := os.Getenv("AUTH_TOKEN_REVIEW_AUDIENCES"); v != "" {
+			audiences = strings.Split(v, ",")
+		}
+		union = append(union, authInfra.NewTokenReviewAuthenticator(clientset, audiences))
+	}
+	if len(union) == 0 {
+		return nil, nil
+	}
+	return union, nil
+}
+
+// newAudit builds the audit sinks from the AUDIT_* environment variables. The
+// returned store answers ListAuditEvents: the JSON lines file when
+// AUDIT_LOG_FILE is set, which replicas only share on a common volume;
+// otherwise the Kubernetes Events, which every replica shares. With
+// AUDIT_KUBERNETES_EVENTS=false as well, it falls back to an in-memory buffer
+// of recent events, which only suits a single replica.
+func newAudit(clientset kubernetes.Interface, dynamicClient dynamic.Interface) (auditCore.Recorders, auditCore.Store, error) {
+	var recorders auditCore.Recorders
+	var store auditCore.Store
+	if logFile := os.Getenv("AUDIT_LOG_FILE"); logFile != "" {
+		sink, err := auditInfra.NewFileSink(logFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		recorders = append(recorders, sink)
+		store = sink
+	}
+	if os.Getenv("AUDIT_KUBERNETES_EVENTS") != "false" {
+		events := auditInfra.NewKubernetesEventSink(clientset, dynamicClient)
+		recorders = append(recorders, events)
+		if store == nil {
+			store = events
+		}
+	}
+	if store == nil {
+		if os.Getenv("LEADER_ELECTION") != "false" {
+			return nil, nil, fmt.Errorf("AUDIT_KUBERNETES_EVENTS=false without AUDIT_LOG_FILE keeps the audit history in memory, which only suits a single replica; set LEADER_ELECTION=false to run one")
+		}
+		memory := auditInfra.NewMemoryStore(1000)
+		recorders = append(recorders, memory)
+		store = memory
+	}
+	return recorders, store, nil
+}
+
+// newRouter configures how runtimes are registered with the router from the
+// VLLM_ROUTER_* environment variables. VLLM_ROUTER_DISCOVERY selects the
+// router's service discovery: "static" keeps its dynamic config in the
+// ConfigMap VLLM_ROUTER_CONFIGMAP (namespace/name), "k8s" labels runtime pods
+// with the selector in VLLM_ROUTER_LABELS. Unset, runtimes are not registered
+// and newRouter returns nil.
+func newRouter(clientset kubernetes.Interface) (vllmInfra.ModelRouter, error) {
+	switch discovery := os.Getenv("VLLM_ROUTER_DISCOVERY"); discovery {
+	case "":
+		return nil, nil
+	case vllmCore.RouterDiscoveryStatic:
+		ref := os.Getenv("VLLM_ROUTER_CONFIGMAP")
+		if ref == "" {
+			ref = "default/vllm-router-dynamic-config"
+		}
+		namespace, name, ok := strings.Cut(ref, "/")
+		if !ok || namespace == "" || name == "" {
+			return nil, fmt.Errorf("VLLM_ROUTER_CONFIGMAP must be namespace/name, got %q", ref)
+		}
+		return vllmInfra.NewStaticRouter(clientset, namespace, name), nil
+	case vllmCore.RouterDiscoveryKubernetes:
+		set, err := labels.ConvertSelectorToLabelsMap(os.Getenv("VLLM_ROUTER_LABELS"))
+		if err != nil || len(set) == 0 {
+			return nil, fmt.Errorf("VLLM_ROUTER_LABELS must be the router's --k8s-label-selector, such as environment=prod,release=router, got %q", os.Getenv("VLLM_ROUTER_LABELS"))
+		}
+		return vllmInfra.NewLabelRouter(clientset, set), nil
+	default:
+		return nil, fmt.Errorf("VLLM_ROUTER_DISCOVERY must be %q or %q, got %q", vllmCore.RouterDiscoveryStatic, vllmCore.RouterDiscoveryKubernetes, discovery)
+	}
+}
+
+// controller is a background loop that must not run on two replicas at once.
+type controller struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// runControllers runs the controllers while this replica holds the Lease
+// LEADER_ELECTION_LEASE (default connect-go-leader) in POD_NAMESPACE (default
+// "default"), and stops them when it loses it. LEADER_ELECTION=false runs them
+// unconditionally, for a single replica. It returns once ctx is cancelled and
+// the controllers have stopped, with the Lease released.
+func runControllers(ctx context.Context, clientset kubernetes.Interface, identity string, controllers []controller) {
+	lead := func(ctx context.Context) {
+		var wg sync.WaitGroup
+		for _, c := range controllers {
+			wg.Go(func() {
+				if err := c.run(ctx); err != nil {
+					slog.Error("controller stopped", "controller", c.name, "error", err)
+				}
+			})
+		}
+		wg.Wait()
+	}
+	if os.Getenv("LEADER_ELECTION") == "false" {
+		lead(ctx)
+		return
+	}
+	namespace := cmp.Or(os.Getenv("POD_NAMESPACE"), "default")
+	lease := cmp.Or(os.Getenv("LEADER_ELECTION_LEASE"), "connect-go-leader")
+	elector := vllmInfra.NewLeaderElector(clientset, namespace, lease, identity)
+	if err := elector.Run(ctx, lead); err != nil {
+		fatal("leader election stopped", err)
+	}
+}
+
+// newIdempotencyGuard configures where idempotency keys are remembered from
+// the VLLM_IDEMPOTENCY_* environment variables. VLLM_IDEMPOTENCY_STORE is
+// "memory" (the default), which only deduplicates retries reaching the same
+// replica, or "configmaps", which keeps each key in a ConfigMap of the
+// request's namespace for all replicas. VLLM_IDEMPOTENCY_WINDOW is how long
+// keys are remembered.
+func newIdempotencyGuard(clientset kubernetes.Interface) (*vllmApp.IdempotencyGuard, error) {
+	var store vllmInfra.IdempotencyStore
+	switch kind := os.Getenv("VLLM_IDEMPOTENCY_STORE"); kind {
+	case "", vllmCore.IdempotencyStoreMemory:
+		store = vllmInfra.NewMemoryIdempotencyStore()
+	case vllmCore.IdempotencyStoreConfigMaps:
+		store = vllmInfra.NewConfigMapIdempotencyStore(clientset)
+	default:
+		return nil, fmt.Errorf("VLLM_IDEMPOTENCY_STORE must be %q or %q, got %q", vllmCore.IdempotencyStoreMemory, vllmCore.IdempotencyStoreConfigMaps, kind)
+	}
+	guard := vllmApp.NewIdempotencyGuard(store)
+	if v := os.Getenv("VLLM_IDEMPOTENCY_WINDOW"); v != "" {
+		window, err := time.ParseDuration(v)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("VLLM_IDEMPOTENCY_WINDOW must be a positive duration such as 24h, got %q", v)
+		}
+		guard.Window = window
+	}
+	return guard, nil
+}
+
+// newStorageResolver configures how spec.storageUri is checked and mounted.
+// pvc:// and hf:// are always checked; file:// only when the models are visible
+// to the server under STORAGE_FILE_ROOT, and s3:// only when S3_ENDPOINT is set.
+// With MODEL_CACHE_DIR, downloaded weights go to that directory on each node so
+// warm-ups and later starts share them.
+func newStorageResolver(clientset kubernetes.Interface) (*vllmInfra.StorageResolver, error) {
+	resolver := &vllmInfra.StorageResolver{
+		Stores: map[string]vllmInfra.ArtifactStore{
+			vllmCore.SchemePVC: vllmInfra.PVCStore{Clientset: clientset},
+			vllmCore.SchemeHF:  vllmInfra.HFStore{Endpoint: os.Getenv("HF_ENDPOINT"), Token: os.Getenv("HF_TOKEN")},
+		},
+		S3FetchImage: os.Getenv("S3_FETCH_IMAGE"),
+		S3Secret:     os.Getenv("S3_CREDENTIALS_SECRET"),
+		HFSecret:     os.Getenv("HF_TOKEN_SECRET"),
+		NodeCacheDir: os.Getenv("MODEL_CACHE_DIR"),
+	}
+	if root := os.Getenv("STORAGE_FILE_ROOT"); root != "" {
+		resolver.Stores[vllmCore.SchemeFile] = vllmInfra.FileStore{Root: root}
+	}
+	if endpoint := os.Getenv("S3_ENDPOINT"); endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("S3_ENDPOINT must be a URL such as https://minio.storage:9000, got %q", endpoint)
+		}
+		store, err := vllmInfra.NewS3Store(u.Host, os.Getenv("S3_ACCESS_KEY_ID"), os.Getenv("S3_SECRET_ACCESS_KEY"), os.Getenv("S3_REGION"), u.Scheme == "https")
+		if err != nil {
+			return nil, err
+		}
+		resolver.Stores[vllmCore.SchemeS3] = store
+		resolver.S3Endpoint = endpoint
 	}
+	return resolver, nil
 }
@@ -0,0 +1,127 @@
+package main
+
+import (
+	metricsCore "connect-go/internal/core/metrics"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// leaderGauge reads metricsCore.Leader.
+func leaderGauge(t *testing.T) float64 {
+	t.Helper()
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(metricsCore.Leader)
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return families[0].GetMetric()[0].GetGauge().GetValue()
+}
+
+// startControllers runs one controller under runControllers and returns a
+// channel closed once the controller starts and one closed once
+// runControllers returns.
+func startControllers(ctx context.Context, clientset *fake.Clientset) (started, done chan struct{}) {
+	started, done = make(chan struct{}), make(chan struct{})
+	controllers := []controller{{"test", func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return nil
+	}}}
+	go func() {
+		defer close(done)
+		runControllers(ctx, clientset, "replica-a", controllers)
+	}()
+	return started, done
+}
+
+func TestRunControllersWhileLeading(t *testing.T) {
+	t.Setenv("POD_NAMESPACE", "default")
+	t.Setenv("LEADER_ELECTION_LEASE", "connect-go-leader")
+	clientset := fake.NewSimpleClientset()
+	ctx, cancel := context.WithCancel(t.Context())
+	started, done := startControllers(ctx, clientset)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("controllers did not start with the Lease free")
+	}
+	if got := leaderGauge(t); got != 1 {
+		t.Errorf("leader gauge = %v while leading, want 1", got)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("runControllers did not return after cancellation")
+	}
+	if got := leaderGauge(t); got != 0 {
+		t.Errorf("leader gauge = %v after shutdown, want 0", got)
+	}
+	lease, err := clientset.CoordinationV1().Leases("default").Get(t.Context(), "connect-go-leader", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if holder := lease.Spec.HolderIdentity; holder != nil && *holder != "" {
+		t.Errorf("Lease still held by %q after shutdown, want it released", *holder)
+	}
+}
+
+func TestRunControllersWaitsForLease(t *testing.T) {
+	t.Setenv("POD_NAMESPACE", "default")
+	t.Setenv("LEADER_ELECTION_LEASE", "connect-go-leader")
+	holder, duration := "replica-b", int32(60)
+	now := metav1.NewMicroTime(time.Now())
+	clientset := fake.NewSimpleClientset(&coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "connect-go-leader"},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &holder,
+			LeaseDurationSeconds: &duration,
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		},
+	})
+	ctx, cancel := context.WithCancel(t.Context())
+	started, done := startControllers(ctx, clientset)
+
+	select {
+	case <-started:
+		t.Fatal("controllers started while another replica holds the Lease")
+	case <-time.After(500 * time.Millisecond):
+	}
+	if got := leaderGauge(t); got != 0 {
+		t.Errorf("leader gauge = %v on a follower, want 0", got)
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("runControllers did not return after cancellation")
+	}
+}
+
+func TestRunControllersWithoutLeaderElection(t *testing.T) {
+	t.Setenv("LEADER_ELECTION", "false")
+	clientset := fake.NewSimpleClientset()
+	ctx, cancel := context.WithCancel(t.Context())
+	started, done := startControllers(ctx, clientset)
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("controllers did not start")
+	}
+	cancel()
+	<-done
+	if actions := clientset.Actions(); len(actions) != 0 {
+		t.Errorf("actions = %v, want no Lease traffic", actions)
+	}
+}
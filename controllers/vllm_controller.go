@@ -4,12 +4,12 @@ package controllers
 // 	"context"
 // 	"fmt"
 
-// 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // 	"k8s.io/apimachinery/pkg/runtime"
 // 	ctrl "sigs.k8s.io/controller-runtime"
 // 	"sigs.k8s.io/controller-runtime/pkg/client"
 // 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+// 	vllmv1 "connect-go/api/vllm/v1"
 // 	app "connect-go/internal/app/vllm"
 // )
 
@@ -19,51 +19,11 @@ package controllers
 // 	VLLMService app.VLLMService // 注入 application 層 service
 // }
 
-// type VLLMCR struct {
-// 	metav1.TypeMeta   `json:",inline"`
-// 	metav1.ObjectMeta `json:"metadata,omitempty"`
-
-// 	Spec   VLLMSpec   `json:"spec"`
-// 	Status VLLMStatus `json:"status"`
-// }
-
-// type VLLMSpec struct {
-// 	Model     string               `json:"model"`
-// 	Action    string               `json:"action"` // start, stop, update
-// 	Replicas  int                  `json:"replicas,omitempty"`
-// 	Resources ResourceRequirements `json:"resources,omitempty"`
-// 	Timeout   int                  `json:"timeout,omitempty"`
-// }
-
-// type ResourceRequirements struct {
-// 	CPU    string `json:"cpu,omitempty"`
-// 	Memory string `json:"memory,omitempty"`
-// }
-
-// type VLLMStatus struct {
-// 	Phase         string           `json:"phase"`
-// 	Message       string           `json:"message"`
-// 	StartTime     metav1.Time      `json:"startTime,omitempty"`
-// 	Condition     metav1.Condition `json:"condition,omitempty"`
-// 	ReadyReplicas int              `json:"readyReplicas,omitempty"`
-// }
-
-// // DeepCopyObject is required to implement client.Object
-// func (in *VLLMCR) DeepCopyObject() runtime.Object {
-// 	if in == nil {
-// 		return nil
-// 	}
-// 	out := new(VLLMCR)
-// 	*out = *in
-// 	out.ObjectMeta = *in.ObjectMeta.DeepCopy()
-// 	return out
-// }
-
 // func (r *VLLMReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 // 	log := log.FromContext(ctx)
 
-// 	// 1. Fetch the VLLMCR
-// 	var cr VLLMCR
+// 	// 1. Fetch the VLLM
+// 	var cr vllmv1.VLLM
 // 	if err := r.Get(ctx, req.NamespacedName, &cr); err != nil {
 // 		log.Error(err, "unable to fetch VLLMCR", "namespace", req.Namespace, "name", req.Name)
 // 		return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -72,7 +32,7 @@ package controllers
 // 	// 2. 根據 action 執行 vLLM production stack 操作
 // 	var err error
 // 	switch cr.Spec.Action {
-// 	case "start":
+// 	case vllmv1.ActionStart:
 // 		_, err = r.VLLMService.Start(cr.Spec.Model, cr.Spec.Action, "")
 // 		if err == nil {
 // 			cr.Status.Phase = "Running"
@@ -81,7 +41,7 @@ package controllers
 // 			cr.Status.Phase = "Failed"
 // 			cr.Status.Message = fmt.Sprintf("Failed to start model: %v", err)
 // 		}
-// 	case "stop":
+// 	case vllmv1.ActionStop:
 // 		_, err = r.VLLMService.Stop(cr.Spec.Model, cr.Spec.Action, "")
 // 		if err == nil {
 // 			cr.Status.Phase = "Stopped"
@@ -117,6 +77,6 @@ package controllers
 // // SetupWithManager sets up the controller with the Manager
 // func (r *VLLMReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // 	return ctrl.NewControllerManagedBy(mgr).
-// 		For(&VLLMCR{}).
+// 		For(&vllmv1.VLLM{}).
 // 		Complete(r)
 // }
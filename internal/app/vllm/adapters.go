@@ -0,0 +1,140 @@
+package vllm
+
+import (
+	auditCore "connect-go/internal/core/audit"
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AdapterManager loads and unloads LoRA adapters on running runtimes and keeps
+// spec.adapters, spec.args and status.adapters in step with the engine.
+type AdapterManager struct {
+	api      *infra.VLLMAPI
+	lora     *infra.LoRAClient
+	recorder auditCore.Recorder
+}
+
+func NewAdapterManager(api *infra.VLLMAPI, lora *infra.LoRAClient, recorder auditCore.Recorder) *AdapterManager {
+	return &AdapterManager{
+		api:      api,
+		lora:     lora,
+		recorder: recorder,
+	}
+}
+
+// Load serves the adapter on the runtime and adds it to spec.adapters. Loading
+// an adapter under an existing name replaces it.
+func (m *AdapterManager) Load(ctx context.Context, namespace, runtime string, adapter domain.Adapter) (_ []domain.AdapterStatus, err error) {
+	ctx, span := tracing.Start(ctx, "AdapterManager.Load", trace.WithAttributes(adapterAttributes(namespace, runtime, adapter.Name)...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	defer recordAction(ctx, m.recorder, domain.ActionLoadAdapter, namespace, runtime, "", time.Now(), &change, &err)
+
+	if err := adapter.Validate(); err != nil {
+		return nil, err
+	}
+	t, resourceVersion, err := m.runningTarget(ctx, namespace, runtime)
+	if err != nil {
+		return nil, err
+	}
+	path, _ := adapter.Path()
+	if err := m.lora.Load(ctx, t.Endpoint, adapter.Name, path); err != nil {
+		return nil, fmt.Errorf("failed to load adapter %q: %w", adapter.Name, err)
+	}
+	t.Adapters = domain.WithAdapter(t.Adapters, adapter)
+	if change, err = m.api.SetAdapters(ctx, namespace, runtime, resourceVersion, t.Adapters); err != nil {
+		return nil, err
+	}
+	return m.syncStatus(ctx, t)
+}
+
+// Unload stops serving the adapter and removes it from spec.adapters.
+func (m *AdapterManager) Unload(ctx context.Context, namespace, runtime, name string) (_ []domain.AdapterStatus, err error) {
+	ctx, span := tracing.Start(ctx, "AdapterManager.Unload", trace.WithAttributes(adapterAttributes(namespace, runtime, name)...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	defer recordAction(ctx, m.recorder, domain.ActionUnloadAdapter, namespace, runtime, "", time.Now(), &change, &err)
+
+	t, resourceVersion, err := m.runningTarget(ctx, namespace, runtime)
+	if err != nil {
+		return nil, err
+	}
+	adapters, found := domain.WithoutAdapter(t.Adapters, name)
+	if !found {
+		return nil, fmt.Errorf("%w: %s is not declared on %s/%s", domain.ErrAdapterNotFound, name, namespace, runtime)
+	}
+	if err := m.lora.Unload(ctx, t.Endpoint, name); err != nil {
+		return nil, fmt.Errorf("failed to unload adapter %q: %w", name, err)
+	}
+	t.Adapters = adapters
+	if change, err = m.api.SetAdapters(ctx, namespace, runtime, resourceVersion, t.Adapters); err != nil {
+		return nil, err
+	}
+	return m.syncStatus(ctx, t)
+}
+
+// List returns the declared adapters and whether the engine serves each one.
+// Adapters cannot be loaded unless the runtime is Running.
+func (m *AdapterManager) List(ctx context.Context, namespace, runtime string) (_ []domain.AdapterStatus, err error) {
+	ctx, span := tracing.Start(ctx, "AdapterManager.List", trace.WithAttributes(adapterAttributes(namespace, runtime, "")...))
+	defer func() { tracing.End(span, err) }()
+
+	t, _, err := m.api.AdapterTarget(ctx, namespace, runtime)
+	if err != nil {
+		return nil, err
+	}
+	if t.Phase != string(domain.StatusRunning) {
+		return adapterStatuses(t.Adapters, nil), nil
+	}
+	return m.syncStatus(ctx, t)
+}
+
+func (m *AdapterManager) runningTarget(ctx context.Context, namespace, runtime string) (*domain.AdapterTarget, string, error) {
+	t, resourceVersion, err := m.api.AdapterTarget(ctx, namespace, runtime)
+	if err != nil {
+		return nil, "", err
+	}
+	if t.Phase != string(domain.StatusRunning) {
+		return nil, "", fmt.Errorf("%w: %s/%s is %q", domain.ErrNotRunning, namespace, runtime, t.Phase)
+	}
+	return t, resourceVersion, nil
+}
+
+// syncStatus asks the engine which adapters it serves and records the result in
+// status.adapters. Failing to reach the engine or to write status is logged, not
+// returned, because the spec change it follows has already been made.
+func (m *AdapterManager) syncStatus(ctx context.Context, t *domain.AdapterTarget) ([]domain.AdapterStatus, error) {
+	loaded, err := m.lora.Loaded(ctx, t.Endpoint)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to list engine adapters", "namespace", t.Namespace, "runtime", t.Name, "error", err)
+	}
+	statuses := adapterStatuses(t.Adapters, loaded)
+	if err := m.api.SetAdapterStatus(ctx, t.Namespace, t.Name, statuses); err != nil {
+		slog.WarnContext(ctx, "failed to record adapter status", "namespace", t.Namespace, "runtime", t.Name, "error", err)
+	}
+	return statuses, nil
+}
+
+func adapterStatuses(adapters []domain.Adapter, loaded map[string]bool) []domain.AdapterStatus {
+	statuses := make([]domain.AdapterStatus, 0, len(adapters))
+	for _, a := range adapters {
+		statuses = append(statuses, domain.AdapterStatus{Name: a.Name, StorageURI: a.StorageURI, Loaded: loaded[a.Name]})
+	}
+	return statuses
+}
+
+func adapterAttributes(namespace, runtime, adapter string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.runtime", runtime),
+		attribute.String("vllm.adapter", adapter),
+	}
+}
@@ -0,0 +1,95 @@
+package vllm
+
+import (
+	metricsCore "connect-go/internal/core/metrics"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Autoscaler periodically adjusts spec.replicas of Running VLLM resources that
+// declare spec.autoscaling, based on the scraped engine stats.
+type Autoscaler struct {
+	api      *infra.VLLMAPI
+	engines  infra.EngineStatsSource
+	interval time.Duration
+}
+
+func NewAutoscaler(api *infra.VLLMAPI, engines infra.EngineStatsSource, interval time.Duration) *Autoscaler {
+	return &Autoscaler{
+		api:      api,
+		engines:  engines,
+		interval: interval,
+	}
+}
+
+// Run evaluates every autoscaled runtime each interval until ctx is cancelled.
+func (a *Autoscaler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := a.Reconcile(ctx); err != nil {
+			slog.WarnContext(ctx, "autoscaler pass failed", "error", err)
+		}
+	}
+}
+
+// Reconcile makes one scaling decision for each autoscaled runtime.
+func (a *Autoscaler) Reconcile(ctx context.Context) error {
+	targets, err := a.api.ListAutoscaled(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		if err := a.reconcile(ctx, t, time.Now()); err != nil {
+			slog.WarnContext(ctx, "failed to autoscale runtime", "namespace", t.Namespace, "runtime", t.Name, "error", err)
+		}
+	}
+	return nil
+}
+
+func (a *Autoscaler) reconcile(ctx context.Context, t domain.AutoscaleTarget, now time.Time) error {
+	r, ok := a.engines.Lookup(t.Namespace, t.Name)
+	if !ok || r.Stats == nil {
+		// Never scale on missing data; wait for the next scrape.
+		return nil
+	}
+	decision := t.Autoscaling.Decide(t.Replicas, r.Stats, t.LastScaleTime, now)
+	metricsCore.AutoscalerDecisions.WithLabelValues(t.Namespace, t.Model, decision.Reason).Inc()
+
+	cond := metav1.Condition{
+		Type:               domain.ConditionAutoscaling,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(now.UTC().Truncate(time.Second)),
+		Reason:             decision.Reason,
+		Message:            decision.Message,
+	}
+	if decision.Scales(t.Replicas) {
+		if err := a.api.Scale(ctx, t.Namespace, t.Name, decision.Replicas, cond); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "autoscaled runtime", "namespace", t.Namespace, "runtime", t.Name,
+			"from", t.Replicas, "to", decision.Replicas, "reason", decision.Reason, "message", decision.Message)
+		return nil
+	}
+	// Only rewrite the condition when the reason changes, so a steady state does
+	// not patch the resource on every pass.
+	if c := meta.FindStatusCondition(t.Conditions, domain.ConditionAutoscaling); c != nil && c.Reason == decision.Reason {
+		return nil
+	}
+	if err := a.api.SetConditions(ctx, t.Namespace, t.Name, cond); err != nil {
+		return fmt.Errorf("failed to record autoscaling condition: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package vllm
+
+import (
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BatchRunner starts, stops or updates many runtimes of a namespace at once.
+// Each runtime goes through the VLLMService, so it is checked and audited as
+// if it had been changed on its own.
+type BatchRunner struct {
+	service VLLMService
+	api     *infra.VLLMAPI
+}
+
+func NewBatchRunner(service VLLMService, api *infra.VLLMAPI) *BatchRunner {
+	return &BatchRunner{
+		service: service,
+		api:     api,
+	}
+}
+
+// batchOp is one kind of change a batch applies.
+type batchOp struct {
+	action string
+	// done reports whether the runtime is already in the requested state;
+	// nil means never.
+	done   func(t domain.BatchTarget) bool
+	apply  func(ctx context.Context, name string) (*domain.SpecChange, error)
+	revert func(ctx context.Context, name string, change *domain.SpecChange) error
+}
+
+// Start starts the selected runtimes that are stopped.
+func (b *BatchRunner) Start(ctx context.Context, req domain.BatchRequest) (*domain.BatchResult, error) {
+	return b.run(ctx, req, batchOp{
+		action: domain.ActionStart,
+		done:   func(t domain.BatchTarget) bool { return t.Running },
+		apply: func(ctx context.Context, name string) (*domain.SpecChange, error) {
+			v, err := b.service.Start(ctx, req.Namespace, name, name, req.DryRun)
+			if err != nil {
+				return nil, err
+			}
+			return v.Change, nil
+		},
+		revert: func(ctx context.Context, name string, _ *domain.SpecChange) error {
+			_, err := b.service.Stop(ctx, req.Namespace, name, name, false)
+			return err
+		},
+	})
+}
+
+// Stop stops the selected runtimes that run.
+func (b *BatchRunner) Stop(ctx context.Context, req domain.BatchRequest) (*domain.BatchResult, error) {
+	return b.run(ctx, req, batchOp{
+		action: domain.ActionStop,
+		done:   func(t domain.BatchTarget) bool { return !t.Running },
+		apply: func(ctx context.Context, name string) (*domain.SpecChange, error) {
+			v, err := b.service.Stop(ctx, req.Namespace, name, name, req.DryRun)
+			if err != nil {
+				return nil, err
+			}
+			return v.Change, nil
+		},
+		revert: func(ctx context.Context, name string, _ *domain.SpecChange) error {
+			_, err := b.service.Start(ctx, req.Namespace, name, name, false)
+			return err
+		},
+	})
+}
+
+// Update merges spec into the spec of each selected runtime. Reverting puts
+// back the spec each runtime had before.
+func (b *BatchRunner) Update(ctx context.Context, req domain.BatchRequest, spec map[string]interface{}) (*domain.BatchResult, error) {
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("%w: nothing to update", domain.ErrInvalidSpec)
+	}
+	return b.run(ctx, req, batchOp{
+		action: domain.ActionUpdate,
+		apply: func(ctx context.Context, name string) (*domain.SpecChange, error) {
+			v, err := b.service.Update(ctx, req.Namespace, name, spec, req.DryRun)
+			if err != nil {
+				return nil, err
+			}
+			return v.Change, nil
+		},
+		revert: func(ctx context.Context, name string, change *domain.SpecChange) error {
+			patch := domain.RevertSpec(change.Before, change.After)
+			if len(patch) == 0 {
+				return nil
+			}
+			_, err := b.service.Update(ctx, req.Namespace, name, patch, false)
+			return err
+		},
+	})
+}
+
+// run applies op to the selected runtimes, at most req.Concurrency at a time.
+// In an atomic batch the first failure stops new items from starting and,
+// once the running ones finish, every succeeded item is reverted.
+func (b *BatchRunner) run(ctx context.Context, req domain.BatchRequest, op batchOp) (_ *domain.BatchResult, err error) {
+	ctx, span := tracing.Start(ctx, "BatchRunner.Run", trace.WithAttributes(
+		attribute.String("vllm.batch.action", op.action),
+		attribute.String("vllm.namespace", req.Namespace),
+		attribute.String("vllm.selector", req.Selector),
+		attribute.Int("vllm.batch.names", len(req.Names)),
+		attribute.Bool("vllm.batch.atomic", req.Atomic),
+		attribute.Bool("vllm.dry_run", req.DryRun),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	targets, err := b.api.ListBatchTargets(ctx, req.Namespace, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+	result := &domain.BatchResult{Action: op.action, Items: batchItems(req, targets, op.done)}
+
+	var failed atomic.Bool
+	failed.Store(result.Failed() > 0)
+	sem := make(chan struct{}, req.Concurrency)
+	var wg sync.WaitGroup
+	for i := range result.Items {
+		item := &result.Items[i]
+		if item.Outcome != "" {
+			continue
+		}
+		sem <- struct{}{}
+		if req.Atomic && failed.Load() {
+			item.Outcome = domain.BatchSkipped
+			<-sem
+			continue
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			change, err := op.apply(ctx, item.Name)
+			if err != nil {
+				item.Outcome, item.Err = domain.BatchFailed, err
+				failed.Store(true)
+				return
+			}
+			item.Outcome, item.Change = domain.BatchSucceeded, change
+		})
+	}
+	wg.Wait()
+
+	if req.Atomic && failed.Load() && !req.DryRun {
+		b.revert(ctx, req, op, result)
+	}
+	span.SetAttributes(attribute.Int("vllm.batch.failed", result.Failed()))
+	return result, nil
+}
+
+// revert undoes every succeeded item of a failed atomic batch. It carries on
+// when the caller goes away, since stopping halfway would leave the batch
+// partly applied.
+func (b *BatchRunner) revert(ctx context.Context, req domain.BatchRequest, op batchOp, result *domain.BatchResult) {
+	ctx = context.WithoutCancel(ctx)
+	sem := make(chan struct{}, req.Concurrency)
+	var wg sync.WaitGroup
+	for i := range result.Items {
+		item := &result.Items[i]
+		if item.Outcome != domain.BatchSucceeded {
+			continue
+		}
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			if err := op.revert(ctx, item.Name, item.Change); err != nil {
+				slog.WarnContext(ctx, "failed to revert batch item", "action", op.action, "namespace", req.Namespace, "resource", item.Name, "error", err)
+				item.RevertErr = err
+				return
+			}
+			item.Outcome = domain.BatchReverted
+		})
+	}
+	wg.Wait()
+	result.Reverted = true
+}
+
+// batchItems lists the runtimes a batch covers: the named ones in the order
+// given, or those matching the selector by name. Named runtimes that do not
+// exist fail, and runtimes already in the requested state are unchanged; the
+// rest are left for run.
+func batchItems(req domain.BatchRequest, targets []domain.BatchTarget, done func(domain.BatchTarget) bool) []domain.BatchItem {
+	byName := make(map[string]domain.BatchTarget, len(targets))
+	for _, t := range targets {
+		byName[t.Name] = t
+	}
+	names := req.Names
+	if len(names) == 0 {
+		for _, t := range targets {
+			names = append(names, t.Name)
+		}
+		slices.Sort(names)
+	}
+	items := make([]domain.BatchItem, len(names))
+	for i, name := range names {
+		items[i].Name = name
+		t, ok := byName[name]
+		switch {
+		case !ok:
+			items[i].Outcome = domain.BatchFailed
+			items[i].Err = fmt.Errorf("%w: %s/%s", domain.ErrRuntimeNotFound, req.Namespace, name)
+		case done != nil && done(t):
+			items[i].Outcome = domain.BatchUnchanged
+		}
+	}
+	return items
+}
@@ -0,0 +1,135 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dfake "k8s.io/client-go/dynamic/fake"
+)
+
+// batchService records the calls a batch makes and fails those of failing.
+type batchService struct {
+	VLLMService
+	failing string
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func (s *batchService) call(action, name string, dryRun bool) (*domain.VLLMUseCase, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !dryRun {
+		s.calls = append(s.calls, action+" "+name)
+	}
+	if name == s.failing {
+		return nil, errors.New("engine unavailable")
+	}
+	return &domain.VLLMUseCase{Resource: name, Change: &domain.SpecChange{
+		Resource: name,
+		Before:   map[string]interface{}{"action": "stop"},
+		After:    map[string]interface{}{"action": action},
+	}}, nil
+}
+
+func (s *batchService) Start(_ context.Context, _, name, _ string, dryRun bool) (*domain.VLLMUseCase, error) {
+	return s.call(domain.ActionStart, name, dryRun)
+}
+
+func (s *batchService) Stop(_ context.Context, _, name, _ string, dryRun bool) (*domain.VLLMUseCase, error) {
+	return s.call(domain.ActionStop, name, dryRun)
+}
+
+func batchAPI(runtimes map[string]vllmv1.Action) *infra.VLLMAPI {
+	var objs []runtime.Object
+	for name, action := range runtimes {
+		objs = append(objs, &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "vllm.ai/v1",
+			"kind":       "VLLM",
+			"metadata":   map[string]interface{}{"namespace": "default", "name": name},
+			"spec":       map[string]interface{}{"model": name, "action": string(action)},
+		}})
+	}
+	client := dfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{vllmv1.VLLMResource: "VLLMList"}, objs...)
+	return &infra.VLLMAPI{Client: client}
+}
+
+func outcomes(result *domain.BatchResult) []domain.BatchOutcome {
+	var out []domain.BatchOutcome
+	for _, item := range result.Items {
+		out = append(out, item.Outcome)
+	}
+	return out
+}
+
+func TestBatchRunnerAtomicReverts(t *testing.T) {
+	service := &batchService{failing: "c"}
+	api := batchAPI(map[string]vllmv1.Action{"a": vllmv1.ActionStop, "b": vllmv1.ActionStart, "c": vllmv1.ActionStop, "d": vllmv1.ActionStop})
+	runner := NewBatchRunner(service, api)
+
+	result, err := runner.Start(t.Context(), domain.BatchRequest{
+		Namespace:   "default",
+		Names:       []string{"a", "b", "c", "d"},
+		Concurrency: 1,
+		Atomic:      true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []domain.BatchOutcome{domain.BatchReverted, domain.BatchUnchanged, domain.BatchFailed, domain.BatchSkipped}
+	if got := outcomes(result); !slices.Equal(got, want) {
+		t.Errorf("outcomes = %v, want %v", got, want)
+	}
+	if !result.Reverted || result.Items[2].Err == nil {
+		t.Errorf("Reverted = %v, error of c = %v; want reverted after c failed", result.Reverted, result.Items[2].Err)
+	}
+	// The already running runtime is left alone and d is never started.
+	if want := []string{"start a", "start c", "stop a"}; !slices.Equal(service.calls, want) {
+		t.Errorf("calls = %v, want %v", service.calls, want)
+	}
+}
+
+func TestBatchRunnerAtomicMissingRuntime(t *testing.T) {
+	service := &batchService{}
+	runner := NewBatchRunner(service, batchAPI(map[string]vllmv1.Action{"a": vllmv1.ActionStart}))
+
+	// A named runtime that does not exist fails the batch before anything runs.
+	result, err := runner.Stop(t.Context(), domain.BatchRequest{Namespace: "default", Names: []string{"a", "missing"}, Atomic: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []domain.BatchOutcome{domain.BatchSkipped, domain.BatchFailed}
+	if got := outcomes(result); !slices.Equal(got, want) {
+		t.Errorf("outcomes = %v, want %v", got, want)
+	}
+	if !errors.Is(result.Items[1].Err, domain.ErrRuntimeNotFound) {
+		t.Errorf("error of missing = %v, want ErrRuntimeNotFound", result.Items[1].Err)
+	}
+	if len(service.calls) != 0 {
+		t.Errorf("calls = %v, want none", service.calls)
+	}
+}
+
+func TestBatchRunnerNonAtomicKeepsSucceeded(t *testing.T) {
+	service := &batchService{failing: "b"}
+	runner := NewBatchRunner(service, batchAPI(map[string]vllmv1.Action{"a": vllmv1.ActionStop, "b": vllmv1.ActionStop, "c": vllmv1.ActionStop}))
+
+	result, err := runner.Start(t.Context(), domain.BatchRequest{Namespace: "default", Names: []string{"a", "b", "c"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []domain.BatchOutcome{domain.BatchSucceeded, domain.BatchFailed, domain.BatchSucceeded}
+	if got := outcomes(result); !slices.Equal(got, want) || result.Reverted {
+		t.Errorf("outcomes = %v, reverted %v; want %v without revert", got, result.Reverted, want)
+	}
+}
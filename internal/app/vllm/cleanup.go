@@ -0,0 +1,72 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"log/slog"
+	"time"
+)
+
+// CleanupController puts domain.FinalizerCleanup on every VLLM resource and,
+// once one is deleted, removes what its runtime leaves behind before letting
+// the resource go.
+type CleanupController struct {
+	api      *infra.VLLMAPI
+	cleaner  *infra.Cleaner
+	interval time.Duration
+}
+
+func NewCleanupController(api *infra.VLLMAPI, cleaner *infra.Cleaner, interval time.Duration) *CleanupController {
+	return &CleanupController{
+		api:      api,
+		cleaner:  cleaner,
+		interval: interval,
+	}
+}
+
+// Run handles finalizers each interval until ctx is cancelled.
+func (c *CleanupController) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := c.Reconcile(ctx); err != nil {
+			slog.WarnContext(ctx, "cleanup pass failed", "error", err)
+		}
+	}
+}
+
+// Reconcile adds missing finalizers and cleans up deleted resources once.
+func (c *CleanupController) Reconcile(ctx context.Context) error {
+	targets, err := c.api.ListCleanupTargets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		if err := c.reconcile(ctx, t); err != nil {
+			slog.WarnContext(ctx, "failed to clean up runtime", "namespace", t.Namespace, "resource", t.Name, "error", err)
+		}
+	}
+	return nil
+}
+
+func (c *CleanupController) reconcile(ctx context.Context, t domain.CleanupTarget) error {
+	switch {
+	case !t.Deleting && !t.HasFinalizer:
+		return c.api.AddFinalizer(ctx, t.Namespace, t.Name)
+	case t.Deleting && t.HasFinalizer:
+		if err := c.cleaner.Cleanup(ctx, t); err != nil {
+			return err
+		}
+		if err := c.api.RemoveFinalizer(ctx, t.Namespace, t.Name); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "cleaned up deleted runtime", "namespace", t.Namespace, "resource", t.Name)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// failingRouter fails to deregister the runtimes in failing.
+type failingRouter struct {
+	*infra.MemoryRouter
+	failing map[string]bool
+}
+
+func (r *failingRouter) Deregister(ctx context.Context, namespace, name string) error {
+	if r.failing[name] {
+		return errors.New("router unavailable")
+	}
+	return r.MemoryRouter.Deregister(ctx, namespace, name)
+}
+
+func cleanupVLLM(name string, deleting bool, finalizers ...string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "vllm.ai/v1",
+		"kind":       "VLLM",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": name, "uid": name + "-uid"},
+		"spec":       map[string]interface{}{"model": name, "action": string(vllmv1.ActionStart)},
+	}}
+	obj.SetFinalizers(finalizers)
+	if deleting {
+		now := metav1.Now()
+		obj.SetDeletionTimestamp(&now)
+	}
+	return obj
+}
+
+func TestCleanupControllerReconcile(t *testing.T) {
+	client := dfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{vllmv1.VLLMResource: "VLLMList"},
+		cleanupVLLM("new", false),
+		cleanupVLLM("serving", false, domain.FinalizerCleanup),
+		cleanupVLLM("deleted", true, domain.FinalizerCleanup),
+		cleanupVLLM("unreachable", true, domain.FinalizerCleanup),
+		cleanupVLLM("stuck", true, domain.FinalizerCleanup),
+	)
+	api := &infra.VLLMAPI{Client: client}
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "deleted", Labels: map[string]string{domain.LabelRuntime: "deleted"}}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "stuck", Labels: map[string]string{domain.LabelRuntime: "stuck"}}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "serving", Labels: map[string]string{domain.LabelRuntime: "serving"}}},
+	)
+	clientset.PrependReactor("delete", "deployments", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		if a.(k8stesting.DeleteAction).GetName() == "stuck" {
+			return true, nil, apierrors.NewForbidden(appsv1.Resource("deployments"), "stuck", nil)
+		}
+		return false, nil, nil
+	})
+	router := &failingRouter{MemoryRouter: infra.NewMemoryRouter(), failing: map[string]bool{"unreachable": true}}
+	controller := NewCleanupController(api, infra.NewCleaner(clientset, nil, router), time.Minute)
+
+	if err := controller.Reconcile(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	targets, err := api.ListCleanupTargets(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var finalized []string
+	for _, target := range targets {
+		if target.HasFinalizer {
+			finalized = append(finalized, target.Name)
+		}
+	}
+	slices.Sort(finalized)
+	// The finalizer is added to new resources and only dropped once both the
+	// deregistration and the cleanup succeeded.
+	if want := []string{"new", "serving", "stuck", "unreachable"}; !slices.Equal(finalized, want) {
+		t.Errorf("resources with the finalizer = %v, want %v", finalized, want)
+	}
+	deployments, err := clientset.AppsV1().Deployments("default").List(t.Context(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var left []string
+	for _, d := range deployments.Items {
+		left = append(left, d.Name)
+	}
+	slices.Sort(left)
+	if want := []string{"serving", "stuck"}; !slices.Equal(left, want) {
+		t.Errorf("deployments left = %v, want %v", left, want)
+	}
+}
@@ -0,0 +1,70 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// ConditionsController keeps status.conditions of every VLLM resource in line
+// with what the readiness checker observes.
+type ConditionsController struct {
+	api       *infra.VLLMAPI
+	readiness infra.ReadinessChecker
+	interval  time.Duration
+}
+
+func NewConditionsController(api *infra.VLLMAPI, readiness infra.ReadinessChecker, interval time.Duration) *ConditionsController {
+	return &ConditionsController{
+		api:       api,
+		readiness: readiness,
+		interval:  interval,
+	}
+}
+
+// Run refreshes the conditions each interval until ctx is cancelled.
+func (c *ConditionsController) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := c.Reconcile(ctx); err != nil {
+			slog.WarnContext(ctx, "conditions pass failed", "error", err)
+		}
+	}
+}
+
+// Reconcile refreshes the conditions of each VLLM resource once.
+func (c *ConditionsController) Reconcile(ctx context.Context) error {
+	targets, err := c.api.ListConditionTargets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		if err := c.reconcile(ctx, t); err != nil {
+			slog.WarnContext(ctx, "failed to update runtime conditions", "namespace", t.Namespace, "resource", t.Name, "error", err)
+		}
+	}
+	return nil
+}
+
+func (c *ConditionsController) reconcile(ctx context.Context, t domain.ConditionTarget) error {
+	if !t.Running {
+		return c.api.SetConditions(ctx, t.Namespace, t.Name, domain.StoppedConditions(t.Generation)...)
+	}
+	progress, err := c.readiness.Check(ctx, t.Namespace, t.Name)
+	switch {
+	case errors.Is(err, domain.ErrRuntimeFailed):
+		return c.api.SetConditions(ctx, t.Namespace, t.Name, domain.FailedConditions(err.Error(), t.Generation)...)
+	case err != nil:
+		return err
+	}
+	return c.api.SetConditions(ctx, t.Namespace, t.Name, domain.ReadinessConditions(progress, t.Generation)...)
+}
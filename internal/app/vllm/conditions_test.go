@@ -0,0 +1,51 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConditionsControllerReconcile(t *testing.T) {
+	api := batchAPI(map[string]vllmv1.Action{"llama": vllmv1.ActionStart, "qwen": vllmv1.ActionStop})
+	controller := NewConditionsController(api, phaseReadiness(domain.ReadinessReady), time.Minute)
+
+	conditions := func() map[string][]metav1.Condition {
+		t.Helper()
+		if err := controller.Reconcile(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+		targets, err := api.ListConditionTargets(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := map[string][]metav1.Condition{}
+		for _, target := range targets {
+			out[target.Name] = target.Conditions
+		}
+		return out
+	}
+
+	first := conditions()
+	if c := meta.FindStatusCondition(first["llama"], domain.ConditionReady); c == nil || c.Status != metav1.ConditionTrue {
+		t.Errorf("Ready of llama = %+v, want True", c)
+	}
+	if c := meta.FindStatusCondition(first["qwen"], domain.ConditionReady); c == nil || c.Status != metav1.ConditionFalse || c.Reason != domain.ReasonStopped {
+		t.Errorf("Ready of qwen = %+v, want False because it is stopped", c)
+	}
+
+	// A second pass observes the same state and keeps the transition times.
+	second := conditions()
+	for name, conds := range first {
+		for _, c := range conds {
+			again := meta.FindStatusCondition(second[name], c.Type)
+			if again == nil || !again.LastTransitionTime.Equal(&c.LastTransitionTime) {
+				t.Errorf("%s of %s = %+v, want its transition time %s kept", c.Type, name, again, c.LastTransitionTime)
+			}
+		}
+	}
+}
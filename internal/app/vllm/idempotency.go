@@ -0,0 +1,123 @@
+package vllm
+
+import (
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// IdempotencyScope is the request a key is used for.
+type IdempotencyScope struct {
+	Namespace string
+	Resource  string
+	Action    string
+	// Fingerprint identifies the request; see domain.Fingerprint.
+	Fingerprint string
+}
+
+// IdempotencyGuard runs a request once per idempotency key and replays its
+// response to retries within Window.
+type IdempotencyGuard struct {
+	store infra.IdempotencyStore
+	// Window is how long a key is remembered after its first use.
+	Window time.Duration
+}
+
+func NewIdempotencyGuard(store infra.IdempotencyStore) *IdempotencyGuard {
+	return &IdempotencyGuard{
+		store:  store,
+		Window: domain.DefaultIdempotencyWindow,
+	}
+}
+
+// Do runs run unless key was already used. A retry of a request that
+// succeeded gets the response run returned then, with replayed set; a retry
+// while the first request still runs fails with ErrRequestInProgress, and a
+// different request under the same key with ErrIdempotencyKeyReused. When
+// run fails, the key is given up so the caller can retry. An empty key runs
+// run as is.
+func (g *IdempotencyGuard) Do(ctx context.Context, key string, scope IdempotencyScope, run func(ctx context.Context) ([]byte, error)) (response []byte, replayed bool, err error) {
+	if key == "" {
+		response, err = run(ctx)
+		return response, false, err
+	}
+	ctx, span := tracing.Start(ctx, "IdempotencyGuard.Do", trace.WithAttributes(
+		attribute.String("vllm.namespace", scope.Namespace),
+		attribute.String("vllm.resource", scope.Resource),
+		attribute.String("vllm.action", scope.Action),
+	))
+	defer func() {
+		span.SetAttributes(attribute.Bool("vllm.idempotent_replayed", replayed))
+		tracing.End(span, err)
+	}()
+
+	if err := domain.ValidateIdempotencyKey(key); err != nil {
+		return nil, false, err
+	}
+	now := time.Now()
+	rec := &domain.IdempotencyRecord{
+		Key:         key,
+		Namespace:   scope.Namespace,
+		Resource:    scope.Resource,
+		Action:      scope.Action,
+		Fingerprint: scope.Fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(g.Window),
+	}
+	existing, err := g.store.Reserve(ctx, rec)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if existing != nil {
+		switch {
+		case existing.Fingerprint != rec.Fingerprint:
+			return nil, false, domain.ErrIdempotencyKeyReused
+		case !existing.Done:
+			return nil, false, domain.ErrRequestInProgress
+		}
+		return existing.Response, true, nil
+	}
+
+	response, err = run(ctx)
+	// The outcome is settled: record it even if the caller went away.
+	ctx = context.WithoutCancel(ctx)
+	if err != nil {
+		if rerr := g.store.Release(ctx, rec); rerr != nil {
+			slog.WarnContext(ctx, "failed to release idempotency key", "namespace", scope.Namespace, "resource", scope.Resource, "error", rerr)
+		}
+		return response, false, err
+	}
+	rec.Done, rec.Response = true, response
+	if err := g.store.Complete(ctx, rec); err != nil {
+		// The request went through; give up the key so a retry merely runs it
+		// again rather than waiting out the lease.
+		slog.WarnContext(ctx, "failed to record idempotent response", "namespace", scope.Namespace, "resource", scope.Resource, "error", err)
+		if rerr := g.store.Release(ctx, rec); rerr != nil {
+			slog.WarnContext(ctx, "failed to release idempotency key", "namespace", scope.Namespace, "resource", scope.Resource, "error", rerr)
+		}
+	}
+	return response, false, nil
+}
+
+// Run drops expired keys every interval until ctx is cancelled.
+func (g *IdempotencyGuard) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := g.store.Prune(ctx); err != nil {
+			slog.WarnContext(ctx, "failed to prune idempotency keys", "error", err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestIdempotencyGuardDo(t *testing.T) {
+	guard := NewIdempotencyGuard(infra.NewMemoryIdempotencyStore())
+	scope := IdempotencyScope{Namespace: "default", Resource: "llama", Action: "start", Fingerprint: "a"}
+	runs := 0
+	run := func(context.Context) ([]byte, error) {
+		runs++
+		return []byte("first"), nil
+	}
+
+	response, replayed, err := guard.Do(t.Context(), "key-1", scope, run)
+	if err != nil || replayed || string(response) != "first" {
+		t.Fatalf("Do = %q, %v, %v; want the response of run", response, replayed, err)
+	}
+	response, replayed, err = guard.Do(t.Context(), "key-1", scope, run)
+	if err != nil || !replayed || string(response) != "first" {
+		t.Fatalf("retry = %q, %v, %v; want the first response replayed", response, replayed, err)
+	}
+	if runs != 1 {
+		t.Errorf("run ran %d times, want once", runs)
+	}
+
+	other := scope
+	other.Fingerprint = "b"
+	if _, _, err := guard.Do(t.Context(), "key-1", other, run); !errors.Is(err, domain.ErrIdempotencyKeyReused) {
+		t.Errorf("different request = %v, want ErrIdempotencyKeyReused", err)
+	}
+	// Keys are scoped to the namespace.
+	elsewhere := scope
+	elsewhere.Namespace = "team-a"
+	if _, replayed, err := guard.Do(t.Context(), "key-1", elsewhere, run); err != nil || replayed {
+		t.Errorf("same key in another namespace = %v, %v; want a fresh run", replayed, err)
+	}
+	if _, replayed, err := guard.Do(t.Context(), "", scope, run); err != nil || replayed {
+		t.Errorf("empty key = %v, %v; want a plain run", replayed, err)
+	}
+	if runs != 3 {
+		t.Errorf("run ran %d times, want 3", runs)
+	}
+}
+
+func TestIdempotencyGuardDoInProgressAndFailure(t *testing.T) {
+	guard := NewIdempotencyGuard(infra.NewMemoryIdempotencyStore())
+	scope := IdempotencyScope{Namespace: "default", Resource: "llama", Action: "start", Fingerprint: "a"}
+
+	// A retry arriving while the first request runs is turned away.
+	_, _, err := guard.Do(t.Context(), "key-1", scope, func(ctx context.Context) ([]byte, error) {
+		_, _, err := guard.Do(ctx, "key-1", scope, func(context.Context) ([]byte, error) {
+			t.Error("retry ran while the first request was running")
+			return nil, nil
+		})
+		if !errors.Is(err, domain.ErrRequestInProgress) {
+			t.Errorf("concurrent retry = %v, want ErrRequestInProgress", err)
+		}
+		return nil, errors.New("engine unavailable")
+	})
+	if err == nil {
+		t.Fatal("Do succeeded, want the error of run")
+	}
+
+	// The failure gave the key up, so the retry runs.
+	response, replayed, err := guard.Do(t.Context(), "key-1", scope, func(context.Context) ([]byte, error) {
+		return []byte("second"), nil
+	})
+	if err != nil || replayed || string(response) != "second" {
+		t.Errorf("retry after failure = %q, %v, %v; want a fresh run", response, replayed, err)
+	}
+}
+
+func TestIdempotencyGuardRejectsInvalidKey(t *testing.T) {
+	guard := NewIdempotencyGuard(infra.NewMemoryIdempotencyStore())
+	_, _, err := guard.Do(t.Context(), string(make([]byte, 300)), IdempotencyScope{Namespace: "default"}, func(context.Context) ([]byte, error) {
+		t.Error("run ran for an invalid key")
+		return nil, nil
+	})
+	if err == nil {
+		t.Error("Do accepted a 300 byte key")
+	}
+}
@@ -0,0 +1,380 @@
+package vllm
+
+import (
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// defaultOperationRetention is how long finished operations are kept.
+	defaultOperationRetention = 24 * time.Hour
+	// operationPollInterval is how often WaitOperation reads an operation
+	// followed by another replica.
+	operationPollInterval = time.Second
+	// operationWriteTimeout bounds the final write of an operation whose
+	// watch has been cancelled.
+	operationWriteTimeout = 10 * time.Second
+)
+
+// errOperationLost stops a watch whose operation was finished or taken over
+// elsewhere.
+var errOperationLost = errors.New("operation is no longer watched by this replica")
+
+// OperationManager records an operation for each start, create and update
+// and follows the runtime until it serves, fails or the operation times out
+// or is cancelled. Each replica follows the operations it began and
+// periodically reports on them; Run takes over operations whose replica
+// stopped reporting, so they survive restarts.
+type OperationManager struct {
+	store     infra.OperationStore
+	readiness infra.ReadinessChecker
+	owner     string
+	interval  time.Duration
+	Retention time.Duration
+
+	mu      sync.Mutex
+	watches map[string]context.CancelFunc
+}
+
+// NewOperationManager returns an OperationManager for the replica named
+// owner, which reports on its operations each interval.
+func NewOperationManager(store infra.OperationStore, readiness infra.ReadinessChecker, owner string, interval time.Duration) *OperationManager {
+	return &OperationManager{
+		store:     store,
+		readiness: readiness,
+		owner:     owner,
+		interval:  interval,
+		Retention: defaultOperationRetention,
+		watches:   map[string]context.CancelFunc{},
+	}
+}
+
+// Begin records an operation for a change to resource that was just applied.
+// When wait is false there is nothing to follow, e.g. the runtime is stopped,
+// and the operation is done at once.
+func (m *OperationManager) Begin(ctx context.Context, namespace, resource, action string, wait bool, timeout time.Duration) (_ *domain.Operation, err error) {
+	ctx, span := tracing.Start(ctx, "OperationManager.Begin", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.resource", resource),
+		attribute.String("vllm.action", action),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	now := time.Now()
+	op := &domain.Operation{
+		ID:        uuid.NewString(),
+		Namespace: namespace,
+		Resource:  resource,
+		Action:    action,
+		State:     domain.OperationRunning,
+		Owner:     m.owner,
+		Deadline:  now.Add(timeout),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if !wait {
+		op.Finish(domain.OperationSucceeded, "runtime is stopped; nothing to wait for", nil, now)
+	}
+	if err := m.store.Create(ctx, op); err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("vllm.operation", op.ID))
+	if !op.Done() {
+		m.watch(*op)
+	}
+	return op, nil
+}
+
+func (m *OperationManager) Get(ctx context.Context, namespace, id string) (*domain.Operation, error) {
+	return m.store.Get(ctx, namespace, id)
+}
+
+// List returns the operations of namespace, newest first, optionally only
+// those of one resource. limit <= 0 returns all.
+func (m *OperationManager) List(ctx context.Context, namespace, resource string, limit int) ([]domain.Operation, error) {
+	ops, err := m.store.List(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if resource != "" {
+		ops = slices.DeleteFunc(ops, func(op domain.Operation) bool { return op.Resource != resource })
+	}
+	slices.SortFunc(ops, func(a, b domain.Operation) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	if limit > 0 && len(ops) > limit {
+		ops = ops[:limit]
+	}
+	return ops, nil
+}
+
+// Cancel asks for an operation to stop. The change the operation follows has
+// already been applied and stays; only the following stops. The replica
+// watching the operation marks it Cancelled, at once when it is this one.
+func (m *OperationManager) Cancel(ctx context.Context, namespace, id string) (_ *domain.Operation, err error) {
+	ctx, span := tracing.Start(ctx, "OperationManager.Cancel", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.operation", id),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	op, err := m.store.Update(ctx, namespace, id, func(op *domain.Operation) error {
+		if op.Done() {
+			return fmt.Errorf("%w: operation %s is %s", domain.ErrOperationDone, id, op.State)
+		}
+		op.CancelRequested = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	cancel, ok := m.watches[watchKey(namespace, id)]
+	m.mu.Unlock()
+	if !ok {
+		return op, nil
+	}
+	cancel()
+	// The watch writes Cancelled before it returns; wait briefly for it so the
+	// caller sees the final state.
+	waitCtx, done := context.WithTimeout(ctx, operationWriteTimeout)
+	defer done()
+	return m.Wait(waitCtx, namespace, id, operationWriteTimeout)
+}
+
+// Wait returns the operation once it is done or timeout has passed, whichever
+// comes first. A timeout is not an error: the operation is returned as it
+// stands.
+func (m *OperationManager) Wait(ctx context.Context, namespace, id string, timeout time.Duration) (*domain.Operation, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(operationPollInterval)
+	defer ticker.Stop()
+	for {
+		op, err := m.store.Get(ctx, namespace, id)
+		if err != nil {
+			return nil, err
+		}
+		if op.Done() {
+			return op, nil
+		}
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return op, nil
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run removes expired operations and takes over orphaned ones each interval
+// until ctx is cancelled.
+func (m *OperationManager) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := m.Reconcile(ctx); err != nil {
+			slog.WarnContext(ctx, "operations pass failed", "error", err)
+		}
+	}
+}
+
+// Reconcile deletes operations finished longer than Retention ago and takes
+// over running operations whose replica has not reported for three intervals.
+func (m *OperationManager) Reconcile(ctx context.Context) error {
+	ops, err := m.store.List(ctx, "")
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, op := range ops {
+		switch {
+		case op.Done() && now.Sub(op.DoneAt) > m.Retention:
+			if err := m.store.Delete(ctx, op.Namespace, op.ID); err != nil {
+				slog.WarnContext(ctx, "failed to delete expired operation", "namespace", op.Namespace, "operation", op.ID, "error", err)
+			}
+		case op.Stale(now, 3*m.interval) && !m.watching(op):
+			if err := m.takeOver(ctx, op, now); err != nil {
+				slog.WarnContext(ctx, "failed to take over operation", "namespace", op.Namespace, "operation", op.ID, "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// takeOver makes this replica the owner of an orphaned operation and resumes
+// following it. The conditional write lets only one replica win. An operation
+// that was cancelled or ran out of time meanwhile is finished instead.
+func (m *OperationManager) takeOver(ctx context.Context, op domain.Operation, now time.Time) error {
+	updated, err := m.store.Update(ctx, op.Namespace, op.ID, func(o *domain.Operation) error {
+		if !o.Stale(now, 3*m.interval) {
+			return errOperationLost
+		}
+		switch {
+		case o.CancelRequested:
+			o.Finish(domain.OperationCancelled, "cancelled", nil, now)
+		case now.After(o.Deadline):
+			o.Finish(domain.OperationFailed, "", domain.OperationErrorOf(fmt.Errorf("%w by %s", domain.ErrReadinessTimeout, o.Deadline.Format(time.RFC3339))), now)
+		default:
+			o.Owner, o.UpdatedAt = m.owner, now
+		}
+		return nil
+	})
+	if errors.Is(err, errOperationLost) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "took over operation", "namespace", op.Namespace, "operation", op.ID, "previousOwner", op.Owner, "state", updated.State)
+	if !updated.Done() {
+		m.watch(*updated)
+	}
+	return nil
+}
+
+func watchKey(namespace, id string) string {
+	return namespace + "/" + id
+}
+
+func (m *OperationManager) watching(op domain.Operation) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.watches[watchKey(op.Namespace, op.ID)]
+	return ok
+}
+
+// watch follows op in the background until it is done, its deadline passes
+// or it is cancelled.
+func (m *OperationManager) watch(op domain.Operation) {
+	key := watchKey(op.Namespace, op.ID)
+	ctx, cancel := context.WithDeadline(context.Background(), op.Deadline)
+	m.mu.Lock()
+	if _, ok := m.watches[key]; ok {
+		m.mu.Unlock()
+		cancel()
+		return
+	}
+	m.watches[key] = cancel
+	m.mu.Unlock()
+	go func() {
+		defer func() {
+			m.mu.Lock()
+			delete(m.watches, key)
+			m.mu.Unlock()
+			cancel()
+		}()
+		m.follow(ctx, op)
+	}()
+}
+
+// follow checks the runtime's readiness like WaitReady, recording each new
+// phase, and reports at least once an interval so other replicas know the
+// operation is looked after.
+func (m *OperationManager) follow(ctx context.Context, op domain.Operation) {
+	ticker := time.NewTicker(readinessPollInterval)
+	defer ticker.Stop()
+	progress := op.Progress
+	reported := time.Now()
+	for {
+		p, err := m.readiness.Check(ctx, op.Namespace, op.Resource)
+		changed := false
+		switch {
+		case errors.Is(err, domain.ErrRuntimeFailed):
+			m.finish(op, progress, domain.OperationFailed, "", domain.OperationErrorOf(err))
+			return
+		case err != nil:
+			slog.DebugContext(ctx, "readiness check failed", "namespace", op.Namespace, "resource", op.Resource, "error", err)
+		case len(progress) == 0 || progress[len(progress)-1].Phase != p.Phase:
+			progress = append(progress, p)
+			changed = true
+		}
+		if err == nil && p.Phase == domain.ReadinessReady {
+			m.finish(op, progress, domain.OperationSucceeded, "runtime is ready", nil)
+			return
+		}
+		if changed || time.Since(reported) >= m.interval {
+			if !m.report(ctx, op, progress) {
+				return
+			}
+			reported = time.Now()
+		}
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				m.finish(op, progress, domain.OperationFailed, "", domain.OperationErrorOf(fmt.Errorf("%w by %s", domain.ErrReadinessTimeout, op.Deadline.Format(time.RFC3339))))
+			} else {
+				m.finish(op, progress, domain.OperationCancelled, "cancelled", nil)
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// report writes the progress so far and refreshes UpdatedAt. It returns false
+// when the watch should stop: the operation was cancelled, which it then
+// records, or it is no longer this replica's.
+func (m *OperationManager) report(ctx context.Context, op domain.Operation, progress []domain.ReadinessProgress) bool {
+	updated, err := m.store.Update(ctx, op.Namespace, op.ID, func(o *domain.Operation) error {
+		if o.Done() || o.Owner != m.owner {
+			return errOperationLost
+		}
+		now := time.Now()
+		o.Progress, o.UpdatedAt = progress, now
+		if o.CancelRequested {
+			o.Finish(domain.OperationCancelled, "cancelled", nil, now)
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errOperationLost), errors.Is(err, domain.ErrOperationNotFound):
+		return false
+	case err != nil:
+		// Keep following; the next report retries, and the operation is only
+		// taken over if reports keep failing.
+		slog.WarnContext(ctx, "failed to report operation progress", "namespace", op.Namespace, "operation", op.ID, "error", err)
+		return true
+	}
+	return !updated.Done()
+}
+
+// finish records the final state unless the operation was finished or taken
+// over elsewhere meanwhile.
+func (m *OperationManager) finish(op domain.Operation, progress []domain.ReadinessProgress, state domain.OperationState, message string, opErr *domain.OperationError) {
+	ctx, cancel := context.WithTimeout(context.Background(), operationWriteTimeout)
+	defer cancel()
+	_, err := m.store.Update(ctx, op.Namespace, op.ID, func(o *domain.Operation) error {
+		if o.Done() || o.Owner != m.owner {
+			return errOperationLost
+		}
+		o.Progress = progress
+		o.Finish(state, message, opErr, time.Now())
+		return nil
+	})
+	switch {
+	case errors.Is(err, errOperationLost):
+		return
+	case err != nil:
+		slog.WarnContext(ctx, "failed to finish operation", "namespace", op.Namespace, "operation", op.ID, "state", state, "error", err)
+		return
+	}
+	slog.InfoContext(ctx, "operation finished", "namespace", op.Namespace, "operation", op.ID, "resource", op.Resource, "state", state)
+}
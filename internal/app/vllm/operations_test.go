@@ -0,0 +1,168 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// phaseReadiness reports every runtime in one phase.
+type phaseReadiness domain.ReadinessPhase
+
+func (p phaseReadiness) Check(context.Context, string, string) (domain.ReadinessProgress, error) {
+	return domain.ReadinessProgress{Phase: domain.ReadinessPhase(p)}, nil
+}
+
+func runningOperation(id, owner string, updated time.Time) *domain.Operation {
+	return &domain.Operation{
+		ID:        id,
+		Namespace: "default",
+		Resource:  "llama",
+		Action:    domain.ActionStart,
+		State:     domain.OperationRunning,
+		Owner:     owner,
+		Deadline:  time.Now().Add(time.Hour),
+		CreatedAt: updated,
+		UpdatedAt: updated,
+	}
+}
+
+func seedOperations(t *testing.T, store infra.OperationStore, ops ...*domain.Operation) {
+	t.Helper()
+	for _, op := range ops {
+		if err := store.Create(t.Context(), op); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// waitDone polls the store until the operation is done.
+func waitDone(t *testing.T, store infra.OperationStore, id string) *domain.Operation {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		op, err := store.Get(t.Context(), "default", id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if op.Done() || time.Now().After(deadline) {
+			return op
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestOperationManagerTakesOverOrphans(t *testing.T) {
+	store := infra.NewConfigMapOperationStore(fake.NewSimpleClientset())
+	long := time.Now().Add(-time.Hour)
+	cancelled := runningOperation("cancelled", "replica-a", long)
+	cancelled.CancelRequested = true
+	expired := runningOperation("expired", "replica-a", long)
+	expired.Deadline = time.Now().Add(-time.Minute)
+	finished := runningOperation("finished", "replica-a", long)
+	finished.Finish(domain.OperationSucceeded, "runtime is ready", nil, time.Now().Add(-48*time.Hour))
+	seedOperations(t, store,
+		runningOperation("orphaned", "replica-a", long),
+		runningOperation("reporting", "replica-c", time.Now()),
+		cancelled, expired, finished,
+	)
+
+	m := NewOperationManager(store, phaseReadiness(domain.ReadinessReady), "replica-b", time.Second)
+	if err := m.Reconcile(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	if op := waitDone(t, store, "orphaned"); op.State != domain.OperationSucceeded || op.Owner != "replica-b" {
+		t.Errorf("orphaned operation is %s owned by %s, want Succeeded by replica-b", op.State, op.Owner)
+	}
+	if op, _ := store.Get(t.Context(), "default", "cancelled"); op.State != domain.OperationCancelled {
+		t.Errorf("cancelled operation is %s, want Cancelled", op.State)
+	}
+	if op, _ := store.Get(t.Context(), "default", "expired"); op.State != domain.OperationFailed || op.Error == nil {
+		t.Errorf("expired operation is %s with error %v, want Failed", op.State, op.Error)
+	}
+	if op, _ := store.Get(t.Context(), "default", "reporting"); op.Owner != "replica-c" || op.Done() {
+		t.Errorf("operation of a reporting replica is %s owned by %s, want it left alone", op.State, op.Owner)
+	}
+	if _, err := store.Get(t.Context(), "default", "finished"); err == nil {
+		t.Error("operation finished past the retention was kept")
+	}
+}
+
+func TestOperationManagerTakeOverOnce(t *testing.T) {
+	store := infra.NewConfigMapOperationStore(fake.NewSimpleClientset())
+	orphan := runningOperation("orphaned", "replica-a", time.Now().Add(-time.Hour))
+	seedOperations(t, store, orphan)
+
+	// Runtimes never get ready, so the winner keeps following the operation.
+	readiness := phaseReadiness(domain.ReadinessLoadingWeights)
+	b := NewOperationManager(store, readiness, "replica-b", time.Minute)
+	c := NewOperationManager(store, readiness, "replica-c", time.Minute)
+	now := time.Now()
+	if err := b.takeOver(t.Context(), *orphan, now); err != nil {
+		t.Fatal(err)
+	}
+	// c read the operation before b took it over.
+	if err := c.takeOver(t.Context(), *orphan, now); err != nil {
+		t.Fatal(err)
+	}
+	op, err := store.Get(t.Context(), "default", "orphaned")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Owner != "replica-b" || op.Done() {
+		t.Errorf("operation is %s owned by %s, want running under replica-b", op.State, op.Owner)
+	}
+	if !b.watching(*op) || c.watching(*op) {
+		t.Errorf("watching: b %v, c %v; want only b", b.watching(*op), c.watching(*op))
+	}
+
+	// The loser's reports are refused, which stops its watch.
+	if c.report(t.Context(), *op, nil) {
+		t.Error("replica-c reported on an operation it does not own")
+	}
+	if _, err := b.Cancel(t.Context(), "default", "orphaned"); err != nil {
+		t.Fatal(err)
+	}
+	if op := waitDone(t, store, "orphaned"); op.State != domain.OperationCancelled {
+		t.Errorf("operation is %s after Cancel, want Cancelled", op.State)
+	}
+}
+
+func TestOperationManagerReport(t *testing.T) {
+	store := infra.NewConfigMapOperationStore(fake.NewSimpleClientset())
+	started := time.Now().Add(-time.Minute)
+	op := runningOperation("op", "replica-b", started)
+	seedOperations(t, store, op)
+	m := NewOperationManager(store, phaseReadiness(domain.ReadinessLoadingWeights), "replica-b", time.Minute)
+
+	progress := []domain.ReadinessProgress{{Phase: domain.ReadinessScheduling}, {Phase: domain.ReadinessLoadingWeights}}
+	if !m.report(t.Context(), *op, progress) {
+		t.Fatal("report of a running operation stopped the watch")
+	}
+	got, _ := store.Get(t.Context(), "default", "op")
+	if len(got.Progress) != 2 || !got.UpdatedAt.After(started) {
+		t.Errorf("progress %+v, updated %v; want both phases and a fresh report", got.Progress, got.UpdatedAt)
+	}
+
+	// A cancellation requested through another replica is recorded by the owner.
+	if _, err := store.Update(t.Context(), "default", "op", func(o *domain.Operation) error {
+		o.CancelRequested = true
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if m.report(t.Context(), *op, progress) {
+		t.Error("report of a cancelled operation kept the watch")
+	}
+	if got, _ := store.Get(t.Context(), "default", "op"); got.State != domain.OperationCancelled {
+		t.Errorf("operation is %s, want Cancelled", got.State)
+	}
+	if m.report(t.Context(), domain.Operation{Namespace: "default", ID: "missing"}, nil) {
+		t.Error("report of a deleted operation kept the watch")
+	}
+}
@@ -0,0 +1,233 @@
+package vllm
+
+import (
+	auditCore "connect-go/internal/core/audit"
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RolloutParams asks for Stable to be replaced by a revision running Model
+// and/or Image.
+type RolloutParams struct {
+	Namespace string
+	Stable    string
+	Model     string
+	Image     string
+	Spec      domain.RolloutSpec
+}
+
+// RolloutController starts rollouts and drives progressing ones step by step:
+// it waits for the new revision to serve, shifts route weights while the
+// revision stays within its gates, then stops the old revision or rolls back.
+type RolloutController struct {
+	api       *infra.VLLMAPI
+	router    infra.TrafficRouter
+	readiness infra.ReadinessChecker
+	engines   infra.EngineStatsSource
+	recorder  auditCore.Recorder
+	interval  time.Duration
+}
+
+func NewRolloutController(api *infra.VLLMAPI, router infra.TrafficRouter, readiness infra.ReadinessChecker, engines infra.EngineStatsSource, recorder auditCore.Recorder, interval time.Duration) *RolloutController {
+	return &RolloutController{
+		api:       api,
+		router:    router,
+		readiness: readiness,
+		engines:   engines,
+		recorder:  recorder,
+		interval:  interval,
+	}
+}
+
+// Start creates the new revision and records a progressing rollout on the
+// stable resource. Traffic is not shifted until the revision is ready.
+func (c *RolloutController) Start(ctx context.Context, p RolloutParams) (_ *domain.Rollout, err error) {
+	ctx, span := tracing.Start(ctx, "RolloutController.Start", trace.WithAttributes(runtimeAttributes(p.Namespace, p.Stable, p.Model)...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	defer recordAction(ctx, c.recorder, domain.ActionRollout, p.Namespace, p.Stable, p.Model, time.Now(), &change, &err)
+
+	p.Spec.Default()
+	if err := p.Spec.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidRollout, err)
+	}
+	if p.Model == "" && p.Image == "" {
+		return nil, fmt.Errorf("%w: a model or image is required", domain.ErrInvalidRollout)
+	}
+	current, err := c.api.GetRollout(ctx, p.Namespace, p.Stable)
+	switch {
+	case err == nil && current.Phase == domain.RolloutProgressing:
+		return nil, fmt.Errorf("%w: %s/%s is moving to %s", domain.ErrRolloutInProgress, p.Namespace, p.Stable, current.Canary)
+	case err != nil && !errors.Is(err, domain.ErrNoRollout):
+		return nil, err
+	}
+
+	if change, err = c.api.CreateRevision(ctx, p.Namespace, p.Stable, infra.RevisionParams{Model: p.Model, Image: p.Image}); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	r := &domain.Rollout{
+		Namespace:     p.Namespace,
+		Stable:        p.Stable,
+		Canary:        change.Resource,
+		Spec:          p.Spec,
+		Step:          -1,
+		Phase:         domain.RolloutProgressing,
+		Message:       fmt.Sprintf("waiting for %s to become ready", change.Resource),
+		StartedAt:     now,
+		StepStartedAt: now,
+	}
+	if err := c.api.SetRolloutStatus(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *RolloutController) Get(ctx context.Context, namespace, stable string) (*domain.Rollout, error) {
+	return c.api.GetRollout(ctx, namespace, stable)
+}
+
+// Abort rolls a progressing rollout back immediately.
+func (c *RolloutController) Abort(ctx context.Context, namespace, stable string) (*domain.Rollout, error) {
+	r, err := c.api.GetRollout(ctx, namespace, stable)
+	if err != nil {
+		return nil, err
+	}
+	if r.Phase != domain.RolloutProgressing {
+		return nil, fmt.Errorf("%w: rollout of %s/%s is already %s", domain.ErrNoRollout, namespace, stable, r.Phase)
+	}
+	if err := c.rollBack(ctx, r, domain.RolloutAborted, "aborted by request"); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Run advances every progressing rollout each interval until ctx is cancelled.
+func (c *RolloutController) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := c.Reconcile(ctx); err != nil {
+			slog.WarnContext(ctx, "rollout pass failed", "error", err)
+		}
+	}
+}
+
+func (c *RolloutController) Reconcile(ctx context.Context) error {
+	rollouts, err := c.api.ListRollouts(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range rollouts {
+		r := &rollouts[i]
+		if err := c.reconcile(ctx, r, time.Now()); err != nil {
+			slog.WarnContext(ctx, "failed to advance rollout", "namespace", r.Namespace, "stable", r.Stable, "canary", r.Canary, "error", err)
+		}
+	}
+	return nil
+}
+
+func (c *RolloutController) reconcile(ctx context.Context, r *domain.Rollout, now time.Time) error {
+	ctx, span := tracing.Start(ctx, "RolloutController.reconcile", trace.WithAttributes(
+		attribute.String("vllm.namespace", r.Namespace),
+		attribute.String("vllm.stable", r.Stable),
+		attribute.String("vllm.canary", r.Canary),
+	))
+	defer span.End()
+
+	progress, err := c.readiness.Check(ctx, r.Namespace, r.Canary)
+	if errors.Is(err, domain.ErrRuntimeFailed) {
+		return c.rollBack(ctx, r, domain.RolloutRolledBack, err.Error())
+	}
+	ready := err == nil && progress.Phase == domain.ReadinessReady
+	var stats *domain.EngineStats
+	if res, ok := c.engines.Lookup(r.Namespace, r.Canary); ok {
+		stats = res.Stats
+	}
+
+	d := r.Decide(ready, stats, now)
+	span.SetAttributes(attribute.String("rollout.decision", string(d.Action)))
+	switch d.Action {
+	case domain.RolloutAdvance:
+		r.Step++
+		if err := c.setWeights(ctx, r); err != nil {
+			return err
+		}
+		r.StepStartedAt = now
+		r.Message = d.Message
+		slog.InfoContext(ctx, "rollout advanced", "namespace", r.Namespace, "stable", r.Stable, "canary", r.Canary, "weight", r.Weight(), "reason", d.Message)
+		return c.api.SetRolloutStatus(ctx, r)
+	case domain.RolloutPromote:
+		return c.promote(ctx, r, d.Message)
+	case domain.RolloutRollBack:
+		return c.rollBack(ctx, r, domain.RolloutRolledBack, d.Message)
+	default:
+		if d.Message == r.Message {
+			return nil
+		}
+		r.Message = d.Message
+		return c.api.SetRolloutStatus(ctx, r)
+	}
+}
+
+// promote stops the old revision once the new one has carried all traffic.
+func (c *RolloutController) promote(ctx context.Context, r *domain.Rollout, message string) (err error) {
+	var change *domain.SpecChange
+	defer recordAction(ctx, c.recorder, domain.ActionPromote, r.Namespace, r.Stable, "", time.Now(), &change, &err)
+
+	if change, err = c.api.SetAction(ctx, r.Namespace, r.Stable, domain.ActionStop); err != nil {
+		return err
+	}
+	r.Phase = domain.RolloutSucceeded
+	r.Message = fmt.Sprintf("%s; %s stopped", message, r.Stable)
+	return c.api.SetRolloutStatus(ctx, r)
+}
+
+// rollBack sends all traffic back to the stable revision and stops the new one.
+func (c *RolloutController) rollBack(ctx context.Context, r *domain.Rollout, phase domain.RolloutPhase, reason string) (err error) {
+	var change *domain.SpecChange
+	defer recordAction(ctx, c.recorder, domain.ActionRollback, r.Namespace, r.Stable, "", time.Now(), &change, &err)
+
+	slog.WarnContext(ctx, "rolling back", "namespace", r.Namespace, "stable", r.Stable, "canary", r.Canary, "weight", r.Weight(), "reason", reason)
+	if r.Step >= 0 {
+		r.Step = -1
+		if err := c.setWeights(ctx, r); err != nil {
+			return err
+		}
+	}
+	if change, err = c.api.SetAction(ctx, r.Namespace, r.Canary, domain.ActionStop); err != nil {
+		return err
+	}
+	r.Phase = phase
+	r.Message = reason
+	return c.api.SetRolloutStatus(ctx, r)
+}
+
+func (c *RolloutController) setWeights(ctx context.Context, r *domain.Rollout) error {
+	stablePort, err := c.api.RuntimePort(ctx, r.Namespace, r.Stable)
+	if err != nil {
+		return err
+	}
+	canaryPort, err := c.api.RuntimePort(ctx, r.Namespace, r.Canary)
+	if err != nil {
+		return err
+	}
+	return c.router.SetWeights(ctx, r.Namespace, r.Spec.Route, []domain.BackendWeight{
+		{Service: r.Stable, Port: stablePort, Weight: 100 - r.Weight()},
+		{Service: r.Canary, Port: canaryPort, Weight: r.Weight()},
+	})
+}
@@ -0,0 +1,63 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RouterController keeps the router's service discovery in line with which
+// runtimes serve: a runtime is registered once it is Ready and deregistered
+// when it is stopped or stops being ready.
+type RouterController struct {
+	api      *infra.VLLMAPI
+	router   infra.ModelRouter
+	interval time.Duration
+}
+
+func NewRouterController(api *infra.VLLMAPI, router infra.ModelRouter, interval time.Duration) *RouterController {
+	return &RouterController{
+		api:      api,
+		router:   router,
+		interval: interval,
+	}
+}
+
+// Run syncs the router registrations each interval until ctx is cancelled.
+func (c *RouterController) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := c.Reconcile(ctx); err != nil {
+			slog.WarnContext(ctx, "router pass failed", "error", err)
+		}
+	}
+}
+
+// Reconcile registers or deregisters each VLLM resource once.
+func (c *RouterController) Reconcile(ctx context.Context) error {
+	targets, err := c.api.ListRouterTargets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		if err := c.reconcile(ctx, t); err != nil {
+			slog.WarnContext(ctx, "failed to sync router registration", "namespace", t.Namespace, "resource", t.Name, "error", err)
+		}
+	}
+	return nil
+}
+
+func (c *RouterController) reconcile(ctx context.Context, t domain.RouterTarget) error {
+	if t.Serving {
+		return c.router.Register(ctx, t.RouterBackend)
+	}
+	return c.router.Deregister(ctx, t.Namespace, t.Name)
+}
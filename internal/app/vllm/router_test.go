@@ -0,0 +1,74 @@
+package vllm
+
+import (
+	vllmlisters "connect-go/api/vllm/client/listers/vllm/v1"
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func routedVLLM(name string, action vllmv1.Action, ready metav1.ConditionStatus) *vllmv1.VLLM {
+	return &vllmv1.VLLM{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       vllmv1.VLLMSpec{Model: "model-" + name, Action: action},
+		Status: vllmv1.VLLMStatus{
+			Endpoint:   "http://" + name + ":8000",
+			Conditions: []metav1.Condition{{Type: domain.ConditionReady, Status: ready}},
+		},
+	}
+}
+
+func TestRouterControllerReconcile(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	deleting := routedVLLM("deleting", vllmv1.ActionStart, metav1.ConditionTrue)
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	for _, v := range []*vllmv1.VLLM{
+		routedVLLM("llama", vllmv1.ActionStart, metav1.ConditionTrue),
+		routedVLLM("loading", vllmv1.ActionStart, metav1.ConditionFalse),
+		routedVLLM("stopping", vllmv1.ActionStop, metav1.ConditionTrue),
+		deleting,
+	} {
+		if err := indexer.Add(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	api := &infra.VLLMAPI{Lister: vllmlisters.NewVLLMLister(indexer)}
+	router := infra.NewMemoryRouter()
+	// Registered earlier, while they served.
+	for _, name := range []string{"loading", "stopping", "deleting"} {
+		if err := router.Register(t.Context(), domain.RouterBackend{Namespace: "default", Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	controller := NewRouterController(api, router, time.Minute)
+	if err := controller.Reconcile(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	want := []domain.RouterBackend{
+		// The cleanup controller deregisters deleted runtimes.
+		{Namespace: "default", Name: "deleting"},
+		{Namespace: "default", Name: "llama", Endpoint: "http://llama:8000", Model: "model-llama"},
+	}
+	if got := router.Backends(); !slices.Equal(got, want) {
+		t.Errorf("backends = %+v, want %+v", got, want)
+	}
+
+	// A runtime that stops being ready is taken out of rotation.
+	notReady := routedVLLM("llama", vllmv1.ActionStart, metav1.ConditionFalse)
+	if err := indexer.Update(notReady); err != nil {
+		t.Fatal(err)
+	}
+	if err := controller.Reconcile(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if got := router.Backends(); !slices.Equal(got, want[:1]) {
+		t.Errorf("backends = %+v, want only the deleting runtime", got)
+	}
+}
@@ -0,0 +1,107 @@
+package vllm
+
+import (
+	authCore "connect-go/internal/core/auth"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"log/slog"
+	"time"
+)
+
+// schedulerPrincipal is the caller recorded in the audit log for scheduled actions.
+var schedulerPrincipal = &authCore.Principal{Name: "system:scheduler", Method: "schedule"}
+
+// actionSetter is the part of VLLMServiceImpl the scheduler needs.
+type actionSetter interface {
+	SetAction(ctx context.Context, namespace, resource, model, action string) error
+}
+
+// Scheduler issues the start and stop actions declared in spec.schedule and
+// keeps status.schedule up to date with the next transition.
+type Scheduler struct {
+	service  actionSetter
+	api      *infra.VLLMAPI
+	interval time.Duration
+}
+
+func NewScheduler(service actionSetter, api *infra.VLLMAPI, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		service:  service,
+		api:      api,
+		interval: interval,
+	}
+}
+
+// Run evaluates every schedule each interval until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Reconcile(ctx); err != nil {
+			slog.WarnContext(ctx, "scheduler pass failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile issues any transition that fell due since the previous pass.
+func (s *Scheduler) Reconcile(ctx context.Context) error {
+	targets, err := s.api.ListScheduled(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		if err := s.reconcile(ctx, t, time.Now()); err != nil {
+			slog.WarnContext(ctx, "failed to apply schedule", "namespace", t.Namespace, "runtime", t.Name, "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) reconcile(ctx context.Context, t domain.ScheduleTarget, now time.Time) error {
+	last := t.LastScheduleTime
+	var due *domain.ScheduledTransition
+	if last.IsZero() {
+		// A newly scheduled resource is left as it is until its first transition.
+		last = now
+	} else {
+		var err error
+		if due, err = t.Schedule.Due(last, now); err != nil {
+			return err
+		}
+	}
+	if due != nil {
+		last = due.Time
+		s.apply(ctx, t, due)
+	}
+
+	next, err := t.Schedule.Next(now)
+	if err != nil {
+		return err
+	}
+	if due == nil && !t.LastScheduleTime.IsZero() && sameTransition(next, t.NextTransition) {
+		return nil
+	}
+	return s.api.SetScheduleStatus(ctx, t.Namespace, t.Name, last, next)
+}
+
+// apply issues a due transition through the service so it is audited and
+// counted like any other lifecycle action. Failures are logged by the service
+// and not retried; the next transition will be attempted as usual.
+func (s *Scheduler) apply(ctx context.Context, t domain.ScheduleTarget, due *domain.ScheduledTransition) {
+	ctx = authCore.NewContext(ctx, schedulerPrincipal)
+	slog.InfoContext(ctx, "issuing scheduled action", "namespace", t.Namespace, "runtime", t.Name, "action", due.Action, "scheduled", due.Time)
+	_ = s.service.SetAction(ctx, t.Namespace, t.Name, t.Model, due.Action)
+}
+
+func sameTransition(a, b *domain.ScheduledTransition) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Action == b.Action && a.Time.Equal(b.Time)
+}
@@ -1,30 +1,75 @@
 package vllm
 
 import (
+	auditCore "connect-go/internal/core/audit"
+	authCore "connect-go/internal/core/auth"
+	metricsCore "connect-go/internal/core/metrics"
+	"connect-go/internal/core/tracing"
 	domain "connect-go/internal/core/vllm"
 	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// VLLMService drives VLLM resources. On a dry run, mutating calls only report
+// in VLLMUseCase.Change what they would change; nothing is stored or audited.
 type VLLMService interface {
-	Start(namespace, runtimeName, model string) (*domain.VLLMUseCase, error)
-	Stop(namespace, runtimeName, model string) (*domain.VLLMUseCase, error)
-	Get(namespace string) ([]domain.VLLMResource, error)
+	Start(ctx context.Context, namespace, runtimeName, model string, dryRun bool) (*domain.VLLMUseCase, error)
+	Stop(ctx context.Context, namespace, runtimeName, model string, dryRun bool) (*domain.VLLMUseCase, error)
+	Create(ctx context.Context, p infra.CreateParams, dryRun bool) (*domain.VLLMUseCase, error)
+	Update(ctx context.Context, namespace, resource string, spec map[string]interface{}, dryRun bool) (*domain.VLLMUseCase, error)
+	// Delete marks a VLLM resource for deletion; the cleanup controller
+	// removes it once the runtime's resources are gone.
+	Delete(ctx context.Context, namespace, resource string, dryRun bool) (*domain.VLLMUseCase, error)
+	Get(ctx context.Context, namespace string) ([]domain.VLLMResource, error)
+	GetStats(ctx context.Context, namespace, runtimeName string) (*domain.VLLMResource, error)
+	WaitReady(ctx context.Context, namespace, resource string, timeout time.Duration) ([]domain.ReadinessProgress, error)
 }
 
+// ErrNoStats is returned by GetStats when no engine stats have been scraped for a runtime.
+var ErrNoStats = errors.New("no engine stats available")
+
+// readinessPollInterval is how often WaitReady checks a starting runtime. It
+// is a variable so tests can poll faster.
+var readinessPollInterval = 2 * time.Second
+
 type VLLMServiceImpl struct {
-	api  *infra.VLLMAPI
-	repo infra.VLLMRepository
+	api       *infra.VLLMAPI
+	repo      infra.VLLMRepository
+	recorder  auditCore.Recorder
+	engines   infra.EngineStatsSource
+	readiness infra.ReadinessChecker
+	router    infra.ModelRouter
 }
 
-func NewVLLMServiceImpl(api *infra.VLLMAPI, repo infra.VLLMRepository) *VLLMServiceImpl {
+// NewVLLMServiceImpl returns a VLLMServiceImpl. router may be nil when the
+// server does not register runtimes with a router.
+func NewVLLMServiceImpl(api *infra.VLLMAPI, repo infra.VLLMRepository, recorder auditCore.Recorder, engines infra.EngineStatsSource, readiness infra.ReadinessChecker, router infra.ModelRouter) *VLLMServiceImpl {
 	return &VLLMServiceImpl{
-		api:  api,
-		repo: repo,
+		api:       api,
+		repo:      repo,
+		recorder:  recorder,
+		engines:   engines,
+		readiness: readiness,
+		router:    router,
 	}
 }
 
-func (s *VLLMServiceImpl) Start(namespace, runningName, model string) (*domain.VLLMUseCase, error) {
+func (s *VLLMServiceImpl) Start(ctx context.Context, namespace, runningName, model string, dryRun bool) (_ *domain.VLLMUseCase, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMServiceImpl.Start", trace.WithAttributes(runtimeAttributes(namespace, runningName, model)...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	if !dryRun {
+		defer s.record(ctx, domain.ActionStart, namespace, runningName, model, time.Now(), &change, &err)
+	}
+
 	vllm, err := s.repo.FindByModel(namespace, runningName, model)
 	if err != nil {
 		return nil, err
@@ -32,17 +77,29 @@ func (s *VLLMServiceImpl) Start(namespace, runningName, model string) (*domain.V
 	if vllm.Status == domain.StatusRunning {
 		return nil, fmt.Errorf("model %s is already running", model)
 	}
-	if err := s.api.Start(namespace, model); err != nil {
+	if change, err = s.api.Start(ctx, namespace, model, dryRun); err != nil {
 		return nil, err
 	}
+	if dryRun {
+		vllm.Resource, vllm.Change = change.Resource, change
+		return vllm, nil
+	}
 	refreshVLLM, err := s.repo.FindByModel(namespace, runningName, model)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh VLLM status after start: %w", err)
 	}
+	refreshVLLM.Resource, refreshVLLM.Change = change.Resource, change
 	return refreshVLLM, nil
 }
 
-func (s *VLLMServiceImpl) Stop(namespace, runningName, model string) (*domain.VLLMUseCase, error) {
+func (s *VLLMServiceImpl) Stop(ctx context.Context, namespace, runningName, model string, dryRun bool) (_ *domain.VLLMUseCase, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMServiceImpl.Stop", trace.WithAttributes(runtimeAttributes(namespace, runningName, model)...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	if !dryRun {
+		defer s.record(ctx, domain.ActionStop, namespace, runningName, model, time.Now(), &change, &err)
+	}
+
 	vllm, err := s.repo.FindByModel(namespace, runningName, model)
 	if err != nil {
 		return nil, err
@@ -50,16 +107,223 @@ func (s *VLLMServiceImpl) Stop(namespace, runningName, model string) (*domain.VL
 	if vllm.Status == domain.StatusStopped {
 		return nil, fmt.Errorf("model %s is already stopped", model)
 	}
-	if err := s.api.Stop(namespace, model); err != nil {
+	if change, err = s.api.Stop(ctx, namespace, model, dryRun); err != nil {
 		return nil, err
 	}
+	if dryRun {
+		vllm.Resource, vllm.Change = change.Resource, change
+		return vllm, nil
+	}
+	// Stop routing to the runtime now rather than on the router controller's
+	// next pass, which retries if this fails.
+	if s.router != nil {
+		if err := s.router.Deregister(ctx, namespace, change.Resource); err != nil {
+			slog.WarnContext(ctx, "failed to deregister stopped runtime from router", "namespace", namespace, "resource", change.Resource, "error", err)
+		}
+	}
 	refreshVLLM, err := s.repo.FindByModel(namespace, runningName, model)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh VLLM status after stop: %w", err)
 	}
+	refreshVLLM.Resource, refreshVLLM.Change = change.Resource, change
 	return refreshVLLM, nil
 }
 
-func (s *VLLMServiceImpl) Get(namespace string) ([]domain.VLLMResource, error) {
-	return s.api.Get(namespace)
+func (s *VLLMServiceImpl) Create(ctx context.Context, p infra.CreateParams, dryRun bool) (_ *domain.VLLMUseCase, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMServiceImpl.Create", trace.WithAttributes(runtimeAttributes(p.Namespace, p.Name, p.Model)...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	if !dryRun {
+		defer s.record(ctx, domain.ActionCreate, p.Namespace, p.Name, p.Model, time.Now(), &change, &err)
+	}
+
+	if change, err = s.api.Create(ctx, p, dryRun); err != nil {
+		return nil, err
+	}
+	vllm := domain.NewVLLM(p.Namespace, p.RuntimeName, p.Model)
+	vllm.Status = domain.StatusPending
+	vllm.Resource, vllm.Change = change.Resource, change
+	return vllm, nil
+}
+
+func (s *VLLMServiceImpl) Update(ctx context.Context, namespace, resource string, spec map[string]interface{}, dryRun bool) (_ *domain.VLLMUseCase, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMServiceImpl.Update", trace.WithAttributes(runtimeAttributes(namespace, resource, "")...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	if !dryRun {
+		defer s.record(ctx, domain.ActionUpdate, namespace, resource, "", time.Now(), &change, &err)
+	}
+
+	if change, err = s.api.Update(ctx, namespace, resource, spec, dryRun); err != nil {
+		return nil, err
+	}
+	model, _ := change.After["model"].(string)
+	runtimeName, _ := change.After["runtimeName"].(string)
+	vllm := domain.NewVLLM(namespace, runtimeName, model)
+	vllm.Status = domain.StatusUpdating
+	vllm.Resource, vllm.Change = change.Resource, change
+	return vllm, nil
+}
+
+func (s *VLLMServiceImpl) Delete(ctx context.Context, namespace, resource string, dryRun bool) (_ *domain.VLLMUseCase, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMServiceImpl.Delete", trace.WithAttributes(runtimeAttributes(namespace, resource, "")...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	if !dryRun {
+		defer s.record(ctx, domain.ActionDelete, namespace, resource, "", time.Now(), &change, &err)
+	}
+
+	if change, err = s.api.Delete(ctx, namespace, resource, dryRun); err != nil {
+		return nil, err
+	}
+	model, _ := change.Before["model"].(string)
+	runtimeName, _ := change.Before["runtimeName"].(string)
+	vllm := domain.NewVLLM(namespace, runtimeName, model)
+	vllm.Status = domain.StatusDeleting
+	vllm.Resource, vllm.Change = change.Resource, change
+	return vllm, nil
+}
+
+func (s *VLLMServiceImpl) Get(ctx context.Context, namespace string) (_ []domain.VLLMResource, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMServiceImpl.Get", trace.WithAttributes(attribute.String("vllm.namespace", namespace)))
+	defer func() { tracing.End(span, err) }()
+	vllms, err := s.api.Get(ctx, namespace)
+	if err != nil || s.engines == nil {
+		return vllms, err
+	}
+	for i := range vllms {
+		if r, ok := s.engines.Lookup(namespace, vllms[i].Name); ok {
+			vllms[i].Stats = r.Stats
+		}
+	}
+	return vllms, nil
+}
+
+func (s *VLLMServiceImpl) GetStats(ctx context.Context, namespace, runtimeName string) (*domain.VLLMResource, error) {
+	if s.engines == nil {
+		return nil, ErrNoStats
+	}
+	r, ok := s.engines.Lookup(namespace, runtimeName)
+	if !ok || r.Stats == nil {
+		return nil, fmt.Errorf("%w for %s/%s", ErrNoStats, namespace, runtimeName)
+	}
+	return r, nil
+}
+
+// WaitReady polls a started runtime until it serves requests, returning every
+// phase it went through. It wraps domain.ErrReadinessTimeout when the timeout
+// expires first and domain.ErrRuntimeFailed when the runtime cannot start.
+func (s *VLLMServiceImpl) WaitReady(ctx context.Context, namespace, resource string, timeout time.Duration) (progress []domain.ReadinessProgress, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMServiceImpl.WaitReady", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.resource", resource),
+	))
+	defer func() { tracing.End(span, err) }()
+	if s.readiness == nil {
+		return nil, fmt.Errorf("readiness checks are not configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(readinessPollInterval)
+	defer ticker.Stop()
+	for {
+		p, err := s.readiness.Check(ctx, namespace, resource)
+		switch {
+		case errors.Is(err, domain.ErrRuntimeFailed):
+			return progress, err
+		case err != nil:
+			slog.DebugContext(ctx, "readiness check failed", "namespace", namespace, "resource", resource, "error", err)
+		case len(progress) == 0 || progress[len(progress)-1].Phase != p.Phase:
+			progress = append(progress, p)
+			span.AddEvent(string(p.Phase), trace.WithAttributes(attribute.String("message", p.Message)))
+			slog.InfoContext(ctx, "runtime readiness", "namespace", namespace, "resource", resource, "phase", p.Phase, "message", p.Message)
+		}
+		if p.Phase == domain.ReadinessReady {
+			return progress, nil
+		}
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return progress, fmt.Errorf("%w after %s", domain.ErrReadinessTimeout, timeout)
+			}
+			return progress, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// SetAction starts or stops an existing VLLM resource by name, leaving the rest of
+// its spec as it is. It is used by the scheduler, which acts on resources rather
+// than templates.
+func (s *VLLMServiceImpl) SetAction(ctx context.Context, namespace, resource, model, action string) (err error) {
+	ctx, span := tracing.Start(ctx, "VLLMServiceImpl.SetAction", trace.WithAttributes(
+		append(runtimeAttributes(namespace, resource, model), attribute.String("vllm.action", action))...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	defer s.record(ctx, action, namespace, resource, model, time.Now(), &change, &err)
+
+	change, err = s.api.SetAction(ctx, namespace, resource, action)
+	return err
+}
+
+func runtimeAttributes(namespace, runtimeName, model string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.runtime", runtimeName),
+		attribute.String("vllm.model", model),
+	}
+}
+
+func (s *VLLMServiceImpl) record(ctx context.Context, action, namespace, runtimeName, model string, started time.Time, change **domain.SpecChange, err *error) {
+	recordAction(ctx, s.recorder, action, namespace, runtimeName, model, started, change, err)
+}
+
+// recordAction logs, counts and audits the outcome of a lifecycle action. It is
+// deferred by each mutating method, so change and err point at the method's
+// final values.
+func recordAction(ctx context.Context, recorder auditCore.Recorder, action, namespace, runtimeName, model string, started time.Time, change **domain.SpecChange, err *error) {
+	ev := auditCore.Event{
+		ID:          uuid.NewString(),
+		Time:        started.UTC(),
+		Caller:      "anonymous",
+		Action:      action,
+		Namespace:   namespace,
+		RuntimeName: runtimeName,
+		Outcome:     auditCore.OutcomeSuccess,
+		Latency:     time.Since(started),
+	}
+	if p, ok := authCore.FromContext(ctx); ok {
+		ev.Caller = p.Name
+		ev.Groups = p.Groups
+	}
+	if c := *change; c != nil {
+		ev.Resource = c.Resource
+		ev.Template = c.Template
+		ev.Changes = auditCore.Diff(c.Before, c.After)
+	}
+	if *err != nil {
+		ev.Outcome = auditCore.OutcomeFailure
+		ev.Error = (*err).Error()
+		slog.WarnContext(ctx, "lifecycle action failed", "action", action, "namespace", namespace, "runtime", runtimeName, "caller", ev.Caller, "error", *err)
+	} else {
+		slog.InfoContext(ctx, "lifecycle action succeeded", "action", action, "namespace", namespace, "runtime", runtimeName, "caller", ev.Caller, "latency", ev.Latency)
+	}
+	metricsCore.LifecycleActions.WithLabelValues(namespace, model, action, ev.Outcome).Inc()
+	if ev.Resource != "" && ev.Outcome == auditCore.OutcomeSuccess {
+		switch action {
+		case domain.ActionStart:
+			metricsCore.StartRequested(namespace, ev.Resource, started)
+		case domain.ActionStop:
+			metricsCore.StopRequested(namespace, ev.Resource)
+		}
+	}
+
+	if recorder == nil {
+		return
+	}
+	// Recording must not fail the action or be cut short by a cancelled request.
+	if recErr := recorder.Record(context.WithoutCancel(ctx), ev); recErr != nil {
+		slog.ErrorContext(ctx, "failed to record audit event", "audit_id", ev.ID, "error", recErr)
+	}
 }
@@ -0,0 +1,147 @@
+package vllm
+
+import (
+	auditCore "connect-go/internal/core/audit"
+	authCore "connect-go/internal/core/auth"
+	domain "connect-go/internal/core/vllm"
+	auditInfra "connect-go/internal/data/audit"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRecordAction(t *testing.T) {
+	store := auditInfra.NewMemoryStore(10)
+	ctx := authCore.NewContext(t.Context(), &authCore.Principal{Name: "alice", Groups: []string{"ml-team"}})
+	started := time.Now().Add(-time.Second)
+
+	change := &domain.SpecChange{
+		Resource: "llama-abc12",
+		Template: "llama",
+		Before:   map[string]interface{}{"action": "stop", "replicas": int64(1)},
+		After:    map[string]interface{}{"action": "start", "replicas": int64(1)},
+	}
+	var err error
+	recordAction(ctx, store, domain.ActionStart, "default", "llama", "meta-llama/Llama-3.1-8B", started, &change, &err)
+
+	var noChange *domain.SpecChange
+	err = errors.New("quota exceeded")
+	// A cancelled request is still audited.
+	cancelled, cancel := context.WithCancel(t.Context())
+	cancel()
+	recordAction(cancelled, store, domain.ActionStop, "default", "qwen", "Qwen/Qwen2.5-7B", started, &noChange, &err)
+
+	events, _ := store.List(t.Context(), auditCore.Query{})
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	failed, succeeded := events[0], events[1]
+	if succeeded.Caller != "alice" || !slices.Equal(succeeded.Groups, []string{"ml-team"}) || succeeded.Outcome != auditCore.OutcomeSuccess {
+		t.Errorf("succeeded event = %+v", succeeded)
+	}
+	if succeeded.Resource != "llama-abc12" || succeeded.Template != "llama" || succeeded.RuntimeName != "llama" || succeeded.Namespace != "default" {
+		t.Errorf("succeeded event names %s/%s from %s", succeeded.Namespace, succeeded.Resource, succeeded.Template)
+	}
+	if want := []auditCore.Change{{Path: "action", Before: `"stop"`, After: `"start"`}}; !slices.Equal(succeeded.Changes, want) {
+		t.Errorf("changes = %+v, want %+v", succeeded.Changes, want)
+	}
+	if !succeeded.Time.Equal(started.UTC()) || succeeded.Latency < time.Second || succeeded.ID == "" {
+		t.Errorf("time %v, latency %v, id %q", succeeded.Time, succeeded.Latency, succeeded.ID)
+	}
+	if failed.Caller != "anonymous" || failed.Outcome != auditCore.OutcomeFailure || failed.Error != "quota exceeded" || failed.Resource != "" {
+		t.Errorf("failed event = %+v", failed)
+	}
+}
+
+// scriptedReadiness reports the given steps in order, then repeats the last.
+type scriptedReadiness struct {
+	steps []domain.ReadinessProgress
+	errs  []error
+	calls int
+}
+
+func (r *scriptedReadiness) Check(context.Context, string, string) (domain.ReadinessProgress, error) {
+	i := min(r.calls, len(r.steps)-1)
+	r.calls++
+	var err error
+	if i < len(r.errs) {
+		err = r.errs[i]
+	}
+	return r.steps[i], err
+}
+
+func fastReadinessPolls(t *testing.T) {
+	t.Helper()
+	interval := readinessPollInterval
+	readinessPollInterval = time.Millisecond
+	t.Cleanup(func() { readinessPollInterval = interval })
+}
+
+func phases(progress []domain.ReadinessProgress) []domain.ReadinessPhase {
+	var out []domain.ReadinessPhase
+	for _, p := range progress {
+		out = append(out, p.Phase)
+	}
+	return out
+}
+
+func TestWaitReady(t *testing.T) {
+	fastReadinessPolls(t)
+	pending := domain.ReadinessProgress{Phase: domain.ReadinessScheduling}
+	loading := domain.ReadinessProgress{Phase: domain.ReadinessLoadingWeights}
+	ready := domain.ReadinessProgress{Phase: domain.ReadinessReady}
+
+	tests := []struct {
+		name       string
+		readiness  *scriptedReadiness
+		wantErr    error
+		wantPhases []domain.ReadinessPhase
+	}{{
+		name: "reports each phase once",
+		readiness: &scriptedReadiness{
+			steps: []domain.ReadinessProgress{pending, pending, loading, loading, ready},
+		},
+		wantPhases: []domain.ReadinessPhase{pending.Phase, loading.Phase, domain.ReadinessReady},
+	}, {
+		name: "skips failed checks",
+		readiness: &scriptedReadiness{
+			steps: []domain.ReadinessProgress{{}, loading, ready},
+			errs:  []error{errors.New("connection refused")},
+		},
+		wantPhases: []domain.ReadinessPhase{loading.Phase, domain.ReadinessReady},
+	}, {
+		name:       "times out",
+		readiness:  &scriptedReadiness{steps: []domain.ReadinessProgress{pending, loading}},
+		wantErr:    domain.ErrReadinessTimeout,
+		wantPhases: []domain.ReadinessPhase{pending.Phase, loading.Phase},
+	}, {
+		name: "stops when the runtime fails",
+		readiness: &scriptedReadiness{
+			steps: []domain.ReadinessProgress{pending, {}},
+			errs:  []error{nil, domain.ErrRuntimeFailed},
+		},
+		wantErr:    domain.ErrRuntimeFailed,
+		wantPhases: []domain.ReadinessPhase{pending.Phase},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewVLLMServiceImpl(nil, nil, nil, nil, tt.readiness, nil)
+			progress, err := s.WaitReady(t.Context(), "default", "llama", 50*time.Millisecond)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WaitReady() = %v, want %v", err, tt.wantErr)
+			}
+			if got := phases(progress); !slices.Equal(got, tt.wantPhases) {
+				t.Errorf("progress = %v, want %v", got, tt.wantPhases)
+			}
+		})
+	}
+}
+
+func TestWaitReadyWithoutReadinessChecks(t *testing.T) {
+	s := NewVLLMServiceImpl(nil, nil, nil, nil, nil, nil)
+	if _, err := s.WaitReady(t.Context(), "default", "llama", time.Second); err == nil {
+		t.Fatal("WaitReady() without a readiness checker succeeded")
+	}
+}
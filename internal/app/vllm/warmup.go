@@ -0,0 +1,123 @@
+package vllm
+
+import (
+	auditCore "connect-go/internal/core/audit"
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultWarmupTimeout bounds how long a warm-up may run before it is marked
+// failed and its DaemonSet removed.
+const defaultWarmupTimeout = 2 * time.Hour
+
+// WarmupController starts warm-ups and follows them until every target node
+// has the image and weights, then removes the warm-up DaemonSet.
+type WarmupController struct {
+	api      *infra.VLLMAPI
+	warmer   *infra.Warmer
+	recorder auditCore.Recorder
+	interval time.Duration
+	Timeout  time.Duration
+}
+
+func NewWarmupController(api *infra.VLLMAPI, warmer *infra.Warmer, recorder auditCore.Recorder, interval time.Duration) *WarmupController {
+	return &WarmupController{
+		api:      api,
+		warmer:   warmer,
+		recorder: recorder,
+		interval: interval,
+		Timeout:  defaultWarmupTimeout,
+	}
+}
+
+// Start warms the nodes matching nodeSelector for a VLLM resource.
+func (c *WarmupController) Start(ctx context.Context, namespace, name string, nodeSelector map[string]string) (_ *domain.Warmup, err error) {
+	ctx, span := tracing.Start(ctx, "WarmupController.Start", trace.WithAttributes(runtimeAttributes(namespace, name, "")...))
+	defer func() { tracing.End(span, err) }()
+	var change *domain.SpecChange
+	defer recordAction(ctx, c.recorder, domain.ActionWarmup, namespace, name, "", time.Now(), &change, &err)
+
+	current, err := c.api.GetWarmup(ctx, namespace, name)
+	switch {
+	case err == nil && !current.Done():
+		return nil, fmt.Errorf("%w for %s/%s", domain.ErrWarmupInProgress, namespace, name)
+	case err != nil && !errors.Is(err, domain.ErrNoWarmup):
+		return nil, err
+	}
+
+	w, err := c.warmer.Start(ctx, namespace, name, nodeSelector)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.api.SetWarmupStatus(ctx, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (c *WarmupController) Get(ctx context.Context, namespace, name string) (*domain.Warmup, error) {
+	return c.api.GetWarmup(ctx, namespace, name)
+}
+
+// Run updates every unfinished warm-up each interval until ctx is cancelled.
+func (c *WarmupController) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := c.Reconcile(ctx); err != nil {
+			slog.WarnContext(ctx, "warm-up pass failed", "error", err)
+		}
+	}
+}
+
+func (c *WarmupController) Reconcile(ctx context.Context) error {
+	warmups, err := c.api.ListWarmups(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range warmups {
+		w := &warmups[i]
+		if err := c.reconcile(ctx, w, time.Now()); err != nil {
+			slog.WarnContext(ctx, "failed to update warm-up", "namespace", w.Namespace, "resource", w.Name, "error", err)
+		}
+	}
+	return nil
+}
+
+func (c *WarmupController) reconcile(ctx context.Context, w *domain.Warmup, now time.Time) error {
+	before := *w
+	if err := c.warmer.Progress(ctx, w); err != nil {
+		return err
+	}
+	if !w.Done() && c.Timeout > 0 && now.Sub(w.StartedAt) > c.Timeout {
+		w.Phase = domain.WarmupFailed
+		w.Message = fmt.Sprintf("timed out after %s with %d/%d node(s) ready: %s", c.Timeout, w.NodesReady, w.NodesDesired, w.Message)
+	}
+	if w.Done() {
+		if w.CompletedAt.IsZero() {
+			w.CompletedAt = now
+		}
+		if err := c.warmer.Cleanup(ctx, w.Namespace, w.Name); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "warm-up finished", "namespace", w.Namespace, "resource", w.Name, "phase", w.Phase, "message", w.Message)
+	}
+	if w.Phase == before.Phase && w.Message == before.Message && w.NodesDesired == before.NodesDesired &&
+		w.NodesPulled == before.NodesPulled && w.NodesReady == before.NodesReady {
+		return nil
+	}
+	return c.api.SetWarmupStatus(ctx, w)
+}
@@ -0,0 +1,62 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// WorkloadController keeps the Deployment, Service and PodDisruptionBudget of
+// every VLLM resource in sync with its spec.
+type WorkloadController struct {
+	api      *infra.VLLMAPI
+	syncer   *infra.WorkloadSyncer
+	interval time.Duration
+}
+
+func NewWorkloadController(api *infra.VLLMAPI, syncer *infra.WorkloadSyncer, interval time.Duration) *WorkloadController {
+	return &WorkloadController{
+		api:      api,
+		syncer:   syncer,
+		interval: interval,
+	}
+}
+
+// Run syncs the workloads each interval until ctx is cancelled.
+func (c *WorkloadController) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := c.Reconcile(ctx); err != nil {
+			slog.WarnContext(ctx, "workload pass failed", "error", err)
+		}
+	}
+}
+
+// Reconcile syncs the workload of each VLLM resource once.
+func (c *WorkloadController) Reconcile(ctx context.Context) error {
+	vllms, err := c.api.ListVLLMs(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range vllms {
+		v := &vllms[i]
+		err := c.syncer.Sync(ctx, v)
+		switch {
+		case errors.Is(err, domain.ErrWorkloadNotOwned):
+			// Managed by something else; leave it be.
+			slog.DebugContext(ctx, "skipping runtime workload", "namespace", v.Namespace, "resource", v.Name, "error", err)
+		case err != nil:
+			slog.WarnContext(ctx, "failed to sync runtime workload", "namespace", v.Namespace, "resource", v.Name, "error", err)
+		}
+	}
+	return nil
+}
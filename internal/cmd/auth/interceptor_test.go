@@ -0,0 +1,87 @@
+package auth
+
+import (
+	vllmv1 "connect-go/api/vllmv1"
+	"connect-go/api/vllmv1/vllmv1connect"
+	authCore "connect-go/internal/core/auth"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+// Procedures missing from procedureActions skip authorization, so every RPC
+// of the service must be mapped.
+func TestEveryProcedureIsAuthorized(t *testing.T) {
+	methods := vllmv1.File_vllm_v1_vllm_proto.Services().ByName("LLMApiService").Methods()
+	if methods.Len() == 0 {
+		t.Fatal("LLMApiService has no methods")
+	}
+	for i := range methods.Len() {
+		procedure := "/vllm.v1.LLMApiService/" + string(methods.Get(i).Name())
+		if _, ok := procedureActions[procedure]; !ok {
+			t.Errorf("%s has no action to authorize", procedure)
+		}
+	}
+}
+
+// tokenAuthenticator accepts the bearer token "alice".
+type tokenAuthenticator struct{}
+
+func (tokenAuthenticator) Authenticate(_ context.Context, creds authCore.Credentials) (*authCore.Principal, error) {
+	switch creds.Header.Get("Authorization") {
+	case "":
+		return nil, authCore.ErrNoCredentials
+	case "Bearer alice":
+		return &authCore.Principal{Name: "alice"}, nil
+	}
+	return nil, errors.New("unknown token")
+}
+
+func TestInterceptor(t *testing.T) {
+	policy := &authCore.Policy{Rules: []authCore.Rule{
+		{Users: []string{"alice"}, Namespaces: []string{"staging"}, Actions: []authCore.Action{authCore.ActionStart}},
+	}}
+	path, handler := vllmv1connect.NewLLMApiServiceHandler(vllmv1connect.UnimplementedLLMApiServiceHandler{},
+		connect.WithInterceptors(NewInterceptor(tokenAuthenticator{}, policy)))
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := vllmv1connect.NewLLMApiServiceClient(server.Client(), server.URL)
+
+	tests := []struct {
+		name      string
+		token     string
+		namespace string
+		stop      bool
+		want      connect.Code
+	}{
+		// The unimplemented handler answers requests that get through.
+		{"allowed", "alice", "staging", false, connect.CodeUnimplemented},
+		{"other namespace", "alice", "prod", false, connect.CodePermissionDenied},
+		{"action not granted", "alice", "staging", true, connect.CodePermissionDenied},
+		{"unknown token", "mallory", "staging", false, connect.CodeUnauthenticated},
+		{"no token", "", "staging", false, connect.CodeUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := connect.NewRequest(&vllmv1.LLMRequest{Namespace: tt.namespace, RuntimeName: "llama"})
+			if tt.token != "" {
+				req.Header().Set("Authorization", "Bearer "+tt.token)
+			}
+			var err error
+			if tt.stop {
+				_, err = client.StopLLM(t.Context(), req)
+			} else {
+				_, err = client.StartLLM(t.Context(), req)
+			}
+			if got := connect.CodeOf(err); got != tt.want {
+				t.Errorf("code = %v, want %v (%v)", got, tt.want, err)
+			}
+		})
+	}
+}
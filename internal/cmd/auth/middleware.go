@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"bytes"
+	authCore "connect-go/internal/core/auth"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+const maxPeekBytes = 1 << 20
+
+// Middleware authenticates every HTTP request and, for the JSON routes listed in
+// Routes, authorizes the mapped action against the namespace in the request body.
+type Middleware struct {
+	Authenticator authCore.Authenticator
+	Policy        *authCore.Policy
+	Routes        map[string]authCore.Action
+}
+
+func NewMiddleware(authn authCore.Authenticator, policy *authCore.Policy, routes map[string]authCore.Action) *Middleware {
+	return &Middleware{
+		Authenticator: authn,
+		Policy:        policy,
+		Routes:        routes,
+	}
+}
+
+func (m *Middleware) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := m.Authenticator.Authenticate(r.Context(), authCore.Credentials{
+			Header: r.Header,
+			TLS:    r.TLS,
+		})
+		if err != nil {
+			slog.WarnContext(r.Context(), "authentication failed", "method", r.Method, "path", r.URL.Path, "error", err)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="vllm"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		r = r.WithContext(authCore.NewContext(r.Context(), principal))
+
+		if action, ok := m.Routes[r.URL.Path]; ok && m.Policy != nil {
+			namespace, err := peekNamespace(r)
+			if err != nil {
+				http.Error(w, "Invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := m.Policy.Authorize(principal, action, namespace); err != nil {
+				slog.WarnContext(r.Context(), "authorization denied", "principal", principal.Name, "action", action, "namespace", namespace, "error", err)
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// peekNamespace reads the namespace field from a JSON body and restores the body
+// so the handler can decode it again.
+func peekNamespace(r *http.Request) (string, error) {
+	if r.Body == nil {
+		return "", nil
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPeekBytes+1))
+	if err != nil {
+		return "", err
+	}
+	if len(body) > maxPeekBytes {
+		return "", errors.New("request body too large")
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if len(body) == 0 {
+		return "", nil
+	}
+	var req struct {
+		Namespace string `json:"namespace"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return "", err
+	}
+	return req.Namespace, nil
+}
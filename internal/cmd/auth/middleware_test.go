@@ -0,0 +1,67 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	policy := &authCore.Policy{Rules: []authCore.Rule{
+		{Users: []string{"alice"}, Namespaces: []string{"staging"}, Actions: []authCore.Action{authCore.ActionStart}},
+	}}
+	var body string
+	var principal *authCore.Principal
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		principal, _ = authCore.FromContext(r.Context())
+	})
+	handler := NewMiddleware(tokenAuthenticator{}, policy, map[string]authCore.Action{"/llm/start": authCore.ActionStart}).Wrap(next)
+
+	tests := []struct {
+		name  string
+		token string
+		path  string
+		body  string
+		want  int
+	}{
+		{"allowed", "alice", "/llm/start", `{"namespace": "staging", "runtime_name": "llama"}`, http.StatusOK},
+		{"other namespace", "alice", "/llm/start", `{"namespace": "prod"}`, http.StatusForbidden},
+		{"empty body is default namespace", "alice", "/llm/start", "", http.StatusForbidden},
+		{"invalid json", "alice", "/llm/start", `{"namespace":`, http.StatusBadRequest},
+		{"too large", "alice", "/llm/start", `{"namespace": "staging", "pad": "` + strings.Repeat("x", maxPeekBytes) + `"}`, http.StatusBadRequest},
+		{"unmapped route only authenticates", "alice", "/metrics", "", http.StatusOK},
+		{"unauthenticated", "", "/metrics", "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, principal = "", nil
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if tt.want != http.StatusOK {
+				if rec.Code == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+					t.Error("401 without a WWW-Authenticate challenge")
+				}
+				return
+			}
+			// The handler reads the body the middleware peeked at.
+			if body != tt.body {
+				t.Errorf("handler read body %q, want %q", body, tt.body)
+			}
+			if principal == nil || principal.Name != "alice" {
+				t.Errorf("principal in context = %+v, want alice", principal)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package logging
+
+import (
+	logCore "connect-go/internal/core/logging"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidRequestID(t *testing.T) {
+	tests := []struct {
+		id    string
+		valid bool
+	}{
+		{"req-1", true},
+		{"0b4f6a3e-5c1d-4f7e-9a2b-8c3d4e5f6a7b", true},
+		{strings.Repeat("a", maxRequestIDLength), true},
+		{"", false},
+		{strings.Repeat("a", maxRequestIDLength+1), false},
+		{"has space", false},
+		{"line\nbreak", false},
+		{"ünïcode", false},
+	}
+	for _, tt := range tests {
+		if got := validRequestID(tt.id); got != tt.valid {
+			t.Errorf("validRequestID(%q) = %v, want %v", tt.id, got, tt.valid)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var seen string
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = logCore.RequestID(r.Context())
+		w.WriteHeader(http.StatusAccepted)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/llm/list", nil)
+	req.Header.Set(logCore.RequestIDHeader, "req-1")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if seen != "req-1" || rec.Header().Get(logCore.RequestIDHeader) != "req-1" || rec.Code != http.StatusAccepted {
+		t.Errorf("context ID %q, response ID %q, status %d; want the caller's ID echoed", seen, rec.Header().Get(logCore.RequestIDHeader), rec.Code)
+	}
+
+	// An unusable ID is replaced by a generated one.
+	req = httptest.NewRequest(http.MethodGet, "/llm/list", nil)
+	req.Header.Set(logCore.RequestIDHeader, "bad id\n")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if seen == "" || seen == "bad id\n" || rec.Header().Get(logCore.RequestIDHeader) != seen {
+		t.Errorf("context ID %q, response ID %q; want a generated ID in both", seen, rec.Header().Get(logCore.RequestIDHeader))
+	}
+}
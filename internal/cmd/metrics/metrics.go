@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	metricsCore "connect-go/internal/core/metrics"
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Handler serves the control plane registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(metricsCore.Registry, promhttp.HandlerOpts{})
+}
+
+// InstrumentHandler counts and times requests to a single JSON route.
+func InstrumentHandler(route string, h http.HandlerFunc) http.Handler {
+	labels := prometheus.Labels{"route": route}
+	return promhttp.InstrumentHandlerDuration(
+		metricsCore.HTTPDuration.MustCurryWith(labels),
+		promhttp.InstrumentHandlerCounter(metricsCore.HTTPRequests.MustCurryWith(labels), h),
+	)
+}
+
+// NewInterceptor counts and times Connect RPCs per procedure.
+func NewInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+			procedure := req.Spec().Procedure
+			code := "ok"
+			if err != nil {
+				code = connect.CodeOf(err).String()
+			}
+			metricsCore.RPCRequests.WithLabelValues(procedure, code).Inc()
+			metricsCore.RPCDuration.WithLabelValues(procedure).Observe(time.Since(start).Seconds())
+			return res, err
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// InstrumentHandler opens a server span for a JSON route, continuing any trace
+// context sent by the caller.
+func InstrumentHandler(route string, h http.Handler) http.Handler {
+	return otelhttp.NewHandler(h, route)
+}
+
+// NewInterceptor opens a server span per Connect RPC. Incoming trace context is
+// trusted so spans join the caller's trace instead of starting a new root.
+func NewInterceptor() (connect.Interceptor, error) {
+	interceptor, err := otelconnect.NewInterceptor(
+		otelconnect.WithTrustRemote(),
+		otelconnect.WithoutMetrics(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing interceptor: %w", err)
+	}
+	return interceptor, nil
+}
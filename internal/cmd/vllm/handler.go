@@ -1,16 +1,93 @@
 package vllm
 
 import (
+	"bytes"
+	"cmp"
 	"connect-go/internal/app/vllm"
+	auditCore "connect-go/internal/core/audit"
+	"connect-go/internal/core/tracing"
 	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
 	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultReadinessTimeout = 10 * time.Minute
+	maxReadinessTimeout     = 30 * time.Minute
 )
 
 type SwitchRequest struct {
 	Namespace   string `json:"namespace"`
 	RuntimeName string `json:"runtimeName"`
 	Model       string `json:"model"`
+	// Wait makes Start return only once the runtime serves requests, or fail
+	// after TimeoutSeconds (default 600, at most 1800).
+	Wait           bool `json:"wait,omitempty"`
+	TimeoutSeconds int  `json:"timeoutSeconds,omitempty"`
+	// DryRun renders the change with a server-side dry run and returns it
+	// without applying it.
+	DryRun bool `json:"dryRun,omitempty"`
+	// IdempotencyKey makes retries of the request return the first response
+	// instead of applying it again; the Idempotency-Key header does the same.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+}
+
+type CreateRequest struct {
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	RuntimeName string `json:"runtimeName"`
+	Model       string `json:"model"`
+	StorageURI  string `json:"storageUri,omitempty"`
+	// Replicas defaults to 1.
+	Replicas int `json:"replicas,omitempty"`
+	// Spec is merged over the generated spec.
+	Spec   map[string]interface{} `json:"spec,omitempty"`
+	DryRun bool                   `json:"dryRun,omitempty"`
+	// IdempotencyKey makes retries of the request return the first response
+	// instead of applying it again; the Idempotency-Key header does the same.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+}
+
+type UpdateRequest struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Replicas  *int   `json:"replicas,omitempty"`
+	// Spec is merged into the live spec like a JSON merge patch.
+	Spec   map[string]interface{} `json:"spec,omitempty"`
+	DryRun bool                   `json:"dryRun,omitempty"`
+	// IdempotencyKey makes retries of the request return the first response
+	// instead of applying it again; the Idempotency-Key header does the same.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+}
+
+type DeleteRequest struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	DryRun    bool   `json:"dryRun,omitempty"`
+}
+
+// DryRunResponse is the object the API server rendered and how its spec
+// differs from the live object.
+type DryRunResponse struct {
+	Message   string                 `json:"message"`
+	Namespace string                 `json:"namespace"`
+	Resource  string                 `json:"resource"`
+	Object    map[string]interface{} `json:"object"`
+	Diff      []auditCore.Change     `json:"diff"`
+}
+
+type ReadinessProgress struct {
+	Phase   string    `json:"phase"`
+	Message string    `json:"message,omitempty"`
+	Time    time.Time `json:"time"`
 }
 
 type GetRequest struct {
@@ -19,13 +96,23 @@ type GetRequest struct {
 
 type VLLMHandler struct {
 	Service vllm.VLLMService
+	// Idempotency deduplicates Start, Stop, Create and Update by idempotency
+	// key; nil runs every request.
+	Idempotency *vllm.IdempotencyGuard
 }
 
-func NewVLLMHandler(service vllm.VLLMService) *VLLMHandler {
-	return &VLLMHandler{Service: service}
+func NewVLLMHandler(service vllm.VLLMService, idempotency *vllm.IdempotencyGuard) *VLLMHandler {
+	return &VLLMHandler{Service: service, Idempotency: idempotency}
 }
 
 func (h *VLLMHandler) Start(w http.ResponseWriter, r *http.Request) {
+	h.idempotent(w, r, domain.ActionStart, h.start)
+}
+
+func (h *VLLMHandler) start(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.Start(r.Context(), "VLLMHandler.Start")
+	defer span.End()
+
 	var req SwitchRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request", http.StatusBadRequest)
@@ -35,15 +122,54 @@ func (h *VLLMHandler) Start(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "All fields are required", http.StatusBadRequest)
 		return
 	}
-	vllm, err := h.Service.Start(req.Namespace, req.RuntimeName, req.Model)
+	vllm, err := h.Service.Start(ctx, req.Namespace, req.RuntimeName, req.Model, req.DryRun)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		tracing.RecordError(span, err)
+		slog.ErrorContext(ctx, "vllm request failed", "path", r.URL.Path, "namespace", req.Namespace, "error", err)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
-	h.writeResponse(w, req, vllm.Status, "vLLM started")
+	if req.DryRun {
+		writeDryRun(w, req.Namespace, "vLLM would be started", vllm.Change)
+		return
+	}
+	if !req.Wait {
+		h.writeResponse(w, req, vllm.Status, "vLLM started", nil)
+		return
+	}
+
+	progress, err := h.Service.WaitReady(ctx, req.Namespace, vllm.Resource, readinessTimeout(req.TimeoutSeconds))
+	if err != nil {
+		tracing.RecordError(span, err)
+		slog.ErrorContext(ctx, "vllm did not become ready", "path", r.URL.Path, "namespace", req.Namespace, "error", err)
+		code := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrReadinessTimeout) {
+			code = http.StatusGatewayTimeout
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		h.writeResponse(w, req, vllm.Status, err.Error(), progress)
+		return
+	}
+	h.writeResponse(w, req, domain.StatusRunning, "vLLM ready", progress)
+}
+
+// readinessTimeout turns a requested timeout in seconds into a bounded duration.
+func readinessTimeout(seconds int) time.Duration {
+	if seconds <= 0 {
+		return defaultReadinessTimeout
+	}
+	return min(time.Duration(seconds)*time.Second, maxReadinessTimeout)
 }
 
 func (h *VLLMHandler) Stop(w http.ResponseWriter, r *http.Request) {
+	h.idempotent(w, r, domain.ActionStop, h.stop)
+}
+
+func (h *VLLMHandler) stop(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.Start(r.Context(), "VLLMHandler.Stop")
+	defer span.End()
+
 	var req SwitchRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request", http.StatusBadRequest)
@@ -53,15 +179,289 @@ func (h *VLLMHandler) Stop(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "All fields are required", http.StatusBadRequest)
 		return
 	}
-	vllm, err := h.Service.Stop(req.Namespace, req.RuntimeName, req.Model)
+	vllm, err := h.Service.Stop(ctx, req.Namespace, req.RuntimeName, req.Model, req.DryRun)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		tracing.RecordError(span, err)
+		slog.ErrorContext(ctx, "vllm request failed", "path", r.URL.Path, "namespace", req.Namespace, "error", err)
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	if req.DryRun {
+		writeDryRun(w, req.Namespace, "vLLM would be stopped", vllm.Change)
+		return
+	}
+	h.writeResponse(w, req, vllm.Status, "vLLM stopped", nil)
+}
+
+func (h *VLLMHandler) Create(w http.ResponseWriter, r *http.Request) {
+	h.idempotent(w, r, domain.ActionCreate, h.create)
+}
+
+func (h *VLLMHandler) create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.Start(r.Context(), "VLLMHandler.Create")
+	defer span.End()
+
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+	if req.Namespace == "" || req.Name == "" {
+		http.Error(w, "Namespace and name are required", http.StatusBadRequest)
+		return
+	}
+	if req.Replicas == 0 {
+		req.Replicas = 1
+	}
+	vllm, err := h.Service.Create(ctx, infra.CreateParams{
+		Namespace:   req.Namespace,
+		Name:        req.Name,
+		Model:       req.Model,
+		RuntimeName: req.RuntimeName,
+		StorageUri:  req.StorageURI,
+		Replicas:    req.Replicas,
+		Spec:        req.Spec,
+	}, req.DryRun)
+	if err != nil {
+		tracing.RecordError(span, err)
+		slog.ErrorContext(ctx, "vllm request failed", "path", r.URL.Path, "namespace", req.Namespace, "error", err)
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	if req.DryRun {
+		writeDryRun(w, req.Namespace, "vLLM would be created", vllm.Change)
+		return
+	}
+	h.writeResponse(w, SwitchRequest{Namespace: req.Namespace, RuntimeName: vllm.RuntimeName, Model: vllm.Model}, vllm.Status, "vLLM created", nil)
+}
+
+func (h *VLLMHandler) Update(w http.ResponseWriter, r *http.Request) {
+	h.idempotent(w, r, domain.ActionUpdate, h.update)
+}
+
+func (h *VLLMHandler) update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.Start(r.Context(), "VLLMHandler.Update")
+	defer span.End()
+
+	var req UpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+	if req.Namespace == "" || req.Name == "" {
+		http.Error(w, "Namespace and name are required", http.StatusBadRequest)
+		return
+	}
+	spec := req.Spec
+	if req.Replicas != nil {
+		if spec == nil {
+			spec = map[string]interface{}{}
+		}
+		spec["replicas"] = *req.Replicas
+	}
+	vllm, err := h.Service.Update(ctx, req.Namespace, req.Name, spec, req.DryRun)
+	if err != nil {
+		tracing.RecordError(span, err)
+		slog.ErrorContext(ctx, "vllm request failed", "path", r.URL.Path, "namespace", req.Namespace, "error", err)
+		http.Error(w, err.Error(), errorStatus(err))
 		return
 	}
-	h.writeResponse(w, req, vllm.Status, "vLLM stopped")
+	if req.DryRun {
+		writeDryRun(w, req.Namespace, "vLLM would be updated", vllm.Change)
+		return
+	}
+	h.writeResponse(w, SwitchRequest{Namespace: req.Namespace, RuntimeName: vllm.RuntimeName, Model: vllm.Model}, vllm.Status, "vLLM updated", nil)
+}
+
+// Delete deletes a VLLM resource. It returns once the resource is marked for
+// deletion; the runtime's Deployments, Services and router registration are
+// removed in the background before the resource disappears.
+func (h *VLLMHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.Start(r.Context(), "VLLMHandler.Delete")
+	defer span.End()
+
+	var req DeleteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+	if req.Namespace == "" || req.Name == "" {
+		http.Error(w, "Namespace and name are required", http.StatusBadRequest)
+		return
+	}
+	vllm, err := h.Service.Delete(ctx, req.Namespace, req.Name, req.DryRun)
+	if err != nil {
+		tracing.RecordError(span, err)
+		slog.ErrorContext(ctx, "vllm request failed", "path", r.URL.Path, "namespace", req.Namespace, "error", err)
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	if req.DryRun {
+		writeDryRun(w, req.Namespace, "vLLM would be deleted", vllm.Change)
+		return
+	}
+	h.writeResponse(w, SwitchRequest{Namespace: req.Namespace, RuntimeName: vllm.RuntimeName, Model: vllm.Model}, vllm.Status, "vLLM deleting", nil)
+}
+
+// idempotent serves r with next at most once per idempotency key, taken from
+// the request body or else the Idempotency-Key header, and replays the
+// response to retries. Only successful responses are replayed; dry runs and
+// bodies next rejects anyway always run.
+func (h *VLLMHandler) idempotent(w http.ResponseWriter, r *http.Request, action string, next http.HandlerFunc) {
+	if h.Idempotency == nil {
+		next(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	var req struct {
+		Namespace      string `json:"namespace"`
+		Name           string `json:"name"`
+		RuntimeName    string `json:"runtimeName"`
+		DryRun         bool   `json:"dryRun"`
+		IdempotencyKey string `json:"idempotencyKey"`
+	}
+	var fields map[string]interface{}
+	if json.Unmarshal(body, &req) != nil || json.Unmarshal(body, &fields) != nil {
+		next(w, r)
+		return
+	}
+	key := req.IdempotencyKey
+	if key == "" {
+		key = r.Header.Get(domain.IdempotencyKeyHeader)
+	}
+	if key == "" || req.DryRun {
+		next(w, r)
+		return
+	}
+	// Create and Update name the resource; Start and Stop its runtime.
+	resource := req.Name
+	if resource == "" {
+		resource = req.RuntimeName
+	}
+	delete(fields, "idempotencyKey")
+	canonical, err := json.Marshal(fields)
+	if err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+
+	rec := &responseRecorder{header: http.Header{}}
+	recorded, replayed, err := h.Idempotency.Do(r.Context(), key, vllm.IdempotencyScope{
+		Namespace:   req.Namespace,
+		Resource:    resource,
+		Action:      action,
+		Fingerprint: domain.Fingerprint([]byte(action), canonical),
+	}, func(ctx context.Context) ([]byte, error) {
+		next(rec, r.WithContext(ctx))
+		if rec.status >= http.StatusBadRequest {
+			return nil, errNotReplayed
+		}
+		return json.Marshal(recordedResponse{Status: rec.status, ContentType: rec.header.Get("Content-Type"), Body: rec.body.Bytes()})
+	})
+	switch {
+	case errors.Is(err, errNotReplayed):
+		rec.flush(w)
+	case err != nil:
+		slog.ErrorContext(r.Context(), "vllm request failed", "path", r.URL.Path, "namespace", req.Namespace, "error", err)
+		http.Error(w, err.Error(), errorStatus(err))
+	case replayed:
+		var res recordedResponse
+		if err := json.Unmarshal(recorded, &res); err != nil {
+			http.Error(w, "Failed to decode recorded response", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", res.ContentType)
+		w.Header().Set(domain.IdempotentReplayedHeader, "true")
+		w.WriteHeader(res.Status)
+		_, _ = w.Write(res.Body)
+	default:
+		rec.flush(w)
+	}
+}
+
+// errNotReplayed marks a failed response, which reaches the caller but is not
+// recorded for retries.
+var errNotReplayed = errors.New("response not recorded")
+
+// recordedResponse is how the JSON handlers record a response for replay.
+type recordedResponse struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        []byte `json:"body"`
+}
+
+// responseRecorder holds a response back until it has been recorded.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.status == 0 {
+		r.status = code
+	}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) flush(w http.ResponseWriter) {
+	for k, v := range r.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(cmp.Or(r.status, http.StatusOK))
+	_, _ = w.Write(r.body.Bytes())
+}
+
+// errorStatus maps service errors to HTTP status codes.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrInvalidStorageURI), errors.Is(err, domain.ErrInvalidSpec):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrArtifactNotFound):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, domain.ErrRuntimeNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrRuntimeExists), errors.Is(err, domain.ErrRequestInProgress):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrInvalidIdempotencyKey):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrIdempotencyKeyReused):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeDryRun(w http.ResponseWriter, namespace, message string, change *domain.SpecChange) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(DryRunResponse{
+		Message:   message,
+		Namespace: namespace,
+		Resource:  change.Resource,
+		Object:    change.Object,
+		Diff:      auditCore.Diff(change.Before, change.After),
+	}); err != nil {
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+	}
 }
 
 func (h *VLLMHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.Start(r.Context(), "VLLMHandler.Get")
+	defer span.End()
+
 	var req GetRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request", http.StatusBadRequest)
@@ -71,18 +471,22 @@ func (h *VLLMHandler) Get(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Namespace is required", http.StatusBadRequest)
 		return
 	}
-	vllms, err := h.Service.Get(req.Namespace)
+	vllms, err := h.Service.Get(ctx, req.Namespace)
 	if err != nil {
+		tracing.RecordError(span, err)
+		slog.ErrorContext(ctx, "vllm request failed", "path", r.URL.Path, "namespace", req.Namespace, "error", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 	statuses := make([]string, len(vllms))
 	models := make([]string, len(vllms))
 	runtimeNames := make([]string, len(vllms))
+	conditions := make([][]metav1.Condition, len(vllms))
 	for i, v := range vllms {
 		statuses[i] = string(v.Phase)
 		models[i] = v.Model
 		runtimeNames[i] = v.Name
+		conditions[i] = v.Conditions
 	}
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(struct {
@@ -91,31 +495,40 @@ func (h *VLLMHandler) Get(w http.ResponseWriter, r *http.Request) {
 		RuntimeNames []string `json:"runtimeNames"`
 		Models       []string `json:"model"`
 		Statuses     []string `json:"statuses"`
+		// Conditions holds status.conditions of each runtime, in the same order.
+		Conditions [][]metav1.Condition `json:"conditions"`
 	}{
 		Message:      "vLLM updated",
 		Namespace:    req.Namespace,
 		RuntimeNames: runtimeNames,
 		Models:       models,
 		Statuses:     statuses,
+		Conditions:   conditions,
 	}); err != nil {
 		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
 	}
 }
 
-func (h *VLLMHandler) writeResponse(w http.ResponseWriter, req SwitchRequest, status domain.Status, message string) {
+func (h *VLLMHandler) writeResponse(w http.ResponseWriter, req SwitchRequest, status domain.Status, message string, progress []domain.ReadinessProgress) {
+	var steps []ReadinessProgress
+	for _, p := range progress {
+		steps = append(steps, ReadinessProgress{Phase: string(p.Phase), Message: p.Message, Time: p.Time})
+	}
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(struct {
-		Message     string `json:"message"`
-		Namespace   string `json:"namespace"`
-		RuntimeName string `json:"runtimeName"`
-		Model       string `json:"model"`
-		Status      string `json:"status"`
+		Message     string              `json:"message"`
+		Namespace   string              `json:"namespace"`
+		RuntimeName string              `json:"runtimeName"`
+		Model       string              `json:"model"`
+		Status      string              `json:"status"`
+		Progress    []ReadinessProgress `json:"progress,omitempty"`
 	}{
 		Message:     message,
 		Namespace:   req.Namespace,
 		RuntimeName: req.RuntimeName,
 		Model:       req.Model,
 		Status:      string(status),
+		Progress:    steps,
 	}); err != nil {
 		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
 	}
@@ -0,0 +1,160 @@
+package vllm
+
+import (
+	"connect-go/internal/app/vllm"
+	auditCore "connect-go/internal/core/audit"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// startingService starts every runtime and reports the outcome of waitErr
+// after the given readiness progress.
+type startingService struct {
+	vllm.VLLMService
+	progress []domain.ReadinessProgress
+	waitErr  error
+	timeout  time.Duration
+}
+
+func (s *startingService) Start(_ context.Context, namespace, runtimeName, model string, _ bool) (*domain.VLLMUseCase, error) {
+	return &domain.VLLMUseCase{Namespace: namespace, RuntimeName: runtimeName, Model: model, Resource: runtimeName + "-abc12", Status: domain.StatusStopped}, nil
+}
+
+func (s *startingService) WaitReady(_ context.Context, _, _ string, timeout time.Duration) ([]domain.ReadinessProgress, error) {
+	s.timeout = timeout
+	return s.progress, s.waitErr
+}
+
+func TestStartWaitsForReadiness(t *testing.T) {
+	progress := []domain.ReadinessProgress{
+		{Phase: domain.ReadinessScheduling, Time: time.Now()},
+		{Phase: domain.ReadinessLoadingWeights, Message: "loading", Time: time.Now()},
+	}
+	tests := []struct {
+		name         string
+		waitErr      error
+		wantCode     int
+		wantProgress []string
+	}{
+		{"ready", nil, http.StatusOK, []string{"Scheduling", "LoadingWeights"}},
+		{"timeout", fmt.Errorf("%w after 1s", domain.ErrReadinessTimeout), http.StatusGatewayTimeout, []string{"Scheduling", "LoadingWeights"}},
+		{"failed", domain.ErrRuntimeFailed, http.StatusInternalServerError, []string{"Scheduling", "LoadingWeights"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &startingService{progress: progress, waitErr: tt.waitErr}
+			h := NewVLLMHandler(service, nil)
+			body := `{"namespace":"default","runtimeName":"llama","model":"meta-llama/Llama-3.1-8B","wait":true,"timeoutSeconds":1}`
+			rec := httptest.NewRecorder()
+			h.Start(rec, httptest.NewRequest(http.MethodPost, "/vllm/start", strings.NewReader(body)))
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantCode, rec.Body)
+			}
+			if service.timeout != time.Second {
+				t.Errorf("WaitReady timeout = %s, want 1s", service.timeout)
+			}
+			var res struct {
+				Message  string              `json:"message"`
+				Progress []ReadinessProgress `json:"progress"`
+			}
+			if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			var phases []string
+			for _, p := range res.Progress {
+				phases = append(phases, p.Phase)
+			}
+			if strings.Join(phases, ",") != strings.Join(tt.wantProgress, ",") {
+				t.Errorf("progress = %v, want %v", phases, tt.wantProgress)
+			}
+			if tt.waitErr != nil && res.Message != tt.waitErr.Error() {
+				t.Errorf("message = %q, want %q", res.Message, tt.waitErr)
+			}
+		})
+	}
+}
+
+func TestReadinessTimeout(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    time.Duration
+	}{
+		{0, defaultReadinessTimeout},
+		{-5, defaultReadinessTimeout},
+		{60, time.Minute},
+		{1800, 30 * time.Minute},
+		{7200, maxReadinessTimeout},
+	}
+	for _, tt := range tests {
+		if got := readinessTimeout(tt.seconds); got != tt.want {
+			t.Errorf("readinessTimeout(%d) = %s, want %s", tt.seconds, got, tt.want)
+		}
+	}
+}
+
+// dryRunService stops runtimes, rendering the change on a dry run, and counts
+// the calls.
+type dryRunService struct {
+	vllm.VLLMService
+	calls int
+}
+
+// stopChange is the change stopping a running runtime makes.
+func stopChange(runtimeName string) *domain.SpecChange {
+	return &domain.SpecChange{
+		Resource: runtimeName,
+		Before:   map[string]interface{}{"action": "start", "replicas": int64(2)},
+		After:    map[string]interface{}{"action": "stop", "replicas": int64(2)},
+		Object:   map[string]interface{}{"kind": "VLLM", "metadata": map[string]interface{}{"name": runtimeName}},
+	}
+}
+
+func (s *dryRunService) Stop(_ context.Context, namespace, runtimeName, model string, dryRun bool) (*domain.VLLMUseCase, error) {
+	s.calls++
+	change := stopChange(runtimeName)
+	change.DryRun = dryRun
+	return &domain.VLLMUseCase{Namespace: namespace, RuntimeName: runtimeName, Model: model, Resource: runtimeName, Status: domain.StatusStopped, Change: change}, nil
+}
+
+func TestStopDryRun(t *testing.T) {
+	service := &dryRunService{}
+	// Dry runs change nothing, so they are never deduplicated.
+	h := NewVLLMHandler(service, vllm.NewIdempotencyGuard(infra.NewMemoryIdempotencyStore()))
+	body := `{"namespace":"default","runtimeName":"llama","model":"llama","dryRun":true,"idempotencyKey":"key-1"}`
+	change := stopChange("llama")
+	want := auditCore.Diff(change.Before, change.After)
+
+	for range 2 {
+		rec := httptest.NewRecorder()
+		h.Stop(rec, httptest.NewRequest(http.MethodPost, "/vllm/stop", strings.NewReader(body)))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+		}
+		if rec.Header().Get(domain.IdempotentReplayedHeader) != "" {
+			t.Error("dry run was replayed")
+		}
+		var res DryRunResponse
+		if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res.Diff, want) {
+			t.Errorf("diff = %+v, want %+v", res.Diff, want)
+		}
+		if res.Resource != "llama" || res.Object["kind"] != "VLLM" {
+			t.Errorf("response = %+v, want the rendered llama object", res)
+		}
+	}
+	if service.calls != 2 {
+		t.Errorf("Stop ran %d times, want every dry run to run", service.calls)
+	}
+}
@@ -0,0 +1,882 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllmv1"
+	"connect-go/api/vllmv1/vllmv1connect"
+	"connect-go/internal/app/vllm"
+	auditCore "connect-go/internal/core/audit"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultAuditLimit = 100
+
+const (
+	defaultOperationWait = time.Minute
+	maxOperationWait     = 10 * time.Minute
+)
+
+// LLMApiServer serves vllm.v1.LLMApiService on top of the same VLLMService as the
+// JSON handlers. A runtime's sample template is named after its runtime name.
+type LLMApiServer struct {
+	vllmv1connect.UnimplementedLLMApiServiceHandler
+	Service    vllm.VLLMService
+	Audit      auditCore.Store
+	Rollouts   *vllm.RolloutController
+	Adapters   *vllm.AdapterManager
+	Warmups    *vllm.WarmupController
+	Batch      *vllm.BatchRunner
+	Operations *vllm.OperationManager
+	// Idempotency deduplicates Start, Stop, Create, Update and the batch
+	// RPCs by idempotency key; nil runs every request.
+	Idempotency *vllm.IdempotencyGuard
+}
+
+func NewLLMApiServer(service vllm.VLLMService, audit auditCore.Store, rollouts *vllm.RolloutController, adapters *vllm.AdapterManager, warmups *vllm.WarmupController, batch *vllm.BatchRunner, operations *vllm.OperationManager, idempotency *vllm.IdempotencyGuard) *LLMApiServer {
+	return &LLMApiServer{Service: service, Audit: audit, Rollouts: rollouts, Adapters: adapters, Warmups: warmups, Batch: batch, Operations: operations, Idempotency: idempotency}
+}
+
+func (s *LLMApiServer) StartLLM(ctx context.Context, req *connect.Request[vllmv1.LLMRequest]) (*connect.Response[vllmv1.LLMResponse], error) {
+	if req.Msg.Namespace == "" || req.Msg.RuntimeName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and runtime_name are required"))
+	}
+	return idempotent(ctx, s.Idempotency, req.Header(), req.Msg, req.Msg.IdempotencyKey, req.Msg.Namespace, req.Msg.RuntimeName, domain.ActionStart, req.Msg.DryRun, func(ctx context.Context) (*connect.Response[vllmv1.LLMResponse], error) {
+		return s.startLLM(ctx, req.Msg)
+	})
+}
+
+func (s *LLMApiServer) startLLM(ctx context.Context, msg *vllmv1.LLMRequest) (*connect.Response[vllmv1.LLMResponse], error) {
+	v, err := s.Service.Start(ctx, msg.Namespace, msg.RuntimeName, msg.RuntimeName, msg.DryRun)
+	if err != nil {
+		return nil, mutationError(err)
+	}
+	if msg.DryRun {
+		return newDryRunResponse("vLLM would be started", v.Change)
+	}
+	timeout := readinessTimeout(int(msg.TimeoutSeconds))
+	operationID := s.beginOperation(ctx, msg.Namespace, v.Resource, domain.ActionStart, true, timeout)
+	if !msg.Wait {
+		res, err := newLLMResponse("vLLM started", v.Status)
+		if err != nil {
+			return nil, err
+		}
+		res.Msg.OperationId = operationID
+		return res, nil
+	}
+
+	progress, err := s.Service.WaitReady(ctx, msg.Namespace, v.Resource, timeout)
+	if err != nil {
+		code := connect.CodeInternal
+		switch {
+		case errors.Is(err, domain.ErrReadinessTimeout):
+			code = connect.CodeDeadlineExceeded
+		case errors.Is(err, domain.ErrRuntimeFailed):
+			code = connect.CodeFailedPrecondition
+		}
+		cerr := connect.NewError(code, err)
+		// Attach the phases reached so far so callers can tell where it stalled.
+		if detail, derr := connect.NewErrorDetail(&vllmv1.LLMResponse{Message: err.Error(), Progress: toReadinessProgress(progress), OperationId: operationID}); derr == nil {
+			cerr.AddDetail(detail)
+		}
+		return nil, cerr
+	}
+	res, err := newLLMResponse("vLLM ready", domain.StatusRunning)
+	if err != nil {
+		return nil, err
+	}
+	res.Msg.Progress, res.Msg.OperationId = toReadinessProgress(progress), operationID
+	return res, nil
+}
+
+func (s *LLMApiServer) StopLLM(ctx context.Context, req *connect.Request[vllmv1.LLMRequest]) (*connect.Response[vllmv1.LLMResponse], error) {
+	if req.Msg.Namespace == "" || req.Msg.RuntimeName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and runtime_name are required"))
+	}
+	return idempotent(ctx, s.Idempotency, req.Header(), req.Msg, req.Msg.IdempotencyKey, req.Msg.Namespace, req.Msg.RuntimeName, domain.ActionStop, req.Msg.DryRun, func(ctx context.Context) (*connect.Response[vllmv1.LLMResponse], error) {
+		return s.stopLLM(ctx, req.Msg)
+	})
+}
+
+func (s *LLMApiServer) stopLLM(ctx context.Context, msg *vllmv1.LLMRequest) (*connect.Response[vllmv1.LLMResponse], error) {
+	v, err := s.Service.Stop(ctx, msg.Namespace, msg.RuntimeName, msg.RuntimeName, msg.DryRun)
+	if err != nil {
+		return nil, mutationError(err)
+	}
+	if msg.DryRun {
+		return newDryRunResponse("vLLM would be stopped", v.Change)
+	}
+	return newLLMResponse("vLLM stopped", v.Status)
+}
+
+func (s *LLMApiServer) CreateLLM(ctx context.Context, req *connect.Request[vllmv1.CreateLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error) {
+	if req.Msg.Namespace == "" || req.Msg.RuntimeName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and runtime_name are required"))
+	}
+	return idempotent(ctx, s.Idempotency, req.Header(), req.Msg, req.Msg.IdempotencyKey, req.Msg.Namespace, req.Msg.RuntimeName, domain.ActionCreate, req.Msg.DryRun, func(ctx context.Context) (*connect.Response[vllmv1.LLMResponse], error) {
+		return s.createLLM(ctx, req.Msg)
+	})
+}
+
+func (s *LLMApiServer) createLLM(ctx context.Context, msg *vllmv1.CreateLLMRequest) (*connect.Response[vllmv1.LLMResponse], error) {
+	spec, err := fromAnyMap(msg.Spec)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	p := infra.CreateParams{
+		Namespace:   msg.Namespace,
+		Name:        msg.RuntimeName,
+		Model:       msg.Model,
+		RuntimeName: msg.RuntimeName,
+		Replicas:    1,
+		Spec:        spec,
+	}
+	if msg.Replicas != nil {
+		p.Replicas = int(*msg.Replicas)
+	}
+	v, err := s.Service.Create(ctx, p, msg.DryRun)
+	if err != nil {
+		return nil, mutationError(err)
+	}
+	if msg.DryRun {
+		return newDryRunResponse("vLLM would be created", v.Change)
+	}
+	res, err := newLLMResponse("vLLM created", v.Status)
+	if err != nil {
+		return nil, err
+	}
+	res.Msg.OperationId = s.beginOperation(ctx, msg.Namespace, v.Resource, domain.ActionCreate, runs(v.Change), defaultReadinessTimeout)
+	return res, nil
+}
+
+func (s *LLMApiServer) UpdateLLM(ctx context.Context, req *connect.Request[vllmv1.UpdateLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error) {
+	if req.Msg.Namespace == "" || req.Msg.RuntimeName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and runtime_name are required"))
+	}
+	return idempotent(ctx, s.Idempotency, req.Header(), req.Msg, req.Msg.IdempotencyKey, req.Msg.Namespace, req.Msg.RuntimeName, domain.ActionUpdate, req.Msg.DryRun, func(ctx context.Context) (*connect.Response[vllmv1.LLMResponse], error) {
+		return s.updateLLM(ctx, req.Msg)
+	})
+}
+
+func (s *LLMApiServer) updateLLM(ctx context.Context, msg *vllmv1.UpdateLLMRequest) (*connect.Response[vllmv1.LLMResponse], error) {
+	spec, err := fromAnyMap(msg.Spec)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if msg.Replicas != nil {
+		if spec == nil {
+			spec = map[string]interface{}{}
+		}
+		spec["replicas"] = *msg.Replicas
+	}
+	v, err := s.Service.Update(ctx, msg.Namespace, msg.RuntimeName, spec, msg.DryRun)
+	if err != nil {
+		return nil, mutationError(err)
+	}
+	if msg.DryRun {
+		return newDryRunResponse("vLLM would be updated", v.Change)
+	}
+	res, err := newLLMResponse("vLLM updated", v.Status)
+	if err != nil {
+		return nil, err
+	}
+	res.Msg.OperationId = s.beginOperation(ctx, msg.Namespace, v.Resource, domain.ActionUpdate, runs(v.Change), defaultReadinessTimeout)
+	return res, nil
+}
+
+func (s *LLMApiServer) DeleteLLM(ctx context.Context, req *connect.Request[vllmv1.DeleteLLMRequest]) (*connect.Response[vllmv1.LLMResponse], error) {
+	if req.Msg.Namespace == "" || req.Msg.RuntimeName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and runtime_name are required"))
+	}
+	v, err := s.Service.Delete(ctx, req.Msg.Namespace, req.Msg.RuntimeName, req.Msg.DryRun)
+	if err != nil {
+		return nil, mutationError(err)
+	}
+	if req.Msg.DryRun {
+		return newDryRunResponse("vLLM would be deleted", v.Change)
+	}
+	return newLLMResponse("vLLM deleting", v.Status)
+}
+
+func (s *LLMApiServer) BatchStartLLMs(ctx context.Context, req *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+	return idempotent(ctx, s.Idempotency, req.Header(), req.Msg, req.Msg.IdempotencyKey, req.Msg.Namespace, "", domain.ActionStart, req.Msg.DryRun, func(ctx context.Context) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+		result, err := s.Batch.Start(ctx, batchRequest(req.Msg))
+		if err != nil {
+			return nil, batchError(err)
+		}
+		s.beginBatchOperations(ctx, req.Msg.Namespace, req.Msg.DryRun, result)
+		return toBatchResponse(result), nil
+	})
+}
+
+func (s *LLMApiServer) BatchStopLLMs(ctx context.Context, req *connect.Request[vllmv1.BatchLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+	return idempotent(ctx, s.Idempotency, req.Header(), req.Msg, req.Msg.IdempotencyKey, req.Msg.Namespace, "", domain.ActionStop, req.Msg.DryRun, func(ctx context.Context) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+		result, err := s.Batch.Stop(ctx, batchRequest(req.Msg))
+		if err != nil {
+			return nil, batchError(err)
+		}
+		return toBatchResponse(result), nil
+	})
+}
+
+func (s *LLMApiServer) BatchUpdateLLMs(ctx context.Context, req *connect.Request[vllmv1.BatchUpdateLLMsRequest]) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+	spec, err := fromAnyMap(req.Msg.Spec)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Replicas != nil {
+		if spec == nil {
+			spec = map[string]interface{}{}
+		}
+		spec["replicas"] = *req.Msg.Replicas
+	}
+	return idempotent(ctx, s.Idempotency, req.Header(), req.Msg, req.Msg.IdempotencyKey, req.Msg.Namespace, "", domain.ActionUpdate, req.Msg.DryRun, func(ctx context.Context) (*connect.Response[vllmv1.BatchLLMsResponse], error) {
+		result, err := s.Batch.Update(ctx, domain.BatchRequest{
+			Namespace:   req.Msg.Namespace,
+			Names:       req.Msg.RuntimeNames,
+			Selector:    req.Msg.LabelSelector,
+			Concurrency: int(req.Msg.Concurrency),
+			Atomic:      req.Msg.Atomic,
+			DryRun:      req.Msg.DryRun,
+		}, spec)
+		if err != nil {
+			return nil, batchError(err)
+		}
+		s.beginBatchOperations(ctx, req.Msg.Namespace, req.Msg.DryRun, result)
+		return toBatchResponse(result), nil
+	})
+}
+
+// beginBatchOperations records an operation for each runtime a batch started
+// or left running with a changed spec, as StartLLM and UpdateLLM do.
+func (s *LLMApiServer) beginBatchOperations(ctx context.Context, namespace string, dryRun bool, result *domain.BatchResult) {
+	if dryRun || result.Action == domain.ActionStop {
+		return
+	}
+	for i := range result.Items {
+		item := &result.Items[i]
+		if item.Outcome != domain.BatchSucceeded || item.Change == nil {
+			continue
+		}
+		item.OperationID = s.beginOperation(ctx, namespace, item.Name, result.Action, runs(item.Change), defaultReadinessTimeout)
+	}
+}
+
+func batchRequest(msg *vllmv1.BatchLLMsRequest) domain.BatchRequest {
+	return domain.BatchRequest{
+		Namespace:   msg.Namespace,
+		Names:       msg.RuntimeNames,
+		Selector:    msg.LabelSelector,
+		Concurrency: int(msg.Concurrency),
+		Atomic:      msg.Atomic,
+		DryRun:      msg.DryRun,
+	}
+}
+
+// batchError maps errors that fail a whole batch to codes; errors of single
+// runtimes are reported per item.
+func batchError(err error) error {
+	if errors.Is(err, domain.ErrInvalidBatch) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return mutationError(err)
+}
+
+func toBatchResponse(r *domain.BatchResult) *connect.Response[vllmv1.BatchLLMsResponse] {
+	res := &vllmv1.BatchLLMsResponse{Reverted: r.Reverted}
+	for _, item := range r.Items {
+		out := &vllmv1.BatchItemResult{RuntimeName: item.Name, Outcome: string(item.Outcome), OperationId: item.OperationID}
+		if item.Err != nil {
+			out.Code = connect.CodeOf(mutationError(item.Err)).String()
+			out.Error = item.Err.Error()
+		}
+		if item.RevertErr != nil {
+			out.RevertError = item.RevertErr.Error()
+		}
+		if item.Change != nil {
+			for _, c := range auditCore.Diff(item.Change.Before, item.Change.After) {
+				out.Changes = append(out.Changes, &vllmv1.SpecChange{Path: c.Path, Before: c.Before, After: c.After})
+			}
+		}
+		res.Results = append(res.Results, out)
+	}
+	return connect.NewResponse(res)
+}
+
+// idempotent runs run at most once per idempotency key, taken from the
+// request field or else the Idempotency-Key header, and replays its response
+// to retries. Dry runs change nothing and always run. resource is empty for
+// a batch.
+func idempotent[T any, PT interface {
+	*T
+	proto.Message
+}](ctx context.Context, guard *vllm.IdempotencyGuard, header http.Header, msg proto.Message, key, namespace, resource, action string, dryRun bool, run func(ctx context.Context) (*connect.Response[T], error)) (*connect.Response[T], error) {
+	if key == "" {
+		key = header.Get(domain.IdempotencyKeyHeader)
+	}
+	if key == "" || dryRun || guard == nil {
+		return run(ctx)
+	}
+	fingerprint, err := requestFingerprint(action, msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var res *connect.Response[T]
+	body, replayed, err := guard.Do(ctx, key, vllm.IdempotencyScope{
+		Namespace:   namespace,
+		Resource:    resource,
+		Action:      action,
+		Fingerprint: fingerprint,
+	}, func(ctx context.Context) ([]byte, error) {
+		var err error
+		if res, err = run(ctx); err != nil {
+			return nil, err
+		}
+		return proto.Marshal(PT(res.Msg))
+	})
+	if err != nil {
+		if cerr := new(connect.Error); errors.As(err, &cerr) {
+			return nil, err
+		}
+		return nil, mutationError(err)
+	}
+	if !replayed {
+		return res, nil
+	}
+	out := new(T)
+	if err := proto.Unmarshal(body, PT(out)); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode recorded response: %w", err))
+	}
+	replay := connect.NewResponse(out)
+	replay.Header().Set(domain.IdempotentReplayedHeader, "true")
+	return replay, nil
+}
+
+// requestFingerprint hashes a request without its idempotency key.
+func requestFingerprint(action string, msg proto.Message) (string, error) {
+	m := proto.Clone(msg).ProtoReflect()
+	m.Clear(m.Descriptor().Fields().ByName("idempotency_key"))
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m.Interface())
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+	return domain.Fingerprint([]byte(action), b), nil
+}
+
+// beginOperation records an operation following a change that was just
+// applied and returns its ID. The change stands even if the operation cannot
+// be recorded, so that only costs the caller the ID.
+func (s *LLMApiServer) beginOperation(ctx context.Context, namespace, resource, action string, wait bool, timeout time.Duration) string {
+	op, err := s.Operations.Begin(ctx, namespace, resource, action, wait, timeout)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record operation", "namespace", namespace, "resource", resource, "action", action, "error", err)
+		return ""
+	}
+	return op.ID
+}
+
+// runs reports whether the runtime should be running after change.
+func runs(change *domain.SpecChange) bool {
+	action, _ := change.After["action"].(string)
+	return action != domain.ActionStop
+}
+
+func (s *LLMApiServer) GetOperation(ctx context.Context, req *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error) {
+	if req.Msg.Namespace == "" || req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and id are required"))
+	}
+	op, err := s.Operations.Get(ctx, req.Msg.Namespace, req.Msg.Id)
+	if err != nil {
+		return nil, operationError(err)
+	}
+	return connect.NewResponse(toOperation(op)), nil
+}
+
+func (s *LLMApiServer) ListOperations(ctx context.Context, req *connect.Request[vllmv1.ListOperationsRequest]) (*connect.Response[vllmv1.ListOperationsResponse], error) {
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+	ops, err := s.Operations.List(ctx, req.Msg.Namespace, req.Msg.RuntimeName, int(req.Msg.Limit))
+	if err != nil {
+		return nil, operationError(err)
+	}
+	res := &vllmv1.ListOperationsResponse{}
+	for i := range ops {
+		res.Operations = append(res.Operations, toOperation(&ops[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *LLMApiServer) CancelOperation(ctx context.Context, req *connect.Request[vllmv1.OperationRequest]) (*connect.Response[vllmv1.Operation], error) {
+	if req.Msg.Namespace == "" || req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and id are required"))
+	}
+	op, err := s.Operations.Cancel(ctx, req.Msg.Namespace, req.Msg.Id)
+	if err != nil {
+		return nil, operationError(err)
+	}
+	return connect.NewResponse(toOperation(op)), nil
+}
+
+func (s *LLMApiServer) WaitOperation(ctx context.Context, req *connect.Request[vllmv1.WaitOperationRequest]) (*connect.Response[vllmv1.Operation], error) {
+	if req.Msg.Namespace == "" || req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and id are required"))
+	}
+	timeout := defaultOperationWait
+	if req.Msg.TimeoutSeconds > 0 {
+		timeout = min(time.Duration(req.Msg.TimeoutSeconds)*time.Second, maxOperationWait)
+	}
+	op, err := s.Operations.Wait(ctx, req.Msg.Namespace, req.Msg.Id, timeout)
+	if err != nil {
+		return nil, operationError(err)
+	}
+	return connect.NewResponse(toOperation(op)), nil
+}
+
+func operationError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrOperationNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, domain.ErrOperationDone):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func toOperation(op *domain.Operation) *vllmv1.Operation {
+	out := &vllmv1.Operation{
+		Id:              op.ID,
+		Namespace:       op.Namespace,
+		RuntimeName:     op.Resource,
+		Action:          op.Action,
+		State:           string(op.State),
+		Done:            op.Done(),
+		Message:         op.Message,
+		Progress:        toReadinessProgress(op.Progress),
+		CancelRequested: op.CancelRequested,
+		CreatedAt:       timestamppb.New(op.CreatedAt),
+		UpdatedAt:       timestamppb.New(op.UpdatedAt),
+		Deadline:        timestamppb.New(op.Deadline),
+	}
+	if op.Error != nil {
+		out.ErrorCode, out.Error = op.Error.Code, op.Error.Message
+	}
+	if !op.DoneAt.IsZero() {
+		out.DoneAt = timestamppb.New(op.DoneAt)
+	}
+	return out
+}
+
+// mutationError maps errors from Start, Stop, Create, Update and Delete to codes.
+func mutationError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidStorageURI), errors.Is(err, domain.ErrInvalidSpec):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domain.ErrArtifactNotFound):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, domain.ErrRuntimeNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, domain.ErrRuntimeExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, domain.ErrInvalidIdempotencyKey), errors.Is(err, domain.ErrIdempotencyKeyReused):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domain.ErrRequestInProgress):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func (s *LLMApiServer) ListLLMs(ctx context.Context, req *connect.Request[vllmv1.ListLLMsRequest]) (*connect.Response[vllmv1.ListLLMsResponse], error) {
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+	vllms, err := s.Service.Get(ctx, req.Msg.Namespace)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &vllmv1.ListLLMsResponse{}
+	for _, v := range vllms {
+		fields := map[string]interface{}{"phase": v.Phase}
+		if len(v.Conditions) > 0 {
+			fields["conditions"] = conditionValues(v.Conditions)
+		}
+		if t := v.NextTransition; t != nil {
+			fields["nextAction"] = t.Action
+			fields["nextTransitionTime"] = t.Time.Format(time.RFC3339)
+		}
+		status, err := toAnyMap(fields)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		res.Llms = append(res.Llms, &vllmv1.LLMInfo{
+			Name:   v.Name,
+			Model:  v.Model,
+			Status: status,
+			Stats:  toEngineStats(v.Stats),
+		})
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *LLMApiServer) ListAuditEvents(ctx context.Context, req *connect.Request[vllmv1.ListAuditEventsRequest]) (*connect.Response[vllmv1.ListAuditEventsResponse], error) {
+	// Callers are authorized for one namespace; an empty one would match the
+	// events of every namespace.
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+	q := auditCore.Query{
+		Namespace:   req.Msg.Namespace,
+		RuntimeName: req.Msg.RuntimeName,
+		Action:      req.Msg.Action,
+		Caller:      req.Msg.Caller,
+		Limit:       int(req.Msg.Limit),
+	}
+	if req.Msg.Since != nil {
+		q.Since = req.Msg.Since.AsTime()
+	}
+	if q.Limit <= 0 {
+		q.Limit = defaultAuditLimit
+	}
+	events, err := s.Audit.List(ctx, q)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &vllmv1.ListAuditEventsResponse{}
+	for _, ev := range events {
+		out := &vllmv1.AuditEvent{
+			Id:          ev.ID,
+			Time:        timestamppb.New(ev.Time),
+			Caller:      ev.Caller,
+			Action:      ev.Action,
+			Namespace:   ev.Namespace,
+			RuntimeName: ev.RuntimeName,
+			Resource:    ev.Resource,
+			Template:    ev.Template,
+			Outcome:     ev.Outcome,
+			Error:       ev.Error,
+			LatencyMs:   ev.Latency.Milliseconds(),
+		}
+		for _, c := range ev.Changes {
+			out.Changes = append(out.Changes, &vllmv1.SpecChange{Path: c.Path, Before: c.Before, After: c.After})
+		}
+		res.Events = append(res.Events, out)
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *LLMApiServer) GetLLMStats(ctx context.Context, req *connect.Request[vllmv1.GetLLMStatsRequest]) (*connect.Response[vllmv1.GetLLMStatsResponse], error) {
+	if req.Msg.Namespace == "" || req.Msg.RuntimeName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and runtime_name are required"))
+	}
+	r, err := s.Service.GetStats(ctx, req.Msg.Namespace, req.Msg.RuntimeName)
+	if err != nil {
+		if errors.Is(err, vllm.ErrNoStats) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vllmv1.GetLLMStatsResponse{
+		Name:  r.Name,
+		Model: r.Model,
+		Stats: toEngineStats(r.Stats),
+	}), nil
+}
+
+func (s *LLMApiServer) StartRollout(ctx context.Context, req *connect.Request[vllmv1.StartRolloutRequest]) (*connect.Response[vllmv1.Rollout], error) {
+	m := req.Msg
+	if m.Namespace == "" || m.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and name are required"))
+	}
+	r, err := s.Rollouts.Start(ctx, vllm.RolloutParams{
+		Namespace: m.Namespace,
+		Stable:    m.Name,
+		Model:     m.Model,
+		Image:     m.Image,
+		Spec: domain.RolloutSpec{
+			Strategy:       domain.RolloutStrategy(m.Strategy),
+			Route:          m.Route,
+			Steps:          m.Steps,
+			StepDuration:   time.Duration(m.StepSeconds) * time.Second,
+			MaxErrorRate:   m.MaxErrorRate,
+			MaxTTFTSeconds: m.MaxTtftSeconds,
+			ReadyTimeout:   time.Duration(m.ReadyTimeoutSeconds) * time.Second,
+		},
+	})
+	if err != nil {
+		return nil, rolloutError(err)
+	}
+	return connect.NewResponse(toRollout(r)), nil
+}
+
+func (s *LLMApiServer) GetRollout(ctx context.Context, req *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error) {
+	if req.Msg.Namespace == "" || req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and name are required"))
+	}
+	r, err := s.Rollouts.Get(ctx, req.Msg.Namespace, req.Msg.Name)
+	if err != nil {
+		return nil, rolloutError(err)
+	}
+	return connect.NewResponse(toRollout(r)), nil
+}
+
+func (s *LLMApiServer) AbortRollout(ctx context.Context, req *connect.Request[vllmv1.RolloutRequest]) (*connect.Response[vllmv1.Rollout], error) {
+	if req.Msg.Namespace == "" || req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and name are required"))
+	}
+	r, err := s.Rollouts.Abort(ctx, req.Msg.Namespace, req.Msg.Name)
+	if err != nil {
+		return nil, rolloutError(err)
+	}
+	return connect.NewResponse(toRollout(r)), nil
+}
+
+func (s *LLMApiServer) LoadAdapter(ctx context.Context, req *connect.Request[vllmv1.LoadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error) {
+	if req.Msg.Namespace == "" || req.Msg.Runtime == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and runtime are required"))
+	}
+	adapters, err := s.Adapters.Load(ctx, req.Msg.Namespace, req.Msg.Runtime, domain.Adapter{Name: req.Msg.Name, StorageURI: req.Msg.StorageUri})
+	if err != nil {
+		return nil, adapterError(err)
+	}
+	return connect.NewResponse(toAdaptersResponse(adapters)), nil
+}
+
+func (s *LLMApiServer) UnloadAdapter(ctx context.Context, req *connect.Request[vllmv1.UnloadAdapterRequest]) (*connect.Response[vllmv1.AdaptersResponse], error) {
+	if req.Msg.Namespace == "" || req.Msg.Runtime == "" || req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace, runtime and name are required"))
+	}
+	adapters, err := s.Adapters.Unload(ctx, req.Msg.Namespace, req.Msg.Runtime, req.Msg.Name)
+	if err != nil {
+		return nil, adapterError(err)
+	}
+	return connect.NewResponse(toAdaptersResponse(adapters)), nil
+}
+
+func (s *LLMApiServer) ListAdapters(ctx context.Context, req *connect.Request[vllmv1.ListAdaptersRequest]) (*connect.Response[vllmv1.AdaptersResponse], error) {
+	if req.Msg.Namespace == "" || req.Msg.Runtime == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and runtime are required"))
+	}
+	adapters, err := s.Adapters.List(ctx, req.Msg.Namespace, req.Msg.Runtime)
+	if err != nil {
+		return nil, adapterError(err)
+	}
+	return connect.NewResponse(toAdaptersResponse(adapters)), nil
+}
+
+func (s *LLMApiServer) WarmUp(ctx context.Context, req *connect.Request[vllmv1.WarmUpRequest]) (*connect.Response[vllmv1.Warmup], error) {
+	if req.Msg.Namespace == "" || req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and name are required"))
+	}
+	w, err := s.Warmups.Start(ctx, req.Msg.Namespace, req.Msg.Name, req.Msg.NodeSelector)
+	if err != nil {
+		return nil, warmupError(err)
+	}
+	return connect.NewResponse(toWarmup(w)), nil
+}
+
+func (s *LLMApiServer) GetWarmUp(ctx context.Context, req *connect.Request[vllmv1.GetWarmUpRequest]) (*connect.Response[vllmv1.Warmup], error) {
+	if req.Msg.Namespace == "" || req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace and name are required"))
+	}
+	w, err := s.Warmups.Get(ctx, req.Msg.Namespace, req.Msg.Name)
+	if err != nil {
+		return nil, warmupError(err)
+	}
+	return connect.NewResponse(toWarmup(w)), nil
+}
+
+func warmupError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidStorageURI):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domain.ErrWarmupInProgress):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, domain.ErrNoWarmup):
+		return connect.NewError(connect.CodeNotFound, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func toWarmup(w *domain.Warmup) *vllmv1.Warmup {
+	res := &vllmv1.Warmup{
+		Namespace:    w.Namespace,
+		Name:         w.Name,
+		Image:        w.Image,
+		CachePath:    w.CachePath,
+		NodeSelector: w.NodeSelector,
+		Phase:        string(w.Phase),
+		Message:      w.Message,
+		NodesDesired: w.NodesDesired,
+		NodesPulled:  w.NodesPulled,
+		NodesReady:   w.NodesReady,
+		StartedAt:    timestamppb.New(w.StartedAt),
+	}
+	if !w.CompletedAt.IsZero() {
+		res.CompletedAt = timestamppb.New(w.CompletedAt)
+	}
+	return res
+}
+
+func adapterError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidAdapter):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domain.ErrNotRunning):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, domain.ErrAdapterNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func toAdaptersResponse(adapters []domain.AdapterStatus) *vllmv1.AdaptersResponse {
+	res := &vllmv1.AdaptersResponse{}
+	for _, a := range adapters {
+		res.Adapters = append(res.Adapters, &vllmv1.Adapter{Name: a.Name, StorageUri: a.StorageURI, Loaded: a.Loaded})
+	}
+	return res
+}
+
+func rolloutError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidRollout):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, domain.ErrRolloutInProgress):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, domain.ErrNoRollout):
+		return connect.NewError(connect.CodeNotFound, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func toRollout(r *domain.Rollout) *vllmv1.Rollout {
+	return &vllmv1.Rollout{
+		Namespace:     r.Namespace,
+		Stable:        r.Stable,
+		Canary:        r.Canary,
+		Strategy:      string(r.Spec.Strategy),
+		Route:         r.Spec.Route,
+		Steps:         r.Spec.Steps,
+		Weight:        r.Weight(),
+		Phase:         string(r.Phase),
+		Message:       r.Message,
+		StartedAt:     timestamppb.New(r.StartedAt),
+		StepStartedAt: timestamppb.New(r.StepStartedAt),
+	}
+}
+
+func toEngineStats(s *domain.EngineStats) *vllmv1.EngineStats {
+	if s == nil {
+		return nil
+	}
+	return &vllmv1.EngineStats{
+		KvCacheUsage:              s.KVCacheUsage,
+		RequestsRunning:           s.RequestsRunning,
+		RequestsWaiting:           s.RequestsWaiting,
+		PromptTokensPerSecond:     s.PromptTokensPerSecond,
+		GenerationTokensPerSecond: s.GenerationTokensPerSecond,
+		TimeToFirstTokenSeconds:   s.TTFTSeconds,
+		ScrapedAt:                 timestamppb.New(s.ScrapedAt),
+		ErrorRate:                 s.ErrorRate,
+	}
+}
+
+func toReadinessProgress(progress []domain.ReadinessProgress) []*vllmv1.ReadinessProgress {
+	out := make([]*vllmv1.ReadinessProgress, 0, len(progress))
+	for _, p := range progress {
+		out = append(out, &vllmv1.ReadinessProgress{
+			Phase:   string(p.Phase),
+			Message: p.Message,
+			Time:    timestamppb.New(p.Time),
+		})
+	}
+	return out
+}
+
+func newLLMResponse(message string, status domain.Status) (*connect.Response[vllmv1.LLMResponse], error) {
+	spec, err := toAnyMap(map[string]interface{}{"status": string(status)})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vllmv1.LLMResponse{Message: message, Spec: spec}), nil
+}
+
+func newDryRunResponse(message string, change *domain.SpecChange) (*connect.Response[vllmv1.LLMResponse], error) {
+	object, err := toAnyMap(change.Object)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &vllmv1.LLMResponse{Message: message, Object: object}
+	for _, c := range auditCore.Diff(change.Before, change.After) {
+		res.Changes = append(res.Changes, &vllmv1.SpecChange{Path: c.Path, Before: c.Before, After: c.After})
+	}
+	return connect.NewResponse(res), nil
+}
+
+// fromAnyMap unpacks google.protobuf.Value entries into plain Go values.
+func fromAnyMap(m map[string]*anypb.Any) (map[string]interface{}, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]interface{}, len(m))
+	for k, packed := range m {
+		var value structpb.Value
+		if err := packed.UnmarshalTo(&value); err != nil {
+			return nil, fmt.Errorf("spec %q must be a google.protobuf.Value: %w", k, err)
+		}
+		out[k] = value.AsInterface()
+	}
+	return out, nil
+}
+
+// conditionValues turns conditions into plain values structpb can encode.
+func conditionValues(conds []metav1.Condition) []interface{} {
+	out := make([]interface{}, 0, len(conds))
+	for _, c := range conds {
+		out = append(out, map[string]interface{}{
+			"type":               c.Type,
+			"status":             string(c.Status),
+			"reason":             c.Reason,
+			"message":            c.Message,
+			"observedGeneration": float64(c.ObservedGeneration),
+			"lastTransitionTime": c.LastTransitionTime.UTC().Format(time.RFC3339),
+		})
+	}
+	return out
+}
+
+// toAnyMap packs plain Go values as google.protobuf.Value inside Any.
+func toAnyMap(m map[string]interface{}) (map[string]*anypb.Any, error) {
+	out := make(map[string]*anypb.Any, len(m))
+	for k, v := range m {
+		value, err := structpb.NewValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %q: %w", k, err)
+		}
+		packed, err := anypb.New(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack %q: %w", k, err)
+		}
+		out[k] = packed
+	}
+	return out, nil
+}
@@ -0,0 +1,69 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllmv1"
+	"connect-go/api/vllmv1/vllmv1connect"
+	authIface "connect-go/internal/cmd/auth"
+	auditCore "connect-go/internal/core/audit"
+	authCore "connect-go/internal/core/auth"
+	auditInfra "connect-go/internal/data/audit"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// bearerNames authenticates "Authorization: Bearer <name>" as <name>.
+type bearerNames struct{}
+
+func (bearerNames) Authenticate(_ context.Context, creds authCore.Credentials) (*authCore.Principal, error) {
+	name, ok := strings.CutPrefix(creds.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return nil, authCore.ErrNoCredentials
+	}
+	return &authCore.Principal{Name: name}, nil
+}
+
+func TestListAuditEventsStaysInAuthorizedNamespace(t *testing.T) {
+	store := auditInfra.NewMemoryStore(0)
+	for _, ns := range []string{"default", "team-b"} {
+		if err := store.Record(t.Context(), auditCore.Event{ID: ns, Time: time.Now(), Action: "stop", Namespace: ns}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	policy := &authCore.Policy{Rules: []authCore.Rule{
+		{Users: []string{"alice"}, Namespaces: []string{"default"}, Actions: []authCore.Action{authCore.ActionAudit}},
+	}}
+	mux := http.NewServeMux()
+	mux.Handle(vllmv1connect.NewLLMApiServiceHandler(&LLMApiServer{Audit: store},
+		connect.WithInterceptors(authIface.NewInterceptor(bearerNames{}, policy))))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := vllmv1connect.NewLLMApiServiceClient(server.Client(), server.URL)
+
+	list := func(namespace string) (*connect.Response[vllmv1.ListAuditEventsResponse], error) {
+		req := connect.NewRequest(&vllmv1.ListAuditEventsRequest{Namespace: namespace})
+		req.Header().Set("Authorization", "Bearer alice")
+		return client.ListAuditEvents(t.Context(), req)
+	}
+
+	res, err := list("default")
+	if err != nil {
+		t.Fatalf("ListAuditEvents(default) = %v", err)
+	}
+	if len(res.Msg.Events) != 1 || res.Msg.Events[0].Namespace != "default" {
+		t.Fatalf("ListAuditEvents(default) = %v, want only the default event", res.Msg.Events)
+	}
+	if _, err := list("team-b"); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("ListAuditEvents(team-b) = %v, want PermissionDenied", err)
+	}
+	// An empty namespace is authorized as default but would match every
+	// namespace, so it is refused outright.
+	if _, err := list(""); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("ListAuditEvents(\"\") = %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,44 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllmv1"
+	"connect-go/internal/app/vllm"
+	auditCore "connect-go/internal/core/audit"
+	domain "connect-go/internal/core/vllm"
+	infra "connect-go/internal/data/vllm"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func TestStopLLMDryRun(t *testing.T) {
+	service := &dryRunService{}
+	server := &LLMApiServer{Service: service, Idempotency: vllm.NewIdempotencyGuard(infra.NewMemoryIdempotencyStore())}
+	change := stopChange("llama")
+	want := auditCore.Diff(change.Before, change.After)
+
+	for range 2 {
+		req := connect.NewRequest(&vllmv1.LLMRequest{Namespace: "default", RuntimeName: "llama", DryRun: true, IdempotencyKey: "key-1"})
+		res, err := server.StopLLM(t.Context(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Header().Get(domain.IdempotentReplayedHeader) != "" {
+			t.Error("dry run was replayed")
+		}
+		if len(res.Msg.Changes) != len(want) {
+			t.Fatalf("changes = %v, want %v", res.Msg.Changes, want)
+		}
+		for i, c := range res.Msg.Changes {
+			if c.Path != want[i].Path || c.Before != want[i].Before || c.After != want[i].After {
+				t.Errorf("change %d = %v, want %+v", i, c, want[i])
+			}
+		}
+		if res.Msg.Object["kind"] == nil {
+			t.Errorf("object = %v, want the rendered object", res.Msg.Object)
+		}
+	}
+	if service.calls != 2 {
+		t.Errorf("Stop ran %d times, want every dry run to run", service.calls)
+	}
+}
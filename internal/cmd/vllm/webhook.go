@@ -0,0 +1,101 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	vllmv2 "connect-go/api/vllm/v2"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+// Webhook paths; config/webhook/manifests.yaml and the CRD's conversion
+// stanza point the API server at them.
+const (
+	ValidatePath = "/validate-vllm-ai-v1-vllm"
+	MutatePath   = "/mutate-vllm-ai-v1-vllm"
+	ConvertPath  = "/convert"
+)
+
+var vllmGroupKind = schema.GroupKind{Group: "vllm.ai", Kind: "VLLM"}
+
+// Validator rejects VLLM objects whose free-form fields contradict each other,
+// such as more tensor-parallel ranks than GPUs or repeated engine flags.
+type Validator struct{}
+
+func (Validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Delete {
+		return admission.Allowed("")
+	}
+	obj, err := decodeVLLM(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	errs := domain.ValidateSpec(spec)
+	if len(errs) == 0 {
+		return admission.Allowed("")
+	}
+	slog.InfoContext(ctx, "rejected VLLM resource", "namespace", req.Namespace, "resource", obj.GetName(), "operation", req.Operation, "error", errs.ToAggregate())
+	status := apierrors.NewInvalid(vllmGroupKind, obj.GetName(), errs).ErrStatus
+	return admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{Allowed: false, Result: &status}}
+}
+
+// Defaulter fills in the port, image, HF_HOME and other defaults on create and
+// update, so objects applied by hand match what CreateLLM renders. Finalizers
+// are left to the cleanup controller.
+type Defaulter struct{}
+
+func (Defaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	obj, err := decodeVLLM(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	if !domain.DefaultSpec(obj.GetName(), spec) {
+		return admission.Allowed("")
+	}
+	if err := unstructured.SetNestedMap(obj.Object, spec, "spec"); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	defaulted, err := json.Marshal(obj.Object)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, defaulted)
+}
+
+func decodeVLLM(req admission.Request) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(req.Object.Raw); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// RegisterWebhooks adds the VLLM admission webhooks and the v1/v2 conversion
+// webhook to srv. The admission webhooks only see v1, the storage version; the
+// API server converts v2 objects before calling them.
+func RegisterWebhooks(srv webhook.Server) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(vllmv1.AddToScheme(scheme))
+	utilruntime.Must(vllmv2.AddToScheme(scheme))
+
+	srv.Register(ValidatePath, &admission.Webhook{Handler: Validator{}})
+	srv.Register(MutatePath, &admission.Webhook{Handler: Defaulter{}})
+	srv.Register(ConvertPath, conversion.NewWebhookHandler(scheme))
+}
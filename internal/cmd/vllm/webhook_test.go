@@ -0,0 +1,164 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func vllmObject(name string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "vllm.ai/v1",
+		"kind":       "VLLM",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		"spec":       spec,
+	}}
+}
+
+func admissionRequest(t *testing.T, op admissionv1.Operation, obj *unstructured.Unstructured) admission.Request {
+	t.Helper()
+	raw, err := json.Marshal(obj.Object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: op,
+		Namespace: "default",
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+}
+
+func TestDefaulter(t *testing.T) {
+	obj := vllmObject("llama", map[string]interface{}{"model": "meta-llama/Llama-3.1-8B"})
+	res := Defaulter{}.Handle(t.Context(), admissionRequest(t, admissionv1.Create, obj))
+	if !res.Allowed {
+		t.Fatalf("Handle() denied: %v", res.Result)
+	}
+	paths := map[string]bool{}
+	for _, p := range res.Patches {
+		paths[p.Path] = true
+		if strings.HasPrefix(p.Path, "/metadata") {
+			t.Errorf("Handle() patched %s; finalizers belong to the cleanup controller", p.Path)
+		}
+	}
+	for _, want := range []string{"/spec/runtimeName", "/spec/replicas", "/spec/vllmConfig", "/spec/deploymentConfig"} {
+		if !paths[want] {
+			t.Errorf("Handle() patches %v, want %s", res.Patches, want)
+		}
+	}
+
+	spec := map[string]interface{}{"model": "meta-llama/Llama-3.1-8B"}
+	domain.DefaultSpec("llama", spec)
+	res = Defaulter{}.Handle(t.Context(), admissionRequest(t, admissionv1.Update, vllmObject("llama", spec)))
+	if !res.Allowed || len(res.Patches) != 0 {
+		t.Errorf("Handle() on a defaulted object = %v, %v, want allowed without patches", res.Allowed, res.Patches)
+	}
+}
+
+func TestValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		allowed bool
+	}{
+		{"valid", []interface{}{"--enforce-eager", "--max-model-len", "4096"}, true},
+		{"repeated lora modules", []interface{}{"--enable-lora", "--lora-modules", "sql=/a", "--lora-modules", "chat=/b"}, true},
+		{"repeated flag", []interface{}{"--max-model-len", "4096", "--max-model-len", "8192"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := vllmObject("llama", map[string]interface{}{"model": "meta-llama/Llama-3.1-8B", "args": tt.args})
+			res := Validator{}.Handle(t.Context(), admissionRequest(t, admissionv1.Create, obj))
+			if res.Allowed != tt.allowed {
+				t.Fatalf("Handle() allowed = %v (%v), want %v", res.Allowed, res.Result, tt.allowed)
+			}
+		})
+	}
+	res := Validator{}.Handle(t.Context(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: admissionv1.Delete}})
+	if !res.Allowed {
+		t.Errorf("Handle() denied a delete: %v", res.Result)
+	}
+}
+
+// TestWebhooksWithAPIServer installs the CRD and webhook configurations into
+// a real API server and applies VLLM objects through it. It needs the envtest
+// binaries; run setup-envtest and set KUBEBUILDER_ASSETS.
+func TestWebhooksWithAPIServer(t *testing.T) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set")
+	}
+	root := filepath.Join("..", "..", "..", "config")
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join(root, "crd")},
+		ErrorIfCRDPathMissing: true,
+		WebhookInstallOptions: envtest.WebhookInstallOptions{
+			Paths:                   []string{filepath.Join(root, "webhook")},
+			IgnoreSchemeConvertible: true,
+		},
+	}
+	config, err := env.Start()
+	if err != nil {
+		t.Fatalf("failed to start envtest: %v", err)
+	}
+	t.Cleanup(func() { env.Stop() })
+
+	opts := env.WebhookInstallOptions
+	srv := webhook.NewServer(webhook.Options{Host: opts.LocalServingHost, Port: opts.LocalServingPort, CertDir: opts.LocalServingCertDir})
+	RegisterWebhooks(srv)
+	go srv.Start(t.Context())
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vllms := client.Resource(schema.GroupVersionResource{Group: "vllm.ai", Version: "v1", Resource: "vllms"}).Namespace("default")
+
+	// The API server fails closed until the webhook server answers.
+	var created *unstructured.Unstructured
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		obj := vllmObject("llama", map[string]interface{}{
+			"model": "meta-llama/Llama-3.1-8B",
+			"args":  []interface{}{"--enable-lora", "--lora-modules", "sql=/a", "--lora-modules", "chat=/b", "--enforce-eager"},
+		})
+		created, err = vllms.Create(t.Context(), obj, metav1.CreateOptions{})
+		if err == nil || time.Now().After(deadline) || apierrors.IsInvalid(err) {
+			break
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("Create() = %v", err)
+	}
+	if port, _, _ := unstructured.NestedInt64(created.Object, "spec", "vllmConfig", "port"); port != domain.DefaultPort {
+		t.Errorf("spec.vllmConfig.port = %d, want the default %d", port, domain.DefaultPort)
+	}
+	if name, _, _ := unstructured.NestedString(created.Object, "spec", "runtimeName"); name != "llama" {
+		t.Errorf("spec.runtimeName = %q, want llama", name)
+	}
+	if len(created.GetFinalizers()) != 0 {
+		t.Errorf("finalizers = %v, want none from the webhook", created.GetFinalizers())
+	}
+
+	invalid := vllmObject("invalid", map[string]interface{}{
+		"model": "meta-llama/Llama-3.1-8B",
+		"args":  []interface{}{"--max-model-len", "4096", "--max-model-len", "8192"},
+	})
+	if _, err := vllms.Create(t.Context(), invalid, metav1.CreateOptions{}); err == nil || !strings.Contains(err.Error(), "--max-model-len") {
+		t.Errorf("Create(invalid) = %v, want the repeated flag rejected", err)
+	}
+}
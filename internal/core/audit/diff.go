@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Change is a single field that differs between two specs. Before and After
+// hold the JSON encoding of the value, empty when the field is absent.
+type Change struct {
+	Path   string `json:"path"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// Diff returns the leaf fields that differ between before and after, sorted by path.
+func Diff(before, after map[string]interface{}) []Change {
+	var changes []Change
+	diffValue("", before, after, &changes)
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes
+}
+
+func diffValue(path string, before, after interface{}, changes *[]Change) {
+	bm, bIsMap := before.(map[string]interface{})
+	am, aIsMap := after.(map[string]interface{})
+	if (bIsMap || before == nil) && (aIsMap || after == nil) && (bIsMap || aIsMap) {
+		keys := map[string]struct{}{}
+		for k := range bm {
+			keys[k] = struct{}{}
+		}
+		for k := range am {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			child := k
+			if path != "" {
+				child = path + "." + k
+			}
+			diffValue(child, bm[k], am[k], changes)
+		}
+		return
+	}
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	*changes = append(*changes, Change{Path: path, Before: encode(before), After: encode(after)})
+}
+
+func encode(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
@@ -0,0 +1,68 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Event is one lifecycle action performed through the management API.
+type Event struct {
+	ID          string        `json:"id"`
+	Time        time.Time     `json:"time"`
+	Caller      string        `json:"caller"`
+	Groups      []string      `json:"groups,omitempty"`
+	Action      string        `json:"action"`
+	Namespace   string        `json:"namespace"`
+	RuntimeName string        `json:"runtimeName"`
+	Resource    string        `json:"resource,omitempty"`
+	Template    string        `json:"template,omitempty"`
+	Changes     []Change      `json:"changes,omitempty"`
+	Outcome     string        `json:"outcome"`
+	Error       string        `json:"error,omitempty"`
+	Latency     time.Duration `json:"latencyNs"`
+}
+
+type Recorder interface {
+	Record(ctx context.Context, ev Event) error
+}
+
+type Store interface {
+	List(ctx context.Context, q Query) ([]Event, error)
+}
+
+// Recorders fans an event out to every sink and reports all failures.
+type Recorders []Recorder
+
+func (rs Recorders) Record(ctx context.Context, ev Event) error {
+	var errs []error
+	for _, r := range rs {
+		if err := r.Record(ctx, ev); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Query filters stored events. Zero values match everything.
+type Query struct {
+	Namespace   string
+	RuntimeName string
+	Action      string
+	Caller      string
+	Since       time.Time
+	Limit       int
+}
+
+func (q Query) Matches(ev Event) bool {
+	return (q.Namespace == "" || q.Namespace == ev.Namespace) &&
+		(q.RuntimeName == "" || q.RuntimeName == ev.RuntimeName) &&
+		(q.Action == "" || q.Action == ev.Action) &&
+		(q.Caller == "" || q.Caller == ev.Caller) &&
+		(q.Since.IsZero() || !ev.Time.Before(q.Since))
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+)
+
+type Action string
+
+const (
+	ActionStart   Action = "start"
+	ActionStop    Action = "stop"
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionDelete  Action = "delete"
+	ActionList    Action = "list"
+	ActionAudit   Action = "audit"
+	ActionRollout Action = "rollout"
+)
+
+var (
+	// ErrNoCredentials is returned by an Authenticator when the request does not
+	// carry the kind of credentials it understands.
+	ErrNoCredentials = errors.New("no credentials provided")
+	ErrForbidden     = errors.New("forbidden")
+)
+
+// Principal is the authenticated caller of the management API.
+type Principal struct {
+	Name   string
+	Groups []string
+	Method string
+}
+
+// Credentials is everything an Authenticator may inspect on an incoming request.
+type Credentials struct {
+	Header http.Header
+	TLS    *tls.ConnectionState
+}
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, creds Credentials) (*Principal, error)
+}
+
+// Union tries each authenticator in order and returns the first principal found.
+type Union []Authenticator
+
+func (u Union) Authenticate(ctx context.Context, creds Credentials) (*Principal, error) {
+	var errs []error
+	for _, a := range u {
+		p, err := a.Authenticate(ctx, creds)
+		if err == nil {
+			return p, nil
+		}
+		if !errors.Is(err, ErrNoCredentials) {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil, ErrNoCredentials
+	}
+	return nil, errors.Join(errs...)
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
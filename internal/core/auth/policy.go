@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+)
+
+const Wildcard = "*"
+
+// Rule grants the listed actions in the listed namespaces to any matching user or group.
+type Rule struct {
+	Users      []string `yaml:"users"`
+	Groups     []string `yaml:"groups"`
+	Namespaces []string `yaml:"namespaces"`
+	Actions    []Action `yaml:"actions"`
+}
+
+// Policy is a per-namespace allow list. Anything not granted by a rule is denied.
+type Policy struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+func (p *Policy) Authorize(principal *Principal, action Action, namespace string) error {
+	if principal == nil {
+		return fmt.Errorf("%w: no principal", ErrForbidden)
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	for _, rule := range p.Rules {
+		if rule.matchesSubject(principal) && rule.matchesNamespace(namespace) && rule.matchesAction(action) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s may not %s in namespace %s", ErrForbidden, principal.Name, action, namespace)
+}
+
+func (r Rule) matchesSubject(p *Principal) bool {
+	if slices.Contains(r.Users, Wildcard) || slices.Contains(r.Users, p.Name) {
+		return true
+	}
+	for _, g := range p.Groups {
+		if slices.Contains(r.Groups, g) {
+			return true
+		}
+	}
+	return slices.Contains(r.Groups, Wildcard)
+}
+
+func (r Rule) matchesNamespace(namespace string) bool {
+	return slices.Contains(r.Namespaces, Wildcard) || slices.Contains(r.Namespaces, namespace)
+}
+
+func (r Rule) matchesAction(action Action) bool {
+	return slices.Contains(r.Actions, Wildcard) || slices.Contains(r.Actions, action)
+}
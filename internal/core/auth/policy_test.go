@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPolicyAuthorize(t *testing.T) {
+	policy := &Policy{Rules: []Rule{
+		{Groups: []string{"ml-platform"}, Namespaces: []string{Wildcard}, Actions: []Action{Wildcard}},
+		{Users: []string{"ci-bot"}, Namespaces: []string{"staging"}, Actions: []Action{ActionStart, ActionStop, ActionList}},
+		{Users: []string{Wildcard}, Namespaces: []string{"default"}, Actions: []Action{ActionList}},
+	}}
+	tests := []struct {
+		name      string
+		principal *Principal
+		action    Action
+		namespace string
+		allowed   bool
+	}{
+		{"group wildcard", &Principal{Name: "alice", Groups: []string{"ml-platform"}}, ActionDelete, "prod", true},
+		{"user in namespace", &Principal{Name: "ci-bot"}, ActionStart, "staging", true},
+		{"user action not granted", &Principal{Name: "ci-bot"}, ActionCreate, "staging", false},
+		{"user other namespace", &Principal{Name: "ci-bot"}, ActionStart, "prod", false},
+		{"any user lists default", &Principal{Name: "bob"}, ActionList, "default", true},
+		{"empty namespace is default", &Principal{Name: "bob"}, ActionList, "", true},
+		{"any user may not start in default", &Principal{Name: "bob"}, ActionStart, "default", false},
+		{"other group", &Principal{Name: "carol", Groups: []string{"research"}}, ActionStart, "prod", false},
+		{"no principal", nil, ActionList, "default", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Authorize(tt.principal, tt.action, tt.namespace)
+			if tt.allowed && err != nil {
+				t.Fatalf("Authorize() = %v, want allowed", err)
+			}
+			if !tt.allowed && !errors.Is(err, ErrForbidden) {
+				t.Fatalf("Authorize() = %v, want ErrForbidden", err)
+			}
+		})
+	}
+}
+
+func TestPolicyDeniesWithoutRules(t *testing.T) {
+	if err := (&Policy{}).Authorize(&Principal{Name: "alice"}, ActionList, "default"); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("Authorize() = %v, want ErrForbidden", err)
+	}
+}
+
+func TestUnion(t *testing.T) {
+	denied := errors.New("bad token")
+	tests := []struct {
+		name    string
+		union   Union
+		want    string
+		wantErr error
+	}{
+		{"first match wins", Union{fakeAuthenticator{err: ErrNoCredentials}, fakeAuthenticator{name: "bob"}, fakeAuthenticator{name: "carol"}}, "bob", nil},
+		{"no credentials", Union{fakeAuthenticator{err: ErrNoCredentials}}, "", ErrNoCredentials},
+		{"rejected credentials", Union{fakeAuthenticator{err: denied}, fakeAuthenticator{err: ErrNoCredentials}}, "", denied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := tt.union.Authenticate(t.Context(), Credentials{})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Authenticate() = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || p.Name != tt.want {
+				t.Fatalf("Authenticate() = %v, %v, want %s", p, err, tt.want)
+			}
+		})
+	}
+}
+
+type fakeAuthenticator struct {
+	name string
+	err  error
+}
+
+func (f fakeAuthenticator) Authenticate(context.Context, Credentials) (*Principal, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &Principal{Name: f.name}, nil
+}
@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestRequestIDTransport(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get(RequestIDHeader))
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: RequestIDTransport(nil)}
+
+	send := func(req *http.Request) {
+		t.Helper()
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	withID, _ := http.NewRequestWithContext(WithRequestID(t.Context(), "req-1"), http.MethodGet, server.URL, nil)
+	send(withID)
+	if withID.Header.Get(RequestIDHeader) != "" {
+		t.Error("the transport modified the caller's request")
+	}
+	without, _ := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	send(without)
+	// An ID the caller set explicitly is kept.
+	explicit, _ := http.NewRequestWithContext(WithRequestID(t.Context(), "req-1"), http.MethodGet, server.URL, nil)
+	explicit.Header.Set(RequestIDHeader, "upstream")
+	send(explicit)
+
+	if want := []string{"req-1", "", "upstream"}; !slices.Equal(got, want) {
+		t.Errorf("server saw request IDs %q, want %q", got, want)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer s3cret")
+	h.Set("Cookie", "session=abc")
+	h.Set("x-api-key", "key")
+	h.Set("Content-Type", "application/json")
+
+	out := RedactHeaders(h)
+	for _, name := range []string{"Authorization", "Cookie", "X-Api-Key"} {
+		if out.Get(name) != redacted {
+			t.Errorf("%s = %q, want it redacted", name, out.Get(name))
+		}
+	}
+	if out.Get("Content-Type") != "application/json" || out.Get("Set-Cookie") != "" {
+		t.Errorf("other headers changed: %v", out)
+	}
+	if h.Get("Authorization") != "Bearer s3cret" {
+		t.Error("RedactHeaders modified its argument")
+	}
+}
+
+func TestContextHandlerAddsRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(ContextHandler{slog.NewJSONHandler(&buf, nil)}).With("component", "test")
+	logger.InfoContext(WithRequestID(t.Context(), "req-1"), "hello")
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record["request_id"] != "req-1" || record["component"] != "test" {
+		t.Errorf("record = %v, want request_id and component", record)
+	}
+}
@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const namespace = "vllm_control_plane"
+
+// Registry holds every control plane metric and is served on /metrics.
+var Registry = prometheus.NewRegistry()
+
+var (
+	RPCRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_requests_total",
+		Help:      "Connect RPCs handled, by procedure and result code.",
+	}, []string{"procedure", "code"})
+
+	RPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_request_duration_seconds",
+		Help:      "Connect RPC latency by procedure.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"procedure"})
+
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "JSON API requests handled, by route, method and status code.",
+	}, []string{"route", "method", "code"})
+
+	HTTPDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "JSON API latency by route and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method"})
+
+	KubernetesRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "kubernetes_requests_total",
+		Help:      "Kubernetes API calls, by HTTP method, resource and status code.",
+	}, []string{"method", "resource", "code"})
+
+	KubernetesDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "kubernetes_request_duration_seconds",
+		Help:      "Kubernetes API call latency by HTTP method and resource.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "resource"})
+
+	LifecycleActions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "lifecycle_actions_total",
+		Help:      "Start and stop requests, by namespace, model, action and outcome.",
+	}, []string{"namespace", "model", "action", "outcome"})
+
+	PhaseTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "phase_transitions_total",
+		Help:      "Observed VLLM status.phase transitions, counted by the leader.",
+	}, []string{"namespace", "model", "from", "to"})
+
+	StartToRunning = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "start_to_running_seconds",
+		Help:      "Time from an accepted start request until the runtime reports Running.",
+		Buckets:   []float64{5, 15, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+	}, []string{"namespace", "model"})
+
+	AutoscalerDecisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "autoscaler_decisions_total",
+		Help:      "Autoscaler decisions, by namespace, model and reason.",
+	}, []string{"namespace", "model", "reason"})
+
+	Leader = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "leader",
+		Help:      "1 while this replica holds the leader Lease and runs the background controllers.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		RPCRequests, RPCDuration,
+		HTTPRequests, HTTPDuration,
+		KubernetesRequests, KubernetesDuration,
+		LifecycleActions, PhaseTransitions, StartToRunning,
+		AutoscalerDecisions,
+		Leader,
+	)
+}
+
+// maxPendingStart is how long a start is remembered without its runtime
+// reaching Running. It is well past the largest StartToRunning bucket, so a
+// start that never completes is dropped rather than kept forever.
+const maxPendingStart = 2 * time.Hour
+
+var (
+	pendingMu sync.Mutex
+	pending   = map[string]time.Time{}
+)
+
+// StartRequested remembers when a start was accepted for a VLLM resource so
+// ObservePhase can measure how long it takes to reach Running.
+func StartRequested(namespace, resource string, at time.Time) {
+	pendingMu.Lock()
+	defer pendingMu.Unlock()
+	for key, started := range pending {
+		if at.Sub(started) > maxPendingStart {
+			delete(pending, key)
+		}
+	}
+	pending[namespace+"/"+resource] = at
+}
+
+// StopRequested drops any pending start for the resource.
+func StopRequested(namespace, resource string) {
+	forgetStart(namespace, resource)
+}
+
+// RuntimeDeleted drops any pending start for a deleted resource.
+func RuntimeDeleted(namespace, resource string) {
+	forgetStart(namespace, resource)
+}
+
+func forgetStart(namespace, resource string) {
+	pendingMu.Lock()
+	defer pendingMu.Unlock()
+	delete(pending, namespace+"/"+resource)
+}
+
+// CountPhase counts a phase transition seen on a VLLM resource.
+func CountPhase(namespace, model, from, to string) {
+	if from != to {
+		PhaseTransitions.WithLabelValues(namespace, model, from, to).Inc()
+	}
+}
+
+// ObservePhase ends the pending start of a VLLM resource on a phase
+// transition. A start ends with the runtime reaching Running or Failed; only
+// the former is timed.
+func ObservePhase(namespace, resource, model, from, to string, now time.Time) {
+	if from == to {
+		return
+	}
+	if to != "Running" && to != "Failed" {
+		return
+	}
+	pendingMu.Lock()
+	at, ok := pending[namespace+"/"+resource]
+	delete(pending, namespace+"/"+resource)
+	pendingMu.Unlock()
+	if ok && to == "Running" {
+		StartToRunning.WithLabelValues(namespace, model).Observe(now.Sub(at).Seconds())
+	}
+}
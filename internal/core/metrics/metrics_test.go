@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+)
+
+func pendingStart(namespace, resource string) bool {
+	pendingMu.Lock()
+	defer pendingMu.Unlock()
+	_, ok := pending[namespace+"/"+resource]
+	return ok
+}
+
+func TestPendingStartsAreEvicted(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name  string
+		evict func(namespace, resource string)
+	}{
+		{"running", func(ns, name string) { ObservePhase(ns, name, "m", "Pending", "Running", now) }},
+		{"failed", func(ns, name string) { ObservePhase(ns, name, "m", "Pending", "Failed", now) }},
+		{"stopped", StopRequested},
+		{"deleted", RuntimeDeleted},
+		{"expired", func(string, string) { StartRequested("test", "other", now.Add(maxPendingStart+time.Minute)) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			StartRequested("test", tt.name, now)
+			tt.evict("test", tt.name)
+			if pendingStart("test", tt.name) {
+				t.Fatalf("start of %s is still pending", tt.name)
+			}
+		})
+	}
+}
+
+func TestPendingStartSurvivesOtherPhases(t *testing.T) {
+	now := time.Now()
+	StartRequested("test", "loading", now)
+	defer StopRequested("test", "loading")
+	ObservePhase("test", "loading", "m", "Stopped", "Pending", now)
+	if !pendingStart("test", "loading") {
+		t.Fatal("start was dropped before the runtime settled")
+	}
+}
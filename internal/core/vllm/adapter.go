@@ -0,0 +1,122 @@
+package vllm
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	ActionLoadAdapter   = "loadAdapter"
+	ActionUnloadAdapter = "unloadAdapter"
+)
+
+const (
+	argEnableLoRA  = "--enable-lora"
+	argLoRAModules = "--lora-modules"
+	// EnvRuntimeLoRAUpdating lets vLLM load and unload adapters while serving.
+	EnvRuntimeLoRAUpdating = "VLLM_ALLOW_RUNTIME_LORA_UPDATING"
+)
+
+var (
+	ErrInvalidAdapter  = errors.New("invalid adapter")
+	ErrAdapterNotFound = errors.New("adapter not found")
+	ErrNotRunning      = errors.New("runtime is not running")
+)
+
+var adapterNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,127}$`)
+
+// Adapter is a LoRA adapter served on top of a runtime's base model.
+type Adapter struct {
+	Name       string
+	StorageURI string
+}
+
+// AdapterStatus is an adapter as declared in spec.adapters and as seen by the engine.
+type AdapterStatus struct {
+	Name       string
+	StorageURI string
+	Loaded     bool
+}
+
+// AdapterTarget is a runtime whose adapters are being managed.
+type AdapterTarget struct {
+	Namespace string
+	Name      string
+	Model     string
+	Phase     string
+	Endpoint  string
+	Adapters  []Adapter
+	Args      []string
+}
+
+func (a Adapter) Validate() error {
+	if !adapterNamePattern.MatchString(a.Name) {
+		return fmt.Errorf("%w: name %q must be 1-128 letters, digits, '.', '_' or '-'", ErrInvalidAdapter, a.Name)
+	}
+	if _, err := a.Path(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Path is where the engine finds the adapter's weights.
+func (a Adapter) Path() (string, error) {
+	if strings.HasPrefix(a.StorageURI, "/") {
+		return a.StorageURI, nil
+	}
+	u, err := url.Parse(a.StorageURI)
+	if err != nil || u.Scheme != "file" || u.Path == "" {
+		return "", fmt.Errorf("%w: storage URI %q must be a file:// URI or an absolute path", ErrInvalidAdapter, a.StorageURI)
+	}
+	return u.Path, nil
+}
+
+// LoRAArgs returns args with the LoRA flags rewritten to serve exactly the
+// given adapters. Other args are kept in order.
+func LoRAArgs(args []string, adapters []Adapter) ([]string, error) {
+	out := make([]string, 0, len(args)+len(adapters)+2)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == argEnableLoRA:
+			continue
+		case arg == argLoRAModules:
+			// Skip the values that follow, up to the next flag.
+			for i+1 < len(args) && !strings.HasPrefix(args[i+1], "--") {
+				i++
+			}
+			continue
+		case strings.HasPrefix(arg, argLoRAModules+"="):
+			continue
+		}
+		out = append(out, arg)
+	}
+	if len(adapters) == 0 {
+		return out, nil
+	}
+	out = append(out, argEnableLoRA, argLoRAModules)
+	for _, a := range adapters {
+		path, err := a.Path()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, a.Name+"="+path)
+	}
+	return out, nil
+}
+
+// WithAdapter returns adapters with a added, replacing any adapter of the same name.
+func WithAdapter(adapters []Adapter, a Adapter) []Adapter {
+	out := slices.DeleteFunc(slices.Clone(adapters), func(x Adapter) bool { return x.Name == a.Name })
+	return append(out, a)
+}
+
+// WithoutAdapter returns adapters without the named adapter.
+func WithoutAdapter(adapters []Adapter, name string) ([]Adapter, bool) {
+	out := slices.DeleteFunc(slices.Clone(adapters), func(x Adapter) bool { return x.Name == name })
+	return out, len(out) != len(adapters)
+}
@@ -0,0 +1,77 @@
+package vllm
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestLoRAArgs(t *testing.T) {
+	sql := Adapter{Name: "sql", StorageURI: "file:///models/sql"}
+	chat := Adapter{Name: "chat", StorageURI: "/models/chat"}
+	tests := []struct {
+		name     string
+		args     []string
+		adapters []Adapter
+		want     []string
+	}{
+		{"adds flags", []string{"--max-model-len", "4096"}, []Adapter{sql, chat},
+			[]string{"--max-model-len", "4096", "--enable-lora", "--lora-modules", "sql=/models/sql", "chat=/models/chat"}},
+		{"replaces existing modules", []string{"--enable-lora", "--lora-modules", "old=/models/old", "other=/models/other", "--max-model-len", "4096"}, []Adapter{sql},
+			[]string{"--max-model-len", "4096", "--enable-lora", "--lora-modules", "sql=/models/sql"}},
+		{"replaces the = form", []string{"--lora-modules=old=/models/old", "--enable-lora"}, []Adapter{chat},
+			[]string{"--enable-lora", "--lora-modules", "chat=/models/chat"}},
+		{"removes flags without adapters", []string{"--enable-lora", "--lora-modules", "old=/models/old", "--enforce-eager"}, nil,
+			[]string{"--enforce-eager"}},
+		{"no args", nil, nil, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoRAArgs(tt.args, tt.adapters)
+			if err != nil {
+				t.Fatalf("LoRAArgs() = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("LoRAArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoRAArgsRejectsRemoteStorage(t *testing.T) {
+	_, err := LoRAArgs(nil, []Adapter{{Name: "sql", StorageURI: "s3://bucket/sql"}})
+	if !errors.Is(err, ErrInvalidAdapter) {
+		t.Fatalf("LoRAArgs() = %v, want ErrInvalidAdapter", err)
+	}
+}
+
+func TestAdapterValidate(t *testing.T) {
+	for _, a := range []Adapter{
+		{Name: "", StorageURI: "/models/sql"},
+		{Name: "-sql", StorageURI: "/models/sql"},
+		{Name: "sql", StorageURI: "models/sql"},
+		{Name: "sql", StorageURI: "file://"},
+	} {
+		if err := a.Validate(); !errors.Is(err, ErrInvalidAdapter) {
+			t.Errorf("Validate(%+v) = %v, want ErrInvalidAdapter", a, err)
+		}
+	}
+	if err := (Adapter{Name: "sql-v1.2", StorageURI: "file:///models/sql"}).Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+}
+
+func TestWithAdapter(t *testing.T) {
+	adapters := []Adapter{{Name: "sql", StorageURI: "/a"}, {Name: "chat", StorageURI: "/b"}}
+	got := WithAdapter(adapters, Adapter{Name: "sql", StorageURI: "/c"})
+	if len(got) != 2 || got[1].StorageURI != "/c" || adapters[0].StorageURI != "/a" {
+		t.Fatalf("WithAdapter() = %+v, input %+v", got, adapters)
+	}
+	got, ok := WithoutAdapter(got, "chat")
+	if !ok || len(got) != 1 || got[0].Name != "sql" {
+		t.Fatalf("WithoutAdapter() = %+v, %v", got, ok)
+	}
+	if _, ok := WithoutAdapter(got, "missing"); ok {
+		t.Fatal("WithoutAdapter() removed a missing adapter")
+	}
+}
@@ -0,0 +1,245 @@
+package vllm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Defaults applied to new VLLM resources by Create and the mutating webhook.
+const (
+	DefaultPort            = 8000
+	DefaultImageRegistry   = "docker.io"
+	DefaultImageName       = "lmcache/vllm-openai:2025-05-27-v1"
+	DefaultImagePullPolicy = "IfNotPresent"
+	DefaultHFHome          = "/data"
+)
+
+// ResourceGPU is the extended resource GPUs are requested under.
+const ResourceGPU = "nvidia.com/gpu"
+
+// DefaultSpec fills in what a VLLM spec leaves out: runtimeName (the resource
+// name), replicas, the engine port, the runtime image and HF_HOME. It reports
+// whether anything changed.
+func DefaultSpec(name string, spec map[string]interface{}) bool {
+	changed := false
+	setDefault := func(value interface{}, fields ...string) {
+		if _, found, _ := unstructured.NestedFieldNoCopy(spec, fields...); !found {
+			_ = unstructured.SetNestedField(spec, value, fields...)
+			changed = true
+		}
+	}
+	setDefault(name, "runtimeName")
+	setDefault(int64(1), "replicas")
+	setDefault(int64(DefaultPort), "vllmConfig", "port")
+	setDefault(DefaultImageRegistry, "deploymentConfig", "image", "registry")
+	setDefault(DefaultImageName, "deploymentConfig", "image", "name")
+	setDefault(DefaultImagePullPolicy, "deploymentConfig", "image", "pullPolicy")
+
+	env, _, _ := unstructured.NestedSlice(spec, "vllmConfig", "env")
+	for _, e := range env {
+		if m, ok := e.(map[string]interface{}); ok && m["name"] == "HF_HOME" {
+			return changed
+		}
+	}
+	env = append(env, map[string]interface{}{"name": "HF_HOME", "value": DefaultHFHome})
+	_ = unstructured.SetNestedSlice(spec, env, "vllmConfig", "env")
+	return true
+}
+
+// ValidateSpec checks the parts of a VLLM spec the CRD schema leaves
+// free-form: engine args against each other and against the GPUs requested,
+// device requests, the port and the storage URI.
+func ValidateSpec(spec map[string]interface{}) field.ErrorList {
+	var errs field.ErrorList
+	root := field.NewPath("spec")
+
+	if model, _, _ := unstructured.NestedString(spec, "model"); model == "" {
+		errs = append(errs, field.Required(root.Child("model"), ""))
+	}
+	if replicas, found, err := unstructured.NestedFieldNoCopy(spec, "replicas"); found {
+		if n, ok := intOf(replicas); !ok || n < 0 || err != nil {
+			errs = append(errs, field.Invalid(root.Child("replicas"), replicas, "must be a non-negative integer"))
+		}
+	}
+	if port, found, _ := unstructured.NestedFieldNoCopy(spec, "vllmConfig", "port"); found {
+		if n, ok := intOf(port); !ok || n < 1 || n > 65535 {
+			errs = append(errs, field.Invalid(root.Child("vllmConfig", "port"), port, "must be between 1 and 65535"))
+		}
+	}
+	if uri, _, _ := unstructured.NestedString(spec, "storageUri"); uri != "" {
+		if _, err := ParseStorageURI(uri); err != nil {
+			errs = append(errs, field.Invalid(root.Child("storageUri"), uri, err.Error()))
+		}
+	}
+
+	gpus, gpuErrs := gpuLimit(spec, root.Child("deploymentConfig", "resources", "limits").Key(ResourceGPU))
+	errs = append(errs, gpuErrs...)
+	errs = append(errs, validateDeviceRequests(spec, gpus, root.Child("deploymentConfig", "deviceRequests"))...)
+
+	args, _, err := unstructured.NestedStringSlice(spec, "args")
+	if err != nil {
+		return append(errs, field.Invalid(root.Child("args"), spec["args"], "must be a list of strings"))
+	}
+	return append(errs, validateArgs(args, gpus, root.Child("args"))...)
+}
+
+// booleanArgs are the vLLM engine flags that take no value, so the argument
+// after one of them is never its value.
+var booleanArgs = map[string]bool{
+	"--disable-async-output-proc":        true,
+	"--disable-cascade-attn":             true,
+	"--disable-custom-all-reduce":        true,
+	"--disable-fastapi-docs":             true,
+	"--disable-frontend-multiprocessing": true,
+	"--disable-log-requests":             true,
+	"--disable-log-stats":                true,
+	"--disable-sliding-window":           true,
+	"--enable-auto-tool-choice":          true,
+	"--enable-chunked-prefill":           true,
+	"--enable-expert-parallel":           true,
+	"--enable-log-requests":              true,
+	"--enable-lora":                      true,
+	"--enable-prefix-caching":            true,
+	"--enable-prompt-tokens-details":     true,
+	"--enable-request-id-headers":        true,
+	"--enable-server-load-tracking":      true,
+	"--enable-sleep-mode":                true,
+	"--enforce-eager":                    true,
+	"--no-enable-chunked-prefill":        true,
+	"--no-enable-prefix-caching":         true,
+	"--return-tokens-as-token-ids":       true,
+	"--skip-tokenizer-init":              true,
+	"--trust-remote-code":                true,
+}
+
+// repeatableArgs are the append-style flags vLLM accepts more than once, each
+// time adding to the list.
+var repeatableArgs = map[string]bool{
+	"--allowed-local-media-path": true,
+	"--lora-modules":             true,
+	"--middleware":               true,
+	"--prompt-adapters":          true,
+}
+
+// validateArgs rejects repeated flags other than the append-style ones, a
+// memory fraction outside (0, 1] and more parallel ranks than GPUs. gpus is -1
+// when no GPU limit is set.
+func validateArgs(args []string, gpus int64, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	seen := map[string]int{}
+	values := map[string]string{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "--") {
+			continue
+		}
+		flag, value, hasValue := strings.Cut(arg, "=")
+		if !hasValue && !booleanArgs[flag] && i+1 < len(args) && !strings.HasPrefix(args[i+1], "--") {
+			value = args[i+1]
+		}
+		if repeatableArgs[flag] {
+			continue
+		}
+		if first, dup := seen[flag]; dup {
+			errs = append(errs, field.Duplicate(path.Index(i), fmt.Sprintf("%s (first at index %d)", flag, first)))
+			continue
+		}
+		seen[flag], values[flag] = i, value
+	}
+
+	if v, ok := values["--gpu-memory-utilization"]; ok {
+		if f, err := strconv.ParseFloat(v, 64); err != nil || f <= 0 || f > 1 {
+			errs = append(errs, field.Invalid(path.Index(seen["--gpu-memory-utilization"]), v, "--gpu-memory-utilization must be in (0, 1]"))
+		}
+	}
+	ranks := int64(1)
+	for _, flag := range []string{"--tensor-parallel-size", "--pipeline-parallel-size"} {
+		v, ok := values[flag]
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 1 {
+			errs = append(errs, field.Invalid(path.Index(seen[flag]), v, flag+" must be a positive integer"))
+			continue
+		}
+		ranks *= n
+	}
+	if gpus >= 0 && ranks > gpus {
+		errs = append(errs, field.Invalid(path, ranks, fmt.Sprintf("tensor × pipeline parallel size needs %d GPUs but the %s limit is %d", ranks, ResourceGPU, gpus)))
+	}
+	return errs
+}
+
+// gpuLimit returns the GPU limit, or -1 when there is none.
+func gpuLimit(spec map[string]interface{}, path *field.Path) (int64, field.ErrorList) {
+	limits, _, _ := unstructured.NestedMap(spec, "deploymentConfig", "resources", "limits")
+	v, ok := limits[ResourceGPU]
+	if !ok {
+		return -1, nil
+	}
+	q, err := resource.ParseQuantity(fmt.Sprint(v))
+	if err != nil {
+		return -1, field.ErrorList{field.Invalid(path, v, "must be a quantity")}
+	}
+	n, ok := q.AsInt64()
+	if !ok || n < 0 {
+		return -1, field.ErrorList{field.Invalid(path, v, "must be a whole number of GPUs")}
+	}
+	return n, nil
+}
+
+// validateDeviceRequests checks that each request's count matches its device
+// IDs and that together they match the GPU limit.
+func validateDeviceRequests(spec map[string]interface{}, gpus int64, path *field.Path) field.ErrorList {
+	requests, found, err := unstructured.NestedSlice(spec, "deploymentConfig", "deviceRequests")
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, nil, "must be a list")}
+	}
+	if !found {
+		return nil
+	}
+	var errs field.ErrorList
+	var total int64
+	for i, r := range requests {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			errs = append(errs, field.Invalid(path.Index(i), r, "must be an object"))
+			continue
+		}
+		ids, _, _ := unstructured.NestedSlice(m, "deviceIDs")
+		count, hasCount := intOf(m["count"])
+		switch {
+		case hasCount && len(ids) > 0 && count != int64(len(ids)):
+			errs = append(errs, field.Invalid(path.Index(i).Child("count"), count, fmt.Sprintf("does not match the %d deviceIDs", len(ids))))
+		case !hasCount:
+			count = int64(len(ids))
+		}
+		total += count
+	}
+	if gpus >= 0 && total > 0 && total != gpus {
+		errs = append(errs, field.Invalid(path, total, fmt.Sprintf("requests %d devices but the %s limit is %d", total, ResourceGPU, gpus)))
+	}
+	return errs
+}
+
+// intOf returns v as an integer if it is a whole JSON number.
+func intOf(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case int32:
+		return int64(n), true
+	case int:
+		return int64(n), true
+	case float64:
+		return int64(n), n == float64(int64(n))
+	default:
+		return 0, false
+	}
+}
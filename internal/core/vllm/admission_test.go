@@ -0,0 +1,152 @@
+package vllm
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		gpus int64
+		want []string
+	}{
+		{"valid", []string{"--tensor-parallel-size", "2", "--gpu-memory-utilization=0.9"}, 2, nil},
+		{"boolean flag before a positional", []string{"--enforce-eager", "meta-llama/Llama-3.1-8B", "--tensor-parallel-size", "2"}, 2, nil},
+		{"boolean flag is not given a value", []string{"--trust-remote-code", "0.5", "--gpu-memory-utilization", "0.9"}, -1, nil},
+		{"repeated lora modules", []string{"--lora-modules", "sql=/a", "--enable-lora", "--lora-modules", "chat=/b"}, -1, nil},
+		{"repeated flag", []string{"--max-model-len", "4096", "--max-model-len=8192"}, -1, []string{"--max-model-len (first at index 0)"}},
+		{"repeated boolean flag", []string{"--enforce-eager", "--enforce-eager"}, -1, []string{"--enforce-eager"}},
+		{"memory fraction above 1", []string{"--gpu-memory-utilization", "1.5"}, -1, []string{"--gpu-memory-utilization must be in (0, 1]"}},
+		{"memory fraction not a number", []string{"--gpu-memory-utilization=most"}, -1, []string{"--gpu-memory-utilization must be in (0, 1]"}},
+		{"bad parallel size", []string{"--tensor-parallel-size", "0"}, -1, []string{"--tensor-parallel-size must be a positive integer"}},
+		{"more ranks than GPUs", []string{"--tensor-parallel-size", "4", "--pipeline-parallel-size", "2"}, 4, []string{"needs 8 GPUs"}},
+		{"no GPU limit", []string{"--tensor-parallel-size", "8"}, -1, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateArgs(tt.args, tt.gpus, field.NewPath("spec", "args"))
+			assertFieldErrors(t, errs, tt.want)
+		})
+	}
+}
+
+func TestValidateSpec(t *testing.T) {
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"model":      "meta-llama/Llama-3.1-8B",
+			"replicas":   int64(1),
+			"vllmConfig": map[string]interface{}{"port": int64(8000)},
+			"deploymentConfig": map[string]interface{}{
+				"resources": map[string]interface{}{"limits": map[string]interface{}{ResourceGPU: "2"}},
+			},
+			"args": []interface{}{"--tensor-parallel-size", "2"},
+		}
+	}
+	tests := []struct {
+		name   string
+		modify func(spec map[string]interface{})
+		want   []string
+	}{
+		{"valid", func(map[string]interface{}) {}, nil},
+		{"no model", func(s map[string]interface{}) { delete(s, "model") }, []string{"spec.model: Required"}},
+		{"negative replicas", func(s map[string]interface{}) { s["replicas"] = int64(-1) }, []string{"spec.replicas"}},
+		{"fractional replicas", func(s map[string]interface{}) { s["replicas"] = 1.5 }, []string{"spec.replicas"}},
+		{"port out of range", func(s map[string]interface{}) {
+			unstructured.SetNestedField(s, int64(70000), "vllmConfig", "port")
+		}, []string{"spec.vllmConfig.port"}},
+		{"bad storage URI", func(s map[string]interface{}) { s["storageUri"] = "gs://weights/llama" }, []string{"spec.storageUri"}},
+		{"args not strings", func(s map[string]interface{}) { s["args"] = []interface{}{int64(1)} }, []string{"spec.args"}},
+		{"more ranks than GPUs", func(s map[string]interface{}) {
+			s["args"] = []interface{}{"--tensor-parallel-size", "4"}
+		}, []string{"needs 4 GPUs"}},
+		{"device requests disagree with the limit", func(s map[string]interface{}) {
+			unstructured.SetNestedSlice(s, []interface{}{map[string]interface{}{"deviceIDs": []interface{}{"0", "1", "2"}}}, "deploymentConfig", "deviceRequests")
+		}, []string{"requests 3 devices"}},
+		{"device count disagrees with IDs", func(s map[string]interface{}) {
+			unstructured.SetNestedSlice(s, []interface{}{map[string]interface{}{"count": int64(2), "deviceIDs": []interface{}{"0"}}}, "deploymentConfig", "deviceRequests")
+		}, []string{"does not match the 1 deviceIDs"}},
+		{"fractional GPUs", func(s map[string]interface{}) {
+			unstructured.SetNestedField(s, "500m", "deploymentConfig", "resources", "limits", ResourceGPU)
+		}, []string{"whole number of GPUs"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := valid()
+			tt.modify(spec)
+			assertFieldErrors(t, ValidateSpec(spec), tt.want)
+		})
+	}
+}
+
+// assertFieldErrors checks that errs has one error per want, each containing
+// its substring.
+func assertFieldErrors(t *testing.T, errs field.ErrorList, want []string) {
+	t.Helper()
+	if len(errs) != len(want) {
+		t.Fatalf("got errors %v, want %d matching %q", errs, len(want), want)
+	}
+	for i, w := range want {
+		if !strings.Contains(errs[i].Error(), w) {
+			t.Errorf("error %d = %q, want it to contain %q", i, errs[i].Error(), w)
+		}
+	}
+}
+
+func TestDefaultSpec(t *testing.T) {
+	spec := map[string]interface{}{"model": "meta-llama/Llama-3.1-8B"}
+	if !DefaultSpec("llama", spec) {
+		t.Fatal("DefaultSpec() = false on an empty spec")
+	}
+	for _, tt := range []struct {
+		fields []string
+		want   interface{}
+	}{
+		{[]string{"runtimeName"}, "llama"},
+		{[]string{"replicas"}, int64(1)},
+		{[]string{"vllmConfig", "port"}, int64(DefaultPort)},
+		{[]string{"deploymentConfig", "image", "registry"}, DefaultImageRegistry},
+		{[]string{"deploymentConfig", "image", "name"}, DefaultImageName},
+		{[]string{"deploymentConfig", "image", "pullPolicy"}, DefaultImagePullPolicy},
+	} {
+		if got, _, _ := unstructured.NestedFieldNoCopy(spec, tt.fields...); got != tt.want {
+			t.Errorf("spec.%s = %v, want %v", strings.Join(tt.fields, "."), got, tt.want)
+		}
+	}
+	env, _, _ := unstructured.NestedSlice(spec, "vllmConfig", "env")
+	if len(env) != 1 || env[0].(map[string]interface{})["value"] != DefaultHFHome {
+		t.Errorf("spec.vllmConfig.env = %v, want HF_HOME", env)
+	}
+	if ValidateSpec(spec) != nil {
+		t.Errorf("defaulted spec is invalid: %v", ValidateSpec(spec))
+	}
+	if DefaultSpec("llama", spec) {
+		t.Error("DefaultSpec() = true on an already defaulted spec")
+	}
+}
+
+func TestDefaultSpecKeepsSetFields(t *testing.T) {
+	spec := map[string]interface{}{
+		"runtimeName": "custom",
+		"replicas":    int64(0),
+		"vllmConfig": map[string]interface{}{
+			"port": int64(9000),
+			"env":  []interface{}{map[string]interface{}{"name": "HF_HOME", "value": "/cache"}},
+		},
+	}
+	DefaultSpec("llama", spec)
+	if spec["runtimeName"] != "custom" || spec["replicas"] != int64(0) {
+		t.Errorf("spec = %v, want runtimeName and replicas kept", spec)
+	}
+	if port, _, _ := unstructured.NestedInt64(spec, "vllmConfig", "port"); port != 9000 {
+		t.Errorf("port = %d, want 9000", port)
+	}
+	env, _, _ := unstructured.NestedSlice(spec, "vllmConfig", "env")
+	if len(env) != 1 || env[0].(map[string]interface{})["value"] != "/cache" {
+		t.Errorf("env = %v, want HF_HOME kept", env)
+	}
+}
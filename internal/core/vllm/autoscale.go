@@ -0,0 +1,110 @@
+package vllm
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	AutoscaleMetricQueueDepth   = "queueDepth"
+	AutoscaleMetricKVCacheUsage = "kvCacheUsage"
+
+	ConditionAutoscaling = "Autoscaling"
+
+	ReasonScaledUp      = "ScaledUp"
+	ReasonScaledDown    = "ScaledDown"
+	ReasonAtMaxReplicas = "AtMaxReplicas"
+	ReasonAtMinReplicas = "AtMinReplicas"
+	ReasonCoolingDown   = "CoolingDown"
+	ReasonWithinTarget  = "WithinTarget"
+)
+
+// autoscaleTolerance avoids flapping when the metric hovers around the target.
+const autoscaleTolerance = 0.1
+
+// AutoscalingSpec mirrors spec.autoscaling on a VLLM resource.
+type AutoscalingSpec struct {
+	Enabled           bool
+	MinReplicas       int32
+	MaxReplicas       int32
+	Metric            string
+	Target            float64
+	ScaleUpCooldown   time.Duration
+	ScaleDownCooldown time.Duration
+}
+
+// AutoscaleTarget is a Running VLLM resource with autoscaling enabled.
+type AutoscaleTarget struct {
+	Namespace     string
+	Name          string
+	Model         string
+	Replicas      int32
+	Autoscaling   AutoscalingSpec
+	LastScaleTime time.Time
+	Conditions    []metav1.Condition
+}
+
+type ScaleDecision struct {
+	Replicas int32
+	Reason   string
+	Message  string
+}
+
+func (d ScaleDecision) Scales(current int32) bool {
+	return d.Replicas != current
+}
+
+func (s AutoscalingSpec) Validate() error {
+	if s.MinReplicas < 1 || s.MaxReplicas < s.MinReplicas {
+		return fmt.Errorf("autoscaling requires 1 <= minReplicas <= maxReplicas, got %d..%d", s.MinReplicas, s.MaxReplicas)
+	}
+	if s.Metric != AutoscaleMetricQueueDepth && s.Metric != AutoscaleMetricKVCacheUsage {
+		return fmt.Errorf("unsupported autoscaling metric %q", s.Metric)
+	}
+	if s.Target <= 0 {
+		return fmt.Errorf("autoscaling target must be positive")
+	}
+	return nil
+}
+
+// Decide computes the desired replica count the same way the Horizontal Pod
+// Autoscaler does: desired = ceil(current * observed / target), where observed
+// is the per-replica average across the engines that were scraped, clamped to
+// the bounds and held back while the cooldown for that direction is running.
+func (s AutoscalingSpec) Decide(current int32, stats *EngineStats, lastScale, now time.Time) ScaleDecision {
+	observed := stats.RequestsWaiting / float64(max(stats.Pods, 1))
+	if s.Metric == AutoscaleMetricKVCacheUsage {
+		observed = stats.KVCacheUsage
+	}
+	ratio := observed / s.Target
+	base := max(current, 1)
+
+	desired := current
+	if math.Abs(ratio-1) > autoscaleTolerance {
+		desired = int32(math.Ceil(float64(base) * ratio))
+	}
+	desired = min(max(desired, s.MinReplicas), s.MaxReplicas)
+	metric := fmt.Sprintf("%s %.2f (target %.2f)", s.Metric, observed, s.Target)
+
+	switch {
+	case desired > current:
+		if !lastScale.IsZero() && now.Sub(lastScale) < s.ScaleUpCooldown {
+			return ScaleDecision{current, ReasonCoolingDown, fmt.Sprintf("%s wants %d replicas; scale-up cooldown active", metric, desired)}
+		}
+		return ScaleDecision{desired, ReasonScaledUp, fmt.Sprintf("%s: scaled from %d to %d replicas", metric, current, desired)}
+	case desired < current:
+		if !lastScale.IsZero() && now.Sub(lastScale) < s.ScaleDownCooldown {
+			return ScaleDecision{current, ReasonCoolingDown, fmt.Sprintf("%s wants %d replicas; scale-down cooldown active", metric, desired)}
+		}
+		return ScaleDecision{desired, ReasonScaledDown, fmt.Sprintf("%s: scaled from %d to %d replicas", metric, current, desired)}
+	case ratio > 1+autoscaleTolerance && current >= s.MaxReplicas:
+		return ScaleDecision{current, ReasonAtMaxReplicas, fmt.Sprintf("%s but already at maxReplicas %d", metric, s.MaxReplicas)}
+	case ratio < 1-autoscaleTolerance && current <= s.MinReplicas:
+		return ScaleDecision{current, ReasonAtMinReplicas, fmt.Sprintf("%s but already at minReplicas %d", metric, s.MinReplicas)}
+	default:
+		return ScaleDecision{current, ReasonWithinTarget, metric}
+	}
+}
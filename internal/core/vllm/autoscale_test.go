@@ -0,0 +1,74 @@
+package vllm
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAutoscalingDecide(t *testing.T) {
+	now := time.Now()
+	spec := AutoscalingSpec{
+		Enabled:           true,
+		MinReplicas:       1,
+		MaxReplicas:       4,
+		Metric:            AutoscaleMetricQueueDepth,
+		Target:            2,
+		ScaleUpCooldown:   time.Minute,
+		ScaleDownCooldown: 5 * time.Minute,
+	}
+	kvCache := spec
+	kvCache.Metric, kvCache.Target = AutoscaleMetricKVCacheUsage, 0.5
+
+	tests := []struct {
+		name      string
+		spec      AutoscalingSpec
+		current   int32
+		stats     EngineStats
+		lastScale time.Time
+		want      int32
+		reason    string
+	}{
+		{"scale up", spec, 2, EngineStats{Pods: 2, RequestsWaiting: 8}, time.Time{}, 4, ReasonScaledUp},
+		{"queue depth is per replica", spec, 2, EngineStats{Pods: 2, RequestsWaiting: 4}, time.Time{}, 2, ReasonWithinTarget},
+		{"within tolerance", spec, 2, EngineStats{Pods: 2, RequestsWaiting: 4.2}, time.Time{}, 2, ReasonWithinTarget},
+		{"scale down", spec, 4, EngineStats{Pods: 4, RequestsWaiting: 2}, time.Time{}, 1, ReasonScaledDown},
+		{"scale up cooldown", spec, 2, EngineStats{Pods: 2, RequestsWaiting: 8}, now.Add(-30 * time.Second), 2, ReasonCoolingDown},
+		{"scale up after cooldown", spec, 2, EngineStats{Pods: 2, RequestsWaiting: 8}, now.Add(-2 * time.Minute), 4, ReasonScaledUp},
+		{"scale down cooldown", spec, 4, EngineStats{Pods: 4, RequestsWaiting: 2}, now.Add(-2 * time.Minute), 4, ReasonCoolingDown},
+		{"scale down after cooldown", spec, 4, EngineStats{Pods: 4, RequestsWaiting: 2}, now.Add(-10 * time.Minute), 1, ReasonScaledDown},
+		{"clamped to max", spec, 3, EngineStats{Pods: 3, RequestsWaiting: 30}, time.Time{}, 4, ReasonScaledUp},
+		{"at max", spec, 4, EngineStats{Pods: 4, RequestsWaiting: 40}, time.Time{}, 4, ReasonAtMaxReplicas},
+		{"clamped to min", spec, 2, EngineStats{Pods: 2}, time.Time{}, 1, ReasonScaledDown},
+		{"at min", spec, 1, EngineStats{Pods: 1}, time.Time{}, 1, ReasonAtMinReplicas},
+		{"kv cache usage", kvCache, 2, EngineStats{Pods: 2, KVCacheUsage: 0.9}, time.Time{}, 4, ReasonScaledUp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.spec.Decide(tt.current, &tt.stats, tt.lastScale, now)
+			if d.Replicas != tt.want || d.Reason != tt.reason {
+				t.Fatalf("Decide() = %d replicas (%s: %s), want %d (%s)", d.Replicas, d.Reason, d.Message, tt.want, tt.reason)
+			}
+		})
+	}
+}
+
+func TestAutoscalingValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  AutoscalingSpec
+		valid bool
+	}{
+		{"valid", AutoscalingSpec{MinReplicas: 1, MaxReplicas: 3, Metric: AutoscaleMetricQueueDepth, Target: 5}, true},
+		{"min above max", AutoscalingSpec{MinReplicas: 4, MaxReplicas: 3, Metric: AutoscaleMetricQueueDepth, Target: 5}, false},
+		{"zero min", AutoscalingSpec{MinReplicas: 0, MaxReplicas: 3, Metric: AutoscaleMetricQueueDepth, Target: 5}, false},
+		{"unknown metric", AutoscalingSpec{MinReplicas: 1, MaxReplicas: 3, Metric: "cpu", Target: 5}, false},
+		{"zero target", AutoscalingSpec{MinReplicas: 1, MaxReplicas: 3, Metric: AutoscaleMetricKVCacheUsage}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.spec.Validate(); (err == nil) != tt.valid {
+				t.Fatalf("Validate() = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package vllm
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var ErrInvalidBatch = errors.New("invalid batch")
+
+const (
+	// DefaultBatchConcurrency is how many runtimes a batch changes at once
+	// unless asked otherwise.
+	DefaultBatchConcurrency = 4
+	// MaxBatchConcurrency bounds the concurrency a batch may ask for.
+	MaxBatchConcurrency = 32
+)
+
+// BatchRequest selects the VLLM resources of one namespace a batch applies
+// to, either by name or by label selector, and says how to run it.
+type BatchRequest struct {
+	Namespace string
+	Names     []string
+	Selector  string
+	// Concurrency bounds how many runtimes change at once; zero means
+	// DefaultBatchConcurrency.
+	Concurrency int
+	// Atomic reverts every change the batch made once any item fails, and
+	// stops starting new items.
+	Atomic bool
+	DryRun bool
+}
+
+// Validate checks the request and fills in the default concurrency.
+func (r *BatchRequest) Validate() error {
+	if r.Namespace == "" {
+		return fmt.Errorf("%w: namespace is required", ErrInvalidBatch)
+	}
+	if (len(r.Names) == 0) == (r.Selector == "") {
+		return fmt.Errorf("%w: exactly one of names and selector is required", ErrInvalidBatch)
+	}
+	if r.Selector != "" {
+		if _, err := labels.Parse(r.Selector); err != nil {
+			return fmt.Errorf("%w: selector: %v", ErrInvalidBatch, err)
+		}
+	}
+	for i, name := range r.Names {
+		if name == "" {
+			return fmt.Errorf("%w: names[%d] is empty", ErrInvalidBatch, i)
+		}
+		if slices.Contains(r.Names[:i], name) {
+			return fmt.Errorf("%w: %q is listed twice", ErrInvalidBatch, name)
+		}
+	}
+	switch {
+	case r.Concurrency == 0:
+		r.Concurrency = DefaultBatchConcurrency
+	case r.Concurrency < 0 || r.Concurrency > MaxBatchConcurrency:
+		return fmt.Errorf("%w: concurrency must be between 1 and %d", ErrInvalidBatch, MaxBatchConcurrency)
+	}
+	return nil
+}
+
+// BatchTarget is a VLLM resource selected by a batch.
+type BatchTarget struct {
+	Name string
+	// Running is false when the resource asks for the runtime to be stopped.
+	Running bool
+}
+
+// BatchOutcome is what a batch did to one runtime.
+type BatchOutcome string
+
+const (
+	BatchSucceeded BatchOutcome = "Succeeded"
+	// BatchUnchanged marks a runtime already in the requested state.
+	BatchUnchanged BatchOutcome = "Unchanged"
+	BatchFailed    BatchOutcome = "Failed"
+	// BatchSkipped marks a runtime an atomic batch did not get to before
+	// another item failed.
+	BatchSkipped BatchOutcome = "Skipped"
+	// BatchReverted marks a change an atomic batch undid.
+	BatchReverted BatchOutcome = "Reverted"
+)
+
+// BatchItem is the result for one runtime of a batch.
+type BatchItem struct {
+	Name    string
+	Outcome BatchOutcome
+	Change  *SpecChange
+	Err     error
+	// RevertErr is why an atomic batch could not undo the change; the item
+	// then stays Succeeded.
+	RevertErr error
+	// OperationID names the operation following a started or updated
+	// runtime, if one was recorded.
+	OperationID string
+}
+
+// BatchResult is the per-runtime outcome of a batch, in the order the
+// runtimes were named or, for a selector, by name.
+type BatchResult struct {
+	Action string
+	Items  []BatchItem
+	// Reverted is true when an atomic batch failed and undid its changes.
+	Reverted bool
+}
+
+// Failed counts the items that failed.
+func (r *BatchResult) Failed() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Outcome == BatchFailed {
+			n++
+		}
+	}
+	return n
+}
+
+// RevertSpec returns the merge patch that takes a spec from after back to
+// before: fields after added are removed and fields it changed or removed get
+// their old value.
+func RevertSpec(before, after map[string]interface{}) map[string]interface{} {
+	patch := map[string]interface{}{}
+	for k, a := range after {
+		b, ok := before[k]
+		if !ok {
+			patch[k] = nil
+			continue
+		}
+		bm, bok := b.(map[string]interface{})
+		am, aok := a.(map[string]interface{})
+		if bok && aok {
+			if nested := RevertSpec(bm, am); len(nested) > 0 {
+				patch[k] = nested
+			}
+			continue
+		}
+		if !reflect.DeepEqual(a, b) {
+			patch[k] = b
+		}
+	}
+	for k, b := range before {
+		if _, ok := after[k]; !ok {
+			patch[k] = b
+		}
+	}
+	return patch
+}
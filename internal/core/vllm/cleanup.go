@@ -0,0 +1,18 @@
+package vllm
+
+// FinalizerCleanup holds a deleted VLLM resource until the control plane has
+// deregistered the runtime from the router and removed what it owns.
+const FinalizerCleanup = "vllm.ai/cleanup"
+
+// LabelRuntime marks objects that belong to the runtime of the VLLM resource
+// it names. They are deleted with the resource, like objects it owns.
+const LabelRuntime = "vllm.ai/runtime"
+
+// CleanupTarget is a VLLM resource as the cleanup controller sees it.
+type CleanupTarget struct {
+	Namespace    string
+	Name         string
+	UID          string
+	Deleting     bool
+	HasFinalizer bool
+}
@@ -0,0 +1,97 @@
+package vllm
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types kept in status.conditions of a VLLM resource.
+const (
+	// ConditionReady is True while the runtime serves requests.
+	ConditionReady = "Ready"
+	// ConditionProgressing is True while a started runtime is on its way to Ready.
+	ConditionProgressing = "Progressing"
+	// ConditionDegraded is True when the runtime cannot become ready on its own.
+	ConditionDegraded = "Degraded"
+	// ConditionScheduled is True once every runtime pod is placed on a node.
+	ConditionScheduled = "Scheduled"
+	// ConditionModelLoaded is True once the engine lists the model.
+	ConditionModelLoaded = "ModelLoaded"
+)
+
+// Condition reasons set by the control plane.
+const (
+	ReasonStopped             = "Stopped"
+	ReasonStartRequested      = "ModelStartRequested"
+	ReasonRuntimeFailed       = "RuntimeFailed"
+	ReasonAsExpected          = "AsExpected"
+	ReasonServing             = "Serving"
+	ReasonPodsScheduled       = "PodsScheduled"
+	ReasonModelServed         = "ModelServed"
+	ReasonWaitingForResources = "WaitingForResources"
+)
+
+// ConditionTarget is a VLLM resource whose conditions the control plane keeps
+// up to date.
+type ConditionTarget struct {
+	Namespace  string
+	Name       string
+	Generation int64
+	// Running is false when the resource asks for the runtime to be stopped.
+	Running    bool
+	Conditions []metav1.Condition
+}
+
+// ReadinessConditions derives the full condition set from one readiness
+// check of a started runtime.
+func ReadinessConditions(p ReadinessProgress, generation int64) []metav1.Condition {
+	cond := func(t string, status bool, reason, message string) metav1.Condition {
+		s := metav1.ConditionFalse
+		if status {
+			s = metav1.ConditionTrue
+		}
+		return metav1.Condition{Type: t, Status: s, Reason: reason, Message: message, ObservedGeneration: generation}
+	}
+	ready := p.Phase == ReadinessReady
+	scheduled := p.Phase != ReadinessScheduling
+
+	conds := []metav1.Condition{
+		cond(ConditionDegraded, false, ReasonAsExpected, ""),
+	}
+	if ready {
+		return append(conds,
+			cond(ConditionReady, true, ReasonServing, p.Message),
+			cond(ConditionProgressing, false, ReasonServing, p.Message),
+			cond(ConditionScheduled, true, ReasonPodsScheduled, ""),
+			cond(ConditionModelLoaded, true, ReasonModelServed, ""),
+		)
+	}
+	conds = append(conds,
+		cond(ConditionReady, false, string(p.Phase), p.Message),
+		cond(ConditionProgressing, true, string(p.Phase), p.Message),
+	)
+	if scheduled {
+		conds = append(conds, cond(ConditionScheduled, true, ReasonPodsScheduled, ""))
+	} else {
+		conds = append(conds, cond(ConditionScheduled, false, ReasonWaitingForResources, p.Message))
+	}
+	return append(conds, cond(ConditionModelLoaded, false, string(p.Phase), p.Message))
+}
+
+// StoppedConditions is the condition set of a runtime that was asked to stop.
+func StoppedConditions(generation int64) []metav1.Condition {
+	conds := make([]metav1.Condition, 0, 5)
+	for _, t := range []string{ConditionReady, ConditionProgressing, ConditionDegraded, ConditionScheduled, ConditionModelLoaded} {
+		conds = append(conds, metav1.Condition{Type: t, Status: metav1.ConditionFalse, Reason: ReasonStopped, ObservedGeneration: generation})
+	}
+	return conds
+}
+
+// FailedConditions is the condition set of a runtime that cannot become ready
+// without intervention.
+func FailedConditions(message string, generation int64) []metav1.Condition {
+	return []metav1.Condition{
+		{Type: ConditionReady, Status: metav1.ConditionFalse, Reason: ReasonRuntimeFailed, Message: message, ObservedGeneration: generation},
+		{Type: ConditionProgressing, Status: metav1.ConditionFalse, Reason: ReasonRuntimeFailed, Message: message, ObservedGeneration: generation},
+		{Type: ConditionDegraded, Status: metav1.ConditionTrue, Reason: ReasonRuntimeFailed, Message: message, ObservedGeneration: generation},
+	}
+}
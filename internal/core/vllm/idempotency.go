@@ -0,0 +1,96 @@
+package vllm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// IdempotencyKeyHeader carries the key of a retried request, like the
+	// idempotency_key request field.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses that repeat the result of
+	// an earlier request with the same key.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	// DefaultIdempotencyWindow is how long a key is remembered unless
+	// configured otherwise.
+	DefaultIdempotencyWindow = 24 * time.Hour
+	// IdempotencyLease is how long a request may hold its key before a retry
+	// assumes the server handling it went away. It outlasts the longest
+	// readiness wait.
+	IdempotencyLease = 40 * time.Minute
+	// maxIdempotencyKeyLength bounds keys like the usual HTTP APIs do.
+	maxIdempotencyKeyLength = 255
+)
+
+// Where idempotency keys are remembered.
+const (
+	IdempotencyStoreMemory     = "memory"
+	IdempotencyStoreConfigMaps = "configmaps"
+)
+
+var (
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	// ErrIdempotencyKeyReused is returned when a key comes back with a
+	// different request than the one it was first used for.
+	ErrIdempotencyKeyReused = errors.New("idempotency key was used for a different request")
+	// ErrRequestInProgress is returned for a retry that arrives while the
+	// first request with its key is still being handled.
+	ErrRequestInProgress = errors.New("a request with this idempotency key is in progress")
+)
+
+// ValidateIdempotencyKey checks that key is 1 to 255 printable ASCII
+// characters.
+func ValidateIdempotencyKey(key string) error {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return fmt.Errorf("%w: must be 1 to %d characters", ErrInvalidIdempotencyKey, maxIdempotencyKeyLength)
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return fmt.Errorf("%w: must be printable ASCII", ErrInvalidIdempotencyKey)
+		}
+	}
+	return nil
+}
+
+// Fingerprint hashes the parts of a request, so a retry can be told apart
+// from a different request reusing its key.
+func Fingerprint(parts ...[]byte) string {
+	h := sha256.New()
+	for _, p := range parts {
+		fmt.Fprintf(h, "%d:", len(p))
+		h.Write(p)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// IdempotencyRecord remembers a request made with an idempotency key and,
+// once it succeeded, its response. Keys are scoped to a namespace.
+type IdempotencyRecord struct {
+	Key       string `json:"key"`
+	Namespace string `json:"namespace"`
+	// Resource is the VLLM resource the request changes, or empty for a batch.
+	Resource    string `json:"resource"`
+	Action      string `json:"action"`
+	Fingerprint string `json:"fingerprint"`
+	// Done is set once the request succeeded and Response holds its result,
+	// encoded by whoever served it.
+	Done      bool      `json:"done,omitempty"`
+	Response  []byte    `json:"response,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Expired reports whether the record no longer guards its key.
+func (r *IdempotencyRecord) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// Held reports whether the record keeps other requests with its key from
+// running: it is unexpired and either done or still within its lease.
+func (r *IdempotencyRecord) Held(now time.Time) bool {
+	return !r.Expired(now) && (r.Done || now.Sub(r.CreatedAt) < IdempotencyLease)
+}
@@ -1,7 +1,9 @@
 package vllm
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -14,18 +16,54 @@ const (
 	StatusUpdating Status = "Updating"
 	StatusFailed   Status = "Failed"
 	StatusPending  Status = "Pending"
+	StatusDeleting Status = "Deleting"
 )
 
 const (
 	ActionStart  = "start"
 	ActionStop   = "stop"
+	ActionCreate = "create"
 	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+var (
+	ErrRuntimeNotFound = errors.New("VLLM resource not found")
+	ErrRuntimeExists   = errors.New("VLLM resource already exists")
+	ErrInvalidSpec     = errors.New("invalid VLLM spec")
+	// ErrWorkloadNotOwned is returned when a Deployment, Service or
+	// PodDisruptionBudget named after a runtime exists but is managed elsewhere.
+	ErrWorkloadNotOwned = errors.New("workload object is not controlled by the VLLM resource")
 )
 
 type VLLMResource struct {
-	Name  string
-	Model string
-	Phase string
+	Name        string
+	RuntimeName string
+	Model       string
+	Phase       string
+	Conditions  []metav1.Condition
+	Stats       *EngineStats
+	// NextTransition is the next scheduled start or stop, if any.
+	NextTransition *ScheduledTransition
+}
+
+// EngineStats is a snapshot of a runtime's load, aggregated across its
+// engines. Request counts and token rates are totals, KV cache usage is the
+// mean per engine, and rates and TTFT cover the interval since the previous
+// scrape.
+type EngineStats struct {
+	// Pods is how many engines answered the scrape.
+	Pods                      int
+	KVCacheUsage              float64
+	RequestsRunning           float64
+	RequestsWaiting           float64
+	PromptTokensPerSecond     float64
+	GenerationTokensPerSecond float64
+	TTFTSeconds               float64
+	// ErrorRate is the fraction of requests finished during the interval that
+	// the engine aborted.
+	ErrorRate float64
+	ScrapedAt time.Time
 }
 
 type VLLMUseCase struct {
@@ -33,6 +71,11 @@ type VLLMUseCase struct {
 	Status      Status
 	Namespace   string
 	RuntimeName string
+	// Resource is the name of the VLLM resource the last action was applied to.
+	Resource string
+	// Change is what the last action changed, or on a dry run what it would
+	// have changed.
+	Change *SpecChange
 }
 
 // VLLMStatus represents the status of a VLLM CR
@@ -40,24 +83,28 @@ type VLLMStatus struct {
 	Phase         string
 	Message       string
 	StartTime     metav1.Time
-	Condition     VLLMCondition
+	Conditions    []metav1.Condition
 	ReadyReplicas int
 }
 
-// VLLMCondition represents a status condition
-type VLLMCondition struct {
-	Type               string
-	Status             string
-	LastTransitionTime metav1.Time
-	Reason             string
-	Message            string
+// SpecChange describes what a mutating call did to a VLLM CR. Before is nil
+// when the resource was created.
+type SpecChange struct {
+	Resource string
+	Template string
+	Before   map[string]interface{}
+	After    map[string]interface{}
+	// Object is the whole object as stored, or as the API server rendered it
+	// on a dry run.
+	Object map[string]interface{}
+	DryRun bool
 }
 
 type VLLMCR struct {
-	APIVersion string                 `yaml:"apiVersion"`
-	Kind       string                 `yaml:"kind"`
-	Metadata   map[string]string      `yaml:"metadata"`
-	Spec       map[string]interface{} `yaml:"spec"`
+	APIVersion string                 `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string                 `json:"kind" yaml:"kind"`
+	Metadata   map[string]string      `json:"metadata" yaml:"metadata"`
+	Spec       map[string]interface{} `json:"spec" yaml:"spec"`
 }
 
 func NewVLLM(namespace, runtimeName, model string) *VLLMUseCase {
@@ -0,0 +1,94 @@
+package vllm
+
+import (
+	"errors"
+	"time"
+)
+
+// OperationState is where a long-running operation is in its life.
+type OperationState string
+
+const (
+	OperationRunning   OperationState = "Running"
+	OperationSucceeded OperationState = "Succeeded"
+	OperationFailed    OperationState = "Failed"
+	OperationCancelled OperationState = "Cancelled"
+)
+
+var (
+	ErrOperationNotFound = errors.New("operation not found")
+	ErrOperationDone     = errors.New("operation already finished")
+)
+
+// Error codes of failed operations, named like the Connect codes they map to.
+const (
+	CodeFailedPrecondition = "failed_precondition"
+	CodeDeadlineExceeded   = "deadline_exceeded"
+	CodeAborted            = "aborted"
+	CodeInternal           = "internal"
+)
+
+// OperationError is why an operation failed.
+type OperationError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *OperationError) Error() string {
+	return e.Message
+}
+
+// OperationErrorOf classifies err, returned while waiting for a runtime to
+// become ready.
+func OperationErrorOf(err error) *OperationError {
+	code := CodeInternal
+	switch {
+	case errors.Is(err, ErrRuntimeFailed):
+		code = CodeFailedPrecondition
+	case errors.Is(err, ErrReadinessTimeout):
+		code = CodeDeadlineExceeded
+	}
+	return &OperationError{Code: code, Message: err.Error()}
+}
+
+// Operation follows a start, create or update of a runtime until the runtime
+// serves requests. The change itself is applied before the operation is
+// recorded; the operation reports how the runtime gets on afterwards.
+type Operation struct {
+	ID        string `json:"id"`
+	Namespace string `json:"namespace"`
+	// Resource is the VLLM resource the operation changed.
+	Resource string         `json:"resource"`
+	Action   string         `json:"action"`
+	State    OperationState `json:"state"`
+	Message  string         `json:"message,omitempty"`
+	// Progress lists the readiness phases reached so far.
+	Progress []ReadinessProgress `json:"progress,omitempty"`
+	Error    *OperationError     `json:"error,omitempty"`
+	// Owner is the server replica watching the operation. It refreshes
+	// UpdatedAt while it does, so another replica can take over once it stops.
+	Owner string `json:"owner,omitempty"`
+	// CancelRequested asks the owner to stop watching.
+	CancelRequested bool      `json:"cancelRequested,omitempty"`
+	Deadline        time.Time `json:"deadline"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+	DoneAt          time.Time `json:"doneAt,omitzero"`
+}
+
+// Done reports whether the operation has finished, one way or another.
+func (o *Operation) Done() bool {
+	return o.State != OperationRunning
+}
+
+// Finish moves the operation to a final state.
+func (o *Operation) Finish(state OperationState, message string, opErr *OperationError, now time.Time) {
+	o.State, o.Message, o.Error = state, message, opErr
+	o.DoneAt, o.UpdatedAt = now, now
+}
+
+// Stale reports whether the owner of a running operation has not reported
+// within grace, so another replica should take it over.
+func (o *Operation) Stale(now time.Time, grace time.Duration) bool {
+	return !o.Done() && now.Sub(o.UpdatedAt) > grace
+}
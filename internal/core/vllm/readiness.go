@@ -0,0 +1,31 @@
+package vllm
+
+import (
+	"errors"
+	"time"
+)
+
+// ReadinessPhase is how far a starting runtime has got towards serving.
+type ReadinessPhase string
+
+const (
+	ReadinessScheduling     ReadinessPhase = "Scheduling"
+	ReadinessPullingImage   ReadinessPhase = "PullingImage"
+	ReadinessLoadingWeights ReadinessPhase = "LoadingWeights"
+	ReadinessReady          ReadinessPhase = "Ready"
+)
+
+var (
+	// ErrRuntimeFailed is returned when a runtime can no longer become ready
+	// without intervention, e.g. its phase is Failed or its image is invalid.
+	ErrRuntimeFailed = errors.New("runtime failed")
+	// ErrReadinessTimeout is returned when a runtime did not become ready in time.
+	ErrReadinessTimeout = errors.New("timed out waiting for runtime to become ready")
+)
+
+// ReadinessProgress is one observed step of a runtime becoming ready.
+type ReadinessProgress struct {
+	Phase   ReadinessPhase
+	Message string
+	Time    time.Time
+}
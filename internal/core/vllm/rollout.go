@@ -0,0 +1,179 @@
+package vllm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	ActionRollout  = "rollout"
+	ActionPromote  = "promote"
+	ActionRollback = "rollback"
+)
+
+// LabelRevisionOf is set on every revision created by a rollout and names the
+// resource the revisions descend from, so chained rollouts keep short names.
+const LabelRevisionOf = "vllm.ai/revision-of"
+
+type RolloutStrategy string
+
+const (
+	// RolloutCanary shifts traffic to the new revision in weighted steps.
+	RolloutCanary RolloutStrategy = "Canary"
+	// RolloutBlueGreen switches all traffic at once after the new revision is ready.
+	RolloutBlueGreen RolloutStrategy = "BlueGreen"
+)
+
+type RolloutPhase string
+
+const (
+	RolloutProgressing RolloutPhase = "Progressing"
+	RolloutSucceeded   RolloutPhase = "Succeeded"
+	RolloutRolledBack  RolloutPhase = "RolledBack"
+	RolloutAborted     RolloutPhase = "Aborted"
+)
+
+var (
+	ErrInvalidRollout    = errors.New("invalid rollout")
+	ErrRolloutInProgress = errors.New("a rollout is already in progress")
+	ErrNoRollout         = errors.New("no rollout found")
+)
+
+var defaultCanarySteps = []int32{10, 25, 50, 100}
+
+// RolloutSpec describes how traffic moves from the stable revision to the new one.
+type RolloutSpec struct {
+	Strategy RolloutStrategy
+	// Route is the Gateway API HTTPRoute whose backend weights are shifted.
+	Route string
+	// Steps are the traffic percentages sent to the new revision, ending at 100.
+	Steps []int32
+	// StepDuration is how long each step must stay healthy before the next.
+	StepDuration time.Duration
+	// MaxErrorRate and MaxTTFTSeconds gate every step; zero disables a gate.
+	MaxErrorRate   float64
+	MaxTTFTSeconds float64
+	// ReadyTimeout bounds how long the new revision may take to serve.
+	ReadyTimeout time.Duration
+}
+
+// Default fills in the steps and durations a request left empty.
+func (s *RolloutSpec) Default() {
+	if s.Strategy == "" {
+		s.Strategy = RolloutCanary
+	}
+	if len(s.Steps) == 0 {
+		if s.Strategy == RolloutBlueGreen {
+			s.Steps = []int32{100}
+		} else {
+			s.Steps = append([]int32(nil), defaultCanarySteps...)
+		}
+	}
+	if s.StepDuration == 0 {
+		s.StepDuration = 5 * time.Minute
+	}
+	if s.ReadyTimeout == 0 {
+		s.ReadyTimeout = 30 * time.Minute
+	}
+}
+
+func (s RolloutSpec) Validate() error {
+	if s.Strategy != RolloutCanary && s.Strategy != RolloutBlueGreen {
+		return fmt.Errorf("unsupported rollout strategy %q", s.Strategy)
+	}
+	if s.Route == "" {
+		return fmt.Errorf("rollout requires an HTTPRoute")
+	}
+	if s.Strategy == RolloutBlueGreen && (len(s.Steps) != 1 || s.Steps[0] != 100) {
+		return fmt.Errorf("blue/green rollouts switch all traffic in a single step")
+	}
+	var prev int32
+	for _, w := range s.Steps {
+		if w <= prev || w > 100 {
+			return fmt.Errorf("rollout steps must increase within 1..100, got %v", s.Steps)
+		}
+		prev = w
+	}
+	if prev != 100 {
+		return fmt.Errorf("the last rollout step must be 100, got %v", s.Steps)
+	}
+	if s.MaxErrorRate < 0 || s.MaxErrorRate > 1 {
+		return fmt.Errorf("maxErrorRate must be within 0..1")
+	}
+	return nil
+}
+
+// Rollout is the state of moving a runtime from Stable to Canary. It is stored
+// in status.rollout of the stable resource.
+type Rollout struct {
+	Namespace string
+	Stable    string
+	Canary    string
+	Spec      RolloutSpec
+	// Step indexes Spec.Steps; -1 while waiting for the canary to become ready.
+	Step          int
+	Phase         RolloutPhase
+	Message       string
+	StartedAt     time.Time
+	StepStartedAt time.Time
+}
+
+// Weight is the traffic percentage currently sent to the canary.
+func (r Rollout) Weight() int32 {
+	if r.Step < 0 || r.Step >= len(r.Spec.Steps) {
+		return 0
+	}
+	return r.Spec.Steps[r.Step]
+}
+
+type RolloutAction string
+
+const (
+	RolloutWait     RolloutAction = "Wait"
+	RolloutAdvance  RolloutAction = "Advance"
+	RolloutPromote  RolloutAction = "Promote"
+	RolloutRollBack RolloutAction = "RollBack"
+)
+
+type RolloutDecision struct {
+	Action  RolloutAction
+	Message string
+}
+
+// Decide chooses the next move for a progressing rollout from the canary's
+// readiness and its latest engine stats.
+func (r Rollout) Decide(ready bool, stats *EngineStats, now time.Time) RolloutDecision {
+	if r.Step < 0 {
+		if ready {
+			return RolloutDecision{RolloutAdvance, fmt.Sprintf("%s is ready", r.Canary)}
+		}
+		if now.Sub(r.StartedAt) > r.Spec.ReadyTimeout {
+			return RolloutDecision{RolloutRollBack, fmt.Sprintf("%s did not become ready within %s", r.Canary, r.Spec.ReadyTimeout)}
+		}
+		return RolloutDecision{RolloutWait, fmt.Sprintf("waiting for %s to become ready", r.Canary)}
+	}
+	if stats == nil || !stats.ScrapedAt.After(r.StepStartedAt) {
+		return RolloutDecision{RolloutWait, fmt.Sprintf("waiting for metrics from %s at %d%%", r.Canary, r.Weight())}
+	}
+	if r.Spec.MaxErrorRate > 0 && stats.ErrorRate > r.Spec.MaxErrorRate {
+		return RolloutDecision{RolloutRollBack, fmt.Sprintf("error rate %.3f exceeds %.3f at %d%%", stats.ErrorRate, r.Spec.MaxErrorRate, r.Weight())}
+	}
+	if r.Spec.MaxTTFTSeconds > 0 && stats.TTFTSeconds > r.Spec.MaxTTFTSeconds {
+		return RolloutDecision{RolloutRollBack, fmt.Sprintf("time to first token %.2fs exceeds %.2fs at %d%%", stats.TTFTSeconds, r.Spec.MaxTTFTSeconds, r.Weight())}
+	}
+	if now.Sub(r.StepStartedAt) < r.Spec.StepDuration {
+		return RolloutDecision{RolloutWait, fmt.Sprintf("%s healthy at %d%%", r.Canary, r.Weight())}
+	}
+	if r.Step == len(r.Spec.Steps)-1 {
+		return RolloutDecision{RolloutPromote, fmt.Sprintf("%s healthy at 100%% for %s", r.Canary, r.Spec.StepDuration)}
+	}
+	return RolloutDecision{RolloutAdvance, fmt.Sprintf("%s healthy at %d%% for %s", r.Canary, r.Weight(), r.Spec.StepDuration)}
+}
+
+// BackendWeight is the share of a route's traffic sent to one runtime Service.
+type BackendWeight struct {
+	Service string
+	Port    int32
+	Weight  int32
+}
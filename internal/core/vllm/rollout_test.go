@@ -0,0 +1,76 @@
+package vllm
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRolloutDecide(t *testing.T) {
+	now := time.Now()
+	spec := RolloutSpec{Strategy: RolloutCanary, Route: "llama", MaxErrorRate: 0.05, MaxTTFTSeconds: 2}
+	spec.Default()
+	rollout := func(step int, stepStarted time.Duration) Rollout {
+		return Rollout{
+			Stable:        "llama",
+			Canary:        "llama-r2",
+			Spec:          spec,
+			Step:          step,
+			Phase:         RolloutProgressing,
+			StartedAt:     now.Add(-time.Hour),
+			StepStartedAt: now.Add(-stepStarted),
+		}
+	}
+	fresh := &EngineStats{Pods: 1, ErrorRate: 0.01, TTFTSeconds: 0.5, ScrapedAt: now}
+	notReady := rollout(-1, 0)
+	notReady.StartedAt = now.Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		rollout Rollout
+		ready   bool
+		stats   *EngineStats
+		want    RolloutAction
+	}{
+		{"waits for the canary", notReady, false, nil, RolloutWait},
+		{"canary ready", notReady, true, nil, RolloutAdvance},
+		{"canary never ready", rollout(-1, 0), false, nil, RolloutRollBack},
+		{"no metrics yet", rollout(0, time.Minute), true, nil, RolloutWait},
+		{"metrics older than the step", rollout(0, time.Minute), true, &EngineStats{ScrapedAt: now.Add(-2 * time.Minute)}, RolloutWait},
+		{"error rate too high", rollout(1, time.Minute), true, &EngineStats{ErrorRate: 0.2, ScrapedAt: now}, RolloutRollBack},
+		{"ttft too high", rollout(1, time.Minute), true, &EngineStats{TTFTSeconds: 3, ScrapedAt: now}, RolloutRollBack},
+		{"healthy within the step", rollout(1, time.Minute), true, fresh, RolloutWait},
+		{"healthy step done", rollout(1, 10*time.Minute), true, fresh, RolloutAdvance},
+		{"last step done", rollout(len(spec.Steps)-1, 10*time.Minute), true, fresh, RolloutPromote},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if d := tt.rollout.Decide(tt.ready, tt.stats, now); d.Action != tt.want {
+				t.Fatalf("Decide() = %s (%s), want %s", d.Action, d.Message, tt.want)
+			}
+		})
+	}
+}
+
+func TestRolloutSpecValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  RolloutSpec
+		valid bool
+	}{
+		{"default canary", RolloutSpec{Route: "r"}, true},
+		{"default blue/green", RolloutSpec{Strategy: RolloutBlueGreen, Route: "r"}, true},
+		{"no route", RolloutSpec{}, false},
+		{"steps must increase", RolloutSpec{Route: "r", Steps: []int32{50, 25, 100}}, false},
+		{"steps must end at 100", RolloutSpec{Route: "r", Steps: []int32{10, 50}}, false},
+		{"blue/green in steps", RolloutSpec{Strategy: RolloutBlueGreen, Route: "r", Steps: []int32{50, 100}}, false},
+		{"error rate above 1", RolloutSpec{Route: "r", MaxErrorRate: 2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.Default()
+			if err := tt.spec.Validate(); (err == nil) != tt.valid {
+				t.Fatalf("Validate() = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package vllm
+
+// Router discovery modes of the vLLM production-stack router.
+const (
+	// RouterDiscoveryStatic lists backends in the router's dynamic config.
+	RouterDiscoveryStatic = "static"
+	// RouterDiscoveryKubernetes has the router watch pods by label.
+	RouterDiscoveryKubernetes = "k8s"
+)
+
+// RouterBackend is a runtime as the router sees it: where to send requests and
+// which model they are for.
+type RouterBackend struct {
+	Namespace string
+	Name      string
+	Endpoint  string
+	Model     string
+}
+
+// Key identifies the runtime behind the backend.
+func (b RouterBackend) Key() string {
+	return b.Namespace + "/" + b.Name
+}
+
+// RouterTarget is a VLLM resource whose router registration the control plane
+// keeps in line with whether it serves.
+type RouterTarget struct {
+	RouterBackend
+	// Serving is true while the runtime should run and reports Ready.
+	Serving bool
+}
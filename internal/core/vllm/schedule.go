@@ -0,0 +1,144 @@
+package vllm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// maxMissedTransitions bounds how far Due walks forward when the scheduler has
+// been down for a long time; only the latest missed transition matters.
+const maxMissedTransitions = 10000
+
+// Schedule mirrors spec.schedule on a VLLM resource. Start and Stop are standard
+// five-field cron expressions evaluated in TimeZone; either may be empty.
+type Schedule struct {
+	TimeZone string
+	Start    string
+	Stop     string
+	// Suspend pauses all scheduled transitions until it is cleared.
+	Suspend bool
+	// OverrideUntil skips scheduled transitions up to and including this time,
+	// so a manual start or stop is not undone by the next window.
+	OverrideUntil time.Time
+}
+
+// ScheduledTransition is a start or stop the schedule will issue at Time.
+type ScheduledTransition struct {
+	Action string
+	Time   time.Time
+}
+
+// ScheduleTarget is a VLLM resource with a schedule.
+type ScheduleTarget struct {
+	Namespace string
+	Name      string
+	Model     string
+	Schedule  Schedule
+	// LastScheduleTime is the time of the last transition the scheduler handled.
+	LastScheduleTime time.Time
+	NextTransition   *ScheduledTransition
+}
+
+type compiledSchedule struct {
+	loc   *time.Location
+	start cron.Schedule
+	stop  cron.Schedule
+}
+
+func (s Schedule) compile() (*compiledSchedule, error) {
+	if s.Start == "" && s.Stop == "" {
+		return nil, fmt.Errorf("schedule requires a start or stop expression")
+	}
+	c := &compiledSchedule{loc: time.UTC}
+	if s.TimeZone != "" {
+		loc, err := time.LoadLocation(s.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule time zone %q: %w", s.TimeZone, err)
+		}
+		c.loc = loc
+	}
+	var err error
+	if s.Start != "" {
+		if c.start, err = cron.ParseStandard(s.Start); err != nil {
+			return nil, fmt.Errorf("invalid schedule start %q: %w", s.Start, err)
+		}
+	}
+	if s.Stop != "" {
+		if c.stop, err = cron.ParseStandard(s.Stop); err != nil {
+			return nil, fmt.Errorf("invalid schedule stop %q: %w", s.Stop, err)
+		}
+	}
+	return c, nil
+}
+
+func (s Schedule) Validate() error {
+	_, err := s.compile()
+	return err
+}
+
+// next returns the first transition strictly after t, ignoring Suspend and
+// OverrideUntil. When start and stop fire at the same instant, stop wins.
+func (c *compiledSchedule) next(t time.Time) (ScheduledTransition, bool) {
+	t = t.In(c.loc)
+	var next ScheduledTransition
+	if c.start != nil {
+		if at := c.start.Next(t); !at.IsZero() {
+			next = ScheduledTransition{Action: ActionStart, Time: at}
+		}
+	}
+	if c.stop != nil {
+		if at := c.stop.Next(t); !at.IsZero() && (next.Time.IsZero() || !at.After(next.Time)) {
+			next = ScheduledTransition{Action: ActionStop, Time: at}
+		}
+	}
+	return next, !next.Time.IsZero()
+}
+
+// Next returns the next transition after now that the schedule will actually
+// issue, or nil when it is suspended or has no future transition.
+func (s Schedule) Next(now time.Time) (*ScheduledTransition, error) {
+	c, err := s.compile()
+	if err != nil {
+		return nil, err
+	}
+	if s.Suspend {
+		return nil, nil
+	}
+	from := now
+	if s.OverrideUntil.After(from) {
+		from = s.OverrideUntil
+	}
+	next, ok := c.next(from)
+	if !ok {
+		return nil, nil
+	}
+	next.Time = next.Time.UTC()
+	return &next, nil
+}
+
+// Due returns the latest transition in (last, now] that is not suppressed by
+// Suspend or OverrideUntil. Earlier missed transitions are superseded by it.
+func (s Schedule) Due(last, now time.Time) (*ScheduledTransition, error) {
+	c, err := s.compile()
+	if err != nil {
+		return nil, err
+	}
+	if s.Suspend {
+		return nil, nil
+	}
+	if s.OverrideUntil.After(last) {
+		last = s.OverrideUntil
+	}
+	var due *ScheduledTransition
+	for i := 0; i < maxMissedTransitions; i++ {
+		next, ok := c.next(last)
+		if !ok || next.Time.After(now) {
+			break
+		}
+		next.Time = next.Time.UTC()
+		due, last = &next, next.Time
+	}
+	return due, nil
+}
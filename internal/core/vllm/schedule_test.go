@@ -0,0 +1,112 @@
+package vllm
+
+import (
+	"testing"
+	"time"
+)
+
+// utc parses a "2006-01-02 15:04" time in UTC.
+func utc(t *testing.T, s string) time.Time {
+	t.Helper()
+	at, err := time.Parse("2006-01-02 15:04", s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return at
+}
+
+// officeHours runs weekdays 09:00 to 18:00 in Berlin, which is UTC+2 in the
+// week of 2026-10-19.
+var officeHours = Schedule{TimeZone: "Europe/Berlin", Start: "0 9 * * 1-5", Stop: "0 18 * * 1-5"}
+
+func TestScheduleDue(t *testing.T) {
+	suspended := officeHours
+	suspended.Suspend = true
+	overridden := officeHours
+	overridden.OverrideUntil = utc(t, "2026-10-19 08:00")
+	sameInstant := Schedule{Start: "0 9 * * *", Stop: "0 9 * * *"}
+
+	tests := []struct {
+		name      string
+		schedule  Schedule
+		last, now string
+		action    string
+		at        string
+	}{
+		{"start due", officeHours, "2026-10-19 06:00", "2026-10-19 07:30", ActionStart, "2026-10-19 07:00"},
+		{"nothing due yet", officeHours, "2026-10-19 06:00", "2026-10-19 06:59", "", ""},
+		{"latest missed wins", officeHours, "2026-10-19 06:00", "2026-10-19 17:00", ActionStop, "2026-10-19 16:00"},
+		{"already handled", officeHours, "2026-10-19 07:00", "2026-10-19 07:30", "", ""},
+		{"weekend", officeHours, "2026-10-23 17:00", "2026-10-26 06:00", "", ""},
+		{"suspended", suspended, "2026-10-19 06:00", "2026-10-19 07:30", "", ""},
+		{"overridden", overridden, "2026-10-19 06:00", "2026-10-19 09:00", "", ""},
+		{"stop wins a tie", sameInstant, "2026-10-19 08:00", "2026-10-19 09:30", ActionStop, "2026-10-19 09:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			due, err := tt.schedule.Due(utc(t, tt.last), utc(t, tt.now))
+			if err != nil {
+				t.Fatalf("Due() = %v", err)
+			}
+			if tt.action == "" {
+				if due != nil {
+					t.Fatalf("Due() = %+v, want nothing", due)
+				}
+				return
+			}
+			if due == nil || due.Action != tt.action || !due.Time.Equal(utc(t, tt.at)) {
+				t.Fatalf("Due() = %+v, want %s at %s", due, tt.action, tt.at)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	suspended := officeHours
+	suspended.Suspend = true
+	overridden := officeHours
+	overridden.OverrideUntil = utc(t, "2026-10-19 08:00")
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		action   string
+		at       string
+	}{
+		{"next start", officeHours, ActionStart, "2026-10-19 07:00"},
+		{"skips overridden start", overridden, ActionStop, "2026-10-19 16:00"},
+		{"suspended", suspended, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := tt.schedule.Next(utc(t, "2026-10-18 12:00"))
+			if err != nil {
+				t.Fatalf("Next() = %v", err)
+			}
+			if tt.action == "" {
+				if next != nil {
+					t.Fatalf("Next() = %+v, want nothing", next)
+				}
+				return
+			}
+			if next == nil || next.Action != tt.action || !next.Time.Equal(utc(t, tt.at)) {
+				t.Fatalf("Next() = %+v, want %s at %s", next, tt.action, tt.at)
+			}
+		})
+	}
+}
+
+func TestScheduleValidate(t *testing.T) {
+	for name, s := range map[string]Schedule{
+		"empty":          {},
+		"bad time zone":  {TimeZone: "Mars/Olympus", Start: "0 9 * * *"},
+		"bad expression": {Start: "every morning"},
+	} {
+		if err := s.Validate(); err == nil {
+			t.Errorf("%s: Validate() = nil, want an error", name)
+		}
+	}
+	if err := officeHours.Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+}
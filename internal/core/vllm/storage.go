@@ -0,0 +1,91 @@
+package vllm
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+const (
+	SchemeFile = "file"
+	SchemePVC  = "pvc"
+	SchemeS3   = "s3"
+	SchemeHF   = "hf"
+)
+
+var (
+	ErrInvalidStorageURI = errors.New("invalid storage URI")
+	ErrArtifactNotFound  = errors.New("model artifact not found")
+)
+
+// StorageLocation is a parsed spec.storageUri:
+//
+//	file:///abs/path          a path already present in the runtime container
+//	pvc://claim/sub/path      a path on a PersistentVolumeClaim
+//	s3://bucket/prefix        objects in an S3-compatible bucket
+//	hf://org/model[@revision] a Hugging Face Hub repository
+type StorageLocation struct {
+	URI    string
+	Scheme string
+	// Bucket is the S3 bucket, Claim the PVC name and Repo the Hub repository.
+	Bucket   string
+	Claim    string
+	Repo     string
+	Revision string
+	// Path is the file path, the path within the claim or the object prefix.
+	Path string
+}
+
+// Artifact is a resolved model artifact and where the runtime will find it.
+type Artifact struct {
+	Location StorageLocation
+	// SizeBytes is the artifact size, or the claim capacity for pvc://; -1 if unknown.
+	SizeBytes int64
+	Files     int
+	// LocalPath is the artifact's path inside the runtime container.
+	LocalPath string
+}
+
+func ParseStorageURI(uri string) (StorageLocation, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return StorageLocation{}, fmt.Errorf("%w %q: %v", ErrInvalidStorageURI, uri, err)
+	}
+	loc := StorageLocation{URI: uri, Scheme: u.Scheme}
+	rest := strings.Trim(u.Path, "/")
+	switch u.Scheme {
+	case SchemeFile:
+		if u.Host != "" || u.Path == "" {
+			return loc, fmt.Errorf("%w %q: expected file:///absolute/path", ErrInvalidStorageURI, uri)
+		}
+		loc.Path = path.Clean(u.Path)
+	case SchemePVC:
+		if u.Host == "" {
+			return loc, fmt.Errorf("%w %q: expected pvc://claim/path", ErrInvalidStorageURI, uri)
+		}
+		loc.Claim, loc.Path = u.Host, rest
+	case SchemeS3:
+		if u.Host == "" || rest == "" {
+			return loc, fmt.Errorf("%w %q: expected s3://bucket/prefix", ErrInvalidStorageURI, uri)
+		}
+		loc.Bucket, loc.Path = u.Host, rest
+	case SchemeHF:
+		repo := strings.Trim(u.Host+"/"+rest, "/")
+		repo, revision, _ := strings.Cut(repo, "@")
+		if strings.Count(repo, "/") != 1 || strings.HasPrefix(repo, "/") || strings.HasSuffix(repo, "/") {
+			return loc, fmt.Errorf("%w %q: expected hf://org/model[@revision]", ErrInvalidStorageURI, uri)
+		}
+		if revision == "" {
+			revision = "main"
+		}
+		loc.Repo, loc.Revision = repo, revision
+	default:
+		return loc, fmt.Errorf("%w %q: unsupported scheme %q (want file, pvc, s3 or hf)", ErrInvalidStorageURI, uri, u.Scheme)
+	}
+	if strings.Contains("/"+loc.Path+"/", "/../") {
+		return loc, fmt.Errorf("%w %q: path must not contain ..", ErrInvalidStorageURI, uri)
+	}
+	return loc, nil
+}
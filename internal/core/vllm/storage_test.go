@@ -0,0 +1,50 @@
+package vllm
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseStorageURI(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want StorageLocation
+	}{
+		{"file:///usr/local/models/llama", StorageLocation{Scheme: SchemeFile, Path: "/usr/local/models/llama"}},
+		{"pvc://models/llama/8b", StorageLocation{Scheme: SchemePVC, Claim: "models", Path: "llama/8b"}},
+		{"pvc://models", StorageLocation{Scheme: SchemePVC, Claim: "models"}},
+		{"s3://weights/llama/8b/", StorageLocation{Scheme: SchemeS3, Bucket: "weights", Path: "llama/8b"}},
+		{"hf://meta-llama/Llama-3.1-8B", StorageLocation{Scheme: SchemeHF, Repo: "meta-llama/Llama-3.1-8B", Revision: "main"}},
+		{"hf://meta-llama/Llama-3.1-8B@v2", StorageLocation{Scheme: SchemeHF, Repo: "meta-llama/Llama-3.1-8B", Revision: "v2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			got, err := ParseStorageURI(tt.uri)
+			if err != nil {
+				t.Fatalf("ParseStorageURI() = %v", err)
+			}
+			tt.want.URI = tt.uri
+			if got != tt.want {
+				t.Fatalf("ParseStorageURI() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStorageURIRejects(t *testing.T) {
+	for _, uri := range []string{
+		"file://host/models",
+		"file://",
+		"pvc:///llama",
+		"s3://weights",
+		"hf://llama",
+		"hf://org/team/model",
+		"gs://weights/llama",
+		"pvc://models/../secrets",
+		"/usr/local/models",
+	} {
+		if _, err := ParseStorageURI(uri); !errors.Is(err, ErrInvalidStorageURI) {
+			t.Errorf("ParseStorageURI(%q) = %v, want ErrInvalidStorageURI", uri, err)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package vllm
+
+import (
+	"errors"
+	"time"
+)
+
+const ActionWarmup = "warmup"
+
+// LabelWarmup marks the DaemonSet and pods warming nodes for a VLLM resource.
+const LabelWarmup = "vllm.ai/warmup"
+
+type WarmupPhase string
+
+const (
+	WarmupPending        WarmupPhase = "Pending"
+	WarmupPullingImage   WarmupPhase = "PullingImage"
+	WarmupCopyingWeights WarmupPhase = "CopyingWeights"
+	WarmupCompleted      WarmupPhase = "Completed"
+	WarmupFailed         WarmupPhase = "Failed"
+)
+
+var (
+	ErrNoWarmup         = errors.New("no warm-up found")
+	ErrWarmupInProgress = errors.New("warm-up already in progress")
+)
+
+// Warmup is the progress of pre-pulling a runtime's image and caching its
+// weights on the target nodes. It is stored in status.warmup.
+type Warmup struct {
+	Namespace string
+	Name      string
+	Image     string
+	// CachePath is the node-local directory the weights are copied to; empty
+	// when only the image is pre-pulled.
+	CachePath    string
+	NodeSelector map[string]string
+	Phase        WarmupPhase
+	Message      string
+	NodesDesired int32
+	// NodesPulled have the image; NodesReady also have the weights cached.
+	NodesPulled int32
+	NodesReady  int32
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+// Done reports whether the warm-up has finished, successfully or not.
+func (w Warmup) Done() bool {
+	return w.Phase == WarmupCompleted || w.Phase == WarmupFailed
+}
@@ -0,0 +1,132 @@
+package audit
+
+import (
+	"cmp"
+	vllmv1 "connect-go/api/vllm/v1"
+	auditCore "connect-go/internal/core/audit"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	eventComponent = "connect-go"
+	// labelAuditEvent marks the Events that carry an audit event.
+	labelAuditEvent = "vllm.ai/audit"
+	// annotationAuditEvent holds the audit event as JSON, so List can return
+	// it as recorded.
+	annotationAuditEvent = "vllm.ai/audit-event"
+)
+
+// KubernetesEventSink emits a Kubernetes Event on the VLLM object an action touched,
+// so `kubectl describe vllm` shows who did what. The Events are stored by the
+// API server, so List gives every replica the same history; it reaches back as
+// far as the API server keeps Events (--event-ttl, one hour by default).
+type KubernetesEventSink struct {
+	client  kubernetes.Interface
+	dynamic dynamic.Interface
+}
+
+func NewKubernetesEventSink(client kubernetes.Interface, dynamicClient dynamic.Interface) *KubernetesEventSink {
+	return &KubernetesEventSink{
+		client:  client,
+		dynamic: dynamicClient,
+	}
+}
+
+// Record emits the Event on the VLLM resource the action touched or, when it
+// failed before one was found, on the resource named after the runtime.
+func (s *KubernetesEventSink) Record(ctx context.Context, ev auditCore.Event) error {
+	name := cmp.Or(ev.Resource, ev.RuntimeName)
+	if name == "" {
+		return nil
+	}
+	namespace := ev.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	recorded, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+	ref := corev1.ObjectReference{
+		APIVersion: vllmv1.SchemeGroupVersion.String(),
+		Kind:       "VLLM",
+		Namespace:  namespace,
+		Name:       name,
+	}
+	// kubectl describe matches events on the object UID, so look it up when we can.
+	if obj, err := s.dynamic.Resource(vllmv1.VLLMResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+		ref.UID = obj.GetUID()
+		ref.ResourceVersion = obj.GetResourceVersion()
+	}
+
+	eventType := corev1.EventTypeNormal
+	reason := capitalize(ev.Action)
+	message := fmt.Sprintf("%s by %s succeeded in %s", ev.Action, ev.Caller, ev.Latency.Round(time.Millisecond))
+	if ev.Outcome != auditCore.OutcomeSuccess {
+		eventType = corev1.EventTypeWarning
+		reason += "Failed"
+		message = fmt.Sprintf("%s by %s failed after %s: %s", ev.Action, ev.Caller, ev.Latency.Round(time.Millisecond), ev.Error)
+	}
+	now := metav1.NewTime(ev.Time)
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: name + ".",
+			Namespace:    namespace,
+			Labels:       map[string]string{labelAuditEvent: "true"},
+			Annotations: map[string]string{
+				"vllm.ai/audit-id":   ev.ID,
+				annotationAuditEvent: string(recorded),
+			},
+		},
+		InvolvedObject:      ref,
+		Reason:              reason,
+		Message:             message,
+		Type:                eventType,
+		Source:              corev1.EventSource{Component: eventComponent},
+		ReportingController: eventComponent,
+		FirstTimestamp:      now,
+		LastTimestamp:       now,
+		Count:               1,
+	}
+	if _, err := s.client.CoreV1().Events(namespace).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create audit event for %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
+
+// List returns matching events, newest first.
+func (s *KubernetesEventSink) List(ctx context.Context, q auditCore.Query) ([]auditCore.Event, error) {
+	list, err := s.client.CoreV1().Events(q.Namespace).List(ctx, metav1.ListOptions{LabelSelector: labelAuditEvent})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+	var events []auditCore.Event
+	for _, item := range list.Items {
+		var ev auditCore.Event
+		if err := json.Unmarshal([]byte(item.Annotations[annotationAuditEvent]), &ev); err != nil {
+			continue
+		}
+		if q.Matches(ev) {
+			events = append(events, ev)
+		}
+	}
+	slices.SortStableFunc(events, func(a, b auditCore.Event) int { return a.Time.Compare(b.Time) })
+	return newestFirst(events, q.Limit), nil
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,136 @@
+package audit
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	auditCore "connect-go/internal/core/audit"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newEventSink(t *testing.T) (*KubernetesEventSink, *fake.Clientset) {
+	t.Helper()
+	vllm := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "vllm.ai/v1",
+		"kind":       "VLLM",
+		"metadata":   map[string]interface{}{"namespace": "default", "name": "llama-abc12", "uid": "llama-uid", "resourceVersion": "7"},
+	}}
+	dynamicClient := dfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{vllmv1.VLLMResource: "VLLMList"}, vllm)
+	clientset := fake.NewSimpleClientset()
+	// The fake clientset does not generate names.
+	var n int
+	clientset.PrependReactor("create", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		e := action.(k8stesting.CreateAction).GetObject().(*corev1.Event)
+		if e.Name == "" {
+			n++
+			e.Name = fmt.Sprintf("%s%d", e.GenerateName, n)
+		}
+		return false, nil, nil
+	})
+	return NewKubernetesEventSink(clientset, dynamicClient), clientset
+}
+
+func TestKubernetesEventSinkRecord(t *testing.T) {
+	sink, clientset := newEventSink(t)
+	ev := auditCore.Event{
+		ID:          "audit-1",
+		Time:        time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		Caller:      "alice",
+		Action:      "start",
+		Namespace:   "default",
+		RuntimeName: "llama",
+		Resource:    "llama-abc12",
+		Outcome:     auditCore.OutcomeSuccess,
+		Latency:     1500 * time.Millisecond,
+	}
+	if err := sink.Record(t.Context(), ev); err != nil {
+		t.Fatal(err)
+	}
+	failed := ev
+	failed.ID, failed.Resource, failed.RuntimeName, failed.Outcome, failed.Error = "audit-2", "", "missing", auditCore.OutcomeFailure, "not found"
+	if err := sink.Record(t.Context(), failed); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := clientset.CoreV1().Events("default").List(t.Context(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 2 {
+		t.Fatalf("got %d Events, want 2", len(list.Items))
+	}
+	byID := map[string]corev1.Event{}
+	for _, e := range list.Items {
+		byID[e.Annotations["vllm.ai/audit-id"]] = e
+	}
+	got := byID["audit-1"]
+	want := corev1.ObjectReference{APIVersion: "vllm.ai/v1", Kind: "VLLM", Namespace: "default", Name: "llama-abc12", UID: "llama-uid", ResourceVersion: "7"}
+	if got.InvolvedObject != want {
+		t.Errorf("involved object = %+v, want %+v", got.InvolvedObject, want)
+	}
+	if got.Type != corev1.EventTypeNormal || got.Reason != "Start" || got.Message != "start by alice succeeded in 1.5s" || got.Source.Component != "connect-go" {
+		t.Errorf("event = %s %s %q from %s", got.Type, got.Reason, got.Message, got.Source.Component)
+	}
+	got = byID["audit-2"]
+	if got.Type != corev1.EventTypeWarning || got.Reason != "StartFailed" || got.InvolvedObject.Name != "missing" || got.InvolvedObject.UID != "" {
+		t.Errorf("failed action event = %s %s on %+v", got.Type, got.Reason, got.InvolvedObject)
+	}
+}
+
+func TestKubernetesEventSinkList(t *testing.T) {
+	sink, clientset := newEventSink(t)
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i, ev := range []auditCore.Event{
+		{ID: "1", Namespace: "default", RuntimeName: "llama", Resource: "llama-abc12", Action: "start", Caller: "alice"},
+		{ID: "2", Namespace: "team-a", RuntimeName: "qwen", Resource: "qwen", Action: "start", Caller: "bob"},
+		{ID: "3", Namespace: "default", RuntimeName: "llama", Resource: "llama-abc12", Action: "stop", Caller: "alice", Changes: []auditCore.Change{{Path: "action", Before: "start", After: "stop"}}},
+	} {
+		ev.Time, ev.Outcome = start.Add(time.Duration(i)*time.Minute), auditCore.OutcomeSuccess
+		if err := sink.Record(t.Context(), ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Events that are not audit events are ignored.
+	if _, err := clientset.CoreV1().Events("default").Create(t.Context(), &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pulled"},
+		Reason:     "Pulled",
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := func(q auditCore.Query) []string {
+		events, err := sink.List(t.Context(), q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, ev := range events {
+			ids = append(ids, ev.ID)
+		}
+		return ids
+	}
+	if got := ids(auditCore.Query{}); !slices.Equal(got, []string{"3", "2", "1"}) {
+		t.Errorf("all events = %v, want newest first", got)
+	}
+	if got := ids(auditCore.Query{Namespace: "default", Limit: 1}); !slices.Equal(got, []string{"3"}) {
+		t.Errorf("latest in default = %v, want [3]", got)
+	}
+	if got := ids(auditCore.Query{Caller: "alice", Action: "start"}); !slices.Equal(got, []string{"1"}) {
+		t.Errorf("starts by alice = %v, want [1]", got)
+	}
+	events, _ := sink.List(t.Context(), auditCore.Query{Action: "stop"})
+	if len(events) != 1 || len(events[0].Changes) != 1 || !events[0].Time.Equal(start.Add(2*time.Minute)) {
+		t.Errorf("stop event = %+v, want it as recorded", events)
+	}
+}
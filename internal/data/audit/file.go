@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"bufio"
+	auditCore "connect-go/internal/core/audit"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// FileSink appends events as JSON lines and answers queries by scanning the file.
+type FileSink struct {
+	path string
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %q: %w", path, err)
+	}
+	return &FileSink{path: path, file: f}, nil
+}
+
+func (s *FileSink) Record(_ context.Context, ev auditCore.Event) error {
+	line, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log %q: %w", s.path, err)
+	}
+	return nil
+}
+
+// List returns matching events, newest first.
+func (s *FileSink) List(ctx context.Context, q auditCore.Query) ([]auditCore.Event, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %q: %w", s.path, err)
+	}
+	defer f.Close()
+
+	var events []auditCore.Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var ev auditCore.Event
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			continue
+		}
+		if q.Matches(ev) {
+			events = append(events, ev)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log %q: %w", s.path, err)
+	}
+	return newestFirst(events, q.Limit), nil
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+func newestFirst(events []auditCore.Event, limit int) []auditCore.Event {
+	out := make([]auditCore.Event, 0, len(events))
+	for i := len(events) - 1; i >= 0; i-- {
+		if limit > 0 && len(out) == limit {
+			break
+		}
+		out = append(out, events[i])
+	}
+	return out
+}
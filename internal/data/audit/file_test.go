@@ -0,0 +1,96 @@
+package audit
+
+import (
+	auditCore "connect-go/internal/core/audit"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	recorded := []auditCore.Event{
+		{ID: "1", Namespace: "default", RuntimeName: "llama", Action: "start", Caller: "alice", Outcome: auditCore.OutcomeSuccess},
+		{ID: "2", Namespace: "team-a", RuntimeName: "qwen", Action: "start", Caller: "bob", Outcome: auditCore.OutcomeFailure, Error: "quota exceeded"},
+		{ID: "3", Namespace: "default", RuntimeName: "llama", Action: "update", Caller: "alice", Outcome: auditCore.OutcomeSuccess,
+			Changes: []auditCore.Change{{Path: "replicas", Before: "1", After: "2"}}, Latency: 250 * time.Millisecond},
+	}
+	for i, ev := range recorded {
+		ev.Time = start.Add(time.Duration(i) * time.Minute)
+		recorded[i] = ev
+		if err := sink.Record(t.Context(), ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, err := sink.List(t.Context(), auditCore.Query{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("got %d events, want 3", len(all))
+	}
+	got := all[0]
+	want := recorded[2]
+	if got.ID != want.ID || !got.Time.Equal(want.Time) || got.Latency != want.Latency || !slices.Equal(got.Changes, want.Changes) {
+		t.Errorf("newest event = %+v, want %+v", got, want)
+	}
+
+	tests := []struct {
+		name  string
+		query auditCore.Query
+		want  []string
+	}{
+		{"namespace", auditCore.Query{Namespace: "default"}, []string{"3", "1"}},
+		{"runtime and action", auditCore.Query{RuntimeName: "llama", Action: "start"}, []string{"1"}},
+		{"caller", auditCore.Query{Caller: "bob"}, []string{"2"}},
+		{"since", auditCore.Query{Since: start.Add(time.Minute)}, []string{"3", "2"}},
+		{"limit", auditCore.Query{Limit: 2}, []string{"3", "2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := sink.List(t.Context(), tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, ev := range events {
+				ids = append(ids, ev.ID)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("List = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileSinkSkipsCorruptLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	if err := os.WriteFile(path, []byte("{\"id\":\"1\",\"action\":\"start\"}\nnot json\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// A reopened log is appended to.
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+	if err := sink.Record(t.Context(), auditCore.Event{ID: "2", Action: "stop"}); err != nil {
+		t.Fatal(err)
+	}
+	events, err := sink.List(t.Context(), auditCore.Query{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].ID != "2" || events[1].ID != "1" {
+		t.Errorf("List = %+v, want events 2 and 1", events)
+	}
+}
@@ -0,0 +1,40 @@
+package audit
+
+import (
+	auditCore "connect-go/internal/core/audit"
+	"context"
+	"sync"
+)
+
+// MemoryStore keeps the most recent events in a bounded ring buffer.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	capacity int
+	events   []auditCore.Event
+}
+
+func NewMemoryStore(capacity int) *MemoryStore {
+	return &MemoryStore{capacity: capacity}
+}
+
+func (s *MemoryStore) Record(_ context.Context, ev auditCore.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, ev)
+	if s.capacity > 0 && len(s.events) > s.capacity {
+		s.events = s.events[len(s.events)-s.capacity:]
+	}
+	return nil
+}
+
+func (s *MemoryStore) List(_ context.Context, q auditCore.Query) ([]auditCore.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var events []auditCore.Event
+	for _, ev := range s.events {
+		if q.Matches(ev) {
+			events = append(events, ev)
+		}
+	}
+	return newestFirst(events, q.Limit), nil
+}
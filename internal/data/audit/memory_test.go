@@ -0,0 +1,38 @@
+package audit
+
+import (
+	auditCore "connect-go/internal/core/audit"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestMemoryStoreEvictsOldest(t *testing.T) {
+	store := NewMemoryStore(3)
+	for i := range 5 {
+		action := "start"
+		if i%2 == 1 {
+			action = "stop"
+		}
+		if err := store.Record(t.Context(), auditCore.Event{ID: fmt.Sprint(i), Action: action}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ids := func(q auditCore.Query) []string {
+		events, err := store.List(t.Context(), q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, ev := range events {
+			ids = append(ids, ev.ID)
+		}
+		return ids
+	}
+	if got := ids(auditCore.Query{}); !slices.Equal(got, []string{"4", "3", "2"}) {
+		t.Errorf("events = %v, want the three newest", got)
+	}
+	if got := ids(auditCore.Query{Action: "start", Limit: 1}); !slices.Equal(got, []string{"4"}) {
+		t.Errorf("latest start = %v, want [4]", got)
+	}
+}
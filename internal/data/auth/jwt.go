@@ -0,0 +1,114 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+var allowedAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTAuthenticator verifies OIDC ID tokens against a JWKS read from a local file.
+// Tokens must come from Issuer and carry an expiry.
+type JWTAuthenticator struct {
+	keys          jose.JSONWebKeySet
+	Issuer        string
+	Audience      string
+	UsernameClaim string
+	GroupsClaim   string
+}
+
+func NewJWTAuthenticator(jwksFile, issuer, audience string) (*JWTAuthenticator, error) {
+	if issuer == "" {
+		return nil, fmt.Errorf("an OIDC issuer is required to verify tokens from JWKS file %q", jwksFile)
+	}
+	data, err := os.ReadFile(jwksFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file %q: %w", jwksFile, err)
+	}
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS file %q: %w", jwksFile, err)
+	}
+	if len(keys.Keys) == 0 {
+		return nil, fmt.Errorf("JWKS file %q contains no keys", jwksFile)
+	}
+	return &JWTAuthenticator{
+		keys:          keys,
+		Issuer:        issuer,
+		Audience:      audience,
+		UsernameClaim: "sub",
+		GroupsClaim:   "groups",
+	}, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(_ context.Context, creds authCore.Credentials) (*authCore.Principal, error) {
+	raw, ok := bearerToken(creds.Header)
+	if !ok {
+		return nil, authCore.ErrNoCredentials
+	}
+	tok, err := jwt.ParseSigned(raw, allowedAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: %w", err)
+	}
+	key, err := a.lookupKey(tok.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var std jwt.Claims
+	extra := map[string]interface{}{}
+	if err := tok.Claims(key.Key, &std, &extra); err != nil {
+		return nil, fmt.Errorf("jwt: %w", err)
+	}
+	// ValidateWithLeeway skips the checks whose claim or expectation is empty.
+	if std.Expiry == nil {
+		return nil, fmt.Errorf("jwt: missing \"exp\" claim")
+	}
+	expected := jwt.Expected{Issuer: a.Issuer, Time: time.Now()}
+	if a.Audience != "" {
+		expected.AnyAudience = jwt.Audience{a.Audience}
+	}
+	if err := std.ValidateWithLeeway(expected, jwt.DefaultLeeway); err != nil {
+		return nil, fmt.Errorf("jwt: %w", err)
+	}
+
+	name, _ := extra[a.UsernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("jwt: missing %q claim", a.UsernameClaim)
+	}
+	p := &authCore.Principal{Name: name, Method: "oidc"}
+	if groups, ok := extra[a.GroupsClaim].([]interface{}); ok {
+		for _, g := range groups {
+			if s, ok := g.(string); ok {
+				p.Groups = append(p.Groups, s)
+			}
+		}
+	}
+	return p, nil
+}
+
+func (a *JWTAuthenticator) lookupKey(kid string) (*jose.JSONWebKey, error) {
+	if kid == "" {
+		if len(a.keys.Keys) == 1 {
+			return &a.keys.Keys[0], nil
+		}
+		return nil, fmt.Errorf("jwt: token has no kid and JWKS holds %d keys", len(a.keys.Keys))
+	}
+	keys := a.keys.Key(kid)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("jwt: unknown key id %q", kid)
+	}
+	return &keys[0], nil
+}
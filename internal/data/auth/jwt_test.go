@@ -0,0 +1,134 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const (
+	testIssuer   = "https://issuer.example.com"
+	testAudience = "vllm-control-plane"
+)
+
+// newTestJWKS writes the public half of a fresh key to a JWKS file and
+// returns a signer for tokens the file verifies.
+func newTestJWKS(t *testing.T) (string, jose.Signer) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key.Public(), KeyID: "k1", Algorithm: string(jose.ES256), Use: "sig"}}}
+	data, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, (&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "k1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path, signer
+}
+
+func bearer(token string) authCore.Credentials {
+	return authCore.Credentials{Header: http.Header{"Authorization": []string{"Bearer " + token}}}
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	path, signer := newTestJWKS(t)
+	a, err := NewJWTAuthenticator(path, testIssuer, testAudience)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	valid := jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "alice",
+		Audience: jwt.Audience{testAudience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	sign := func(change func(c *jwt.Claims)) string {
+		c := valid
+		change(&c)
+		token, err := jwt.Signed(signer).Claims(c).Claims(map[string]interface{}{"sub": c.Subject, "groups": []string{"ml-team"}}).Serialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	p, err := a.Authenticate(t.Context(), bearer(sign(func(*jwt.Claims) {})))
+	if err != nil {
+		t.Fatalf("valid token: %v", err)
+	}
+	if p.Name != "alice" || !slices.Equal(p.Groups, []string{"ml-team"}) || p.Method != "oidc" {
+		t.Errorf("principal = %+v, want alice in ml-team", p)
+	}
+
+	tests := []struct {
+		name   string
+		change func(c *jwt.Claims)
+	}{
+		{"expired", func(c *jwt.Claims) { c.Expiry = jwt.NewNumericDate(now.Add(-time.Hour)) }},
+		{"no expiry", func(c *jwt.Claims) { c.Expiry = nil }},
+		{"not yet valid", func(c *jwt.Claims) { c.NotBefore = jwt.NewNumericDate(now.Add(time.Hour)) }},
+		{"wrong issuer", func(c *jwt.Claims) { c.Issuer = "https://other.example.com" }},
+		{"no issuer", func(c *jwt.Claims) { c.Issuer = "" }},
+		{"wrong audience", func(c *jwt.Claims) { c.Audience = jwt.Audience{"another-service"} }},
+		{"no subject", func(c *jwt.Claims) { c.Subject = "" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if p, err := a.Authenticate(t.Context(), bearer(sign(tt.change))); err == nil {
+				t.Errorf("Authenticate accepted the token as %+v", p)
+			}
+		})
+	}
+}
+
+func TestJWTAuthenticatorRejectsForeignKeys(t *testing.T) {
+	path, _ := newTestJWKS(t)
+	_, foreign := newTestJWKS(t)
+	a, err := NewJWTAuthenticator(path, testIssuer, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.Signed(foreign).Claims(jwt.Claims{
+		Issuer:  testIssuer,
+		Subject: "mallory",
+		Expiry:  jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Authenticate(t.Context(), bearer(token)); err == nil {
+		t.Error("token signed by another key was accepted")
+	}
+	if _, err := a.Authenticate(t.Context(), authCore.Credentials{Header: http.Header{}}); err != authCore.ErrNoCredentials {
+		t.Errorf("no token = %v, want ErrNoCredentials", err)
+	}
+}
+
+func TestNewJWTAuthenticatorRequiresIssuer(t *testing.T) {
+	path, _ := newTestJWKS(t)
+	if _, err := NewJWTAuthenticator(path, "", testAudience); err == nil {
+		t.Error("NewJWTAuthenticator accepted an empty issuer")
+	}
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// ClientCertAuthenticator maps a verified TLS client certificate to a principal:
+// the subject CN is the user name and the subject organizations are the groups.
+// Chain verification itself is done by the TLS server using the client CA pool.
+type ClientCertAuthenticator struct{}
+
+func NewClientCertAuthenticator() *ClientCertAuthenticator {
+	return &ClientCertAuthenticator{}
+}
+
+func (a *ClientCertAuthenticator) Authenticate(_ context.Context, creds authCore.Credentials) (*authCore.Principal, error) {
+	if creds.TLS == nil || len(creds.TLS.VerifiedChains) == 0 || len(creds.TLS.VerifiedChains[0]) == 0 {
+		return nil, authCore.ErrNoCredentials
+	}
+	cert := creds.TLS.VerifiedChains[0][0]
+	if cert.Subject.CommonName == "" {
+		return nil, fmt.Errorf("client certificate has an empty common name")
+	}
+	return &authCore.Principal{
+		Name:   cert.Subject.CommonName,
+		Groups: cert.Subject.Organization,
+		Method: "mtls",
+	}, nil
+}
+
+// LoadClientCAs reads a PEM bundle of CAs trusted to sign client certificates.
+func LoadClientCAs(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file %q: %w", path, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in client CA file %q", path)
+	}
+	return pool, nil
+}
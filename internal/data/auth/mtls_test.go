@@ -0,0 +1,36 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func verifiedAs(subject pkix.Name) *tls.ConnectionState {
+	return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{Subject: subject}}}}
+}
+
+func TestClientCertAuthenticator(t *testing.T) {
+	a := NewClientCertAuthenticator()
+	p, err := a.Authenticate(t.Context(), authCore.Credentials{TLS: verifiedAs(pkix.Name{CommonName: "ci-bot", Organization: []string{"deployers"}})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "ci-bot" || !slices.Equal(p.Groups, []string{"deployers"}) || p.Method != "mtls" {
+		t.Errorf("principal = %+v, want ci-bot in deployers", p)
+	}
+	if _, err := a.Authenticate(t.Context(), authCore.Credentials{TLS: verifiedAs(pkix.Name{Organization: []string{"deployers"}})}); err == nil {
+		t.Error("certificate without a common name was accepted")
+	}
+	// A certificate the TLS server did not verify is no credential.
+	unverified := &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "mallory"}}}}
+	for _, state := range []*tls.ConnectionState{nil, unverified} {
+		if _, err := a.Authenticate(t.Context(), authCore.Credentials{TLS: state}); !errors.Is(err, authCore.ErrNoCredentials) {
+			t.Errorf("Authenticate(%v) = %v, want ErrNoCredentials", state, err)
+		}
+	}
+}
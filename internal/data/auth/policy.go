@@ -0,0 +1,30 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"fmt"
+	"os"
+
+	"go.yaml.in/yaml/v2"
+)
+
+// LoadPolicy reads the RBAC rules from a YAML file, e.g.
+//
+//	rules:
+//	  - groups: ["ml-platform"]
+//	    namespaces: ["*"]
+//	    actions: ["*"]
+//	  - users: ["ci-bot"]
+//	    namespaces: ["staging"]
+//	    actions: ["start", "stop", "list"]
+func LoadPolicy(path string) (*authCore.Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file %q: %w", path, err)
+	}
+	var policy authCore.Policy
+	if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to decode policy file %q: %w", path, err)
+	}
+	return &policy, nil
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	data := `rules:
+  - users: ["ci-bot"]
+    namespaces: ["staging"]
+    actions: ["start", "list"]
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := LoadPolicy(path)
+	if err != nil {
+		t.Fatalf("LoadPolicy() = %v", err)
+	}
+	if err := policy.Authorize(&authCore.Principal{Name: "ci-bot"}, authCore.ActionStart, "staging"); err != nil {
+		t.Fatalf("Authorize() = %v, want allowed", err)
+	}
+}
+
+func TestLoadPolicyRejectsUnknownFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(path, []byte("rules:\n  - user: [\"ci-bot\"]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPolicy(path); err == nil {
+		t.Fatal("LoadPolicy() accepted a misspelled field")
+	}
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"context"
+	"crypto/subtle"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// StaticTokenAuthenticator checks bearer tokens against a CSV file in the
+// kube-apiserver token file format: token,user,uid,"group1,group2".
+type StaticTokenAuthenticator struct {
+	tokens map[string]*authCore.Principal
+}
+
+func NewStaticTokenAuthenticator(path string) (*StaticTokenAuthenticator, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open token file %q: %w", path, err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	r.Comment = '#'
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token file %q: %w", path, err)
+	}
+
+	tokens := make(map[string]*authCore.Principal, len(records))
+	for i, rec := range records {
+		if len(rec) < 2 || rec[0] == "" || rec[1] == "" {
+			return nil, fmt.Errorf("token file %q line %d: token and user are required", path, i+1)
+		}
+		p := &authCore.Principal{Name: rec[1], Method: "token"}
+		if len(rec) > 3 && rec[3] != "" {
+			p.Groups = strings.Split(rec[3], ",")
+		}
+		tokens[rec[0]] = p
+	}
+	return &StaticTokenAuthenticator{tokens: tokens}, nil
+}
+
+func (a *StaticTokenAuthenticator) Authenticate(_ context.Context, creds authCore.Credentials) (*authCore.Principal, error) {
+	token, ok := bearerToken(creds.Header)
+	if !ok {
+		return nil, authCore.ErrNoCredentials
+	}
+	for known, p := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(known), []byte(token)) == 1 {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("static token: unknown token")
+}
+
+func bearerToken(h http.Header) (string, bool) {
+	value := h.Get("Authorization")
+	scheme, token, found := strings.Cut(value, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
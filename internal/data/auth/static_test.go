@@ -0,0 +1,52 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func writeTokenFile(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "tokens.csv")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestStaticTokenAuthenticator(t *testing.T) {
+	a, err := NewStaticTokenAuthenticator(writeTokenFile(t, "# token,user,uid,groups\ns3cret,ci-bot,1,\"ci,deployers\"\nplain,alice\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := a.Authenticate(t.Context(), bearer("s3cret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "ci-bot" || !slices.Equal(p.Groups, []string{"ci", "deployers"}) || p.Method != "token" {
+		t.Errorf("principal = %+v, want ci-bot in ci and deployers", p)
+	}
+	if p, err := a.Authenticate(t.Context(), bearer("plain")); err != nil || p.Name != "alice" || len(p.Groups) != 0 {
+		t.Errorf("token without groups = %+v, %v; want alice", p, err)
+	}
+	if _, err := a.Authenticate(t.Context(), bearer("guess")); err == nil {
+		t.Error("unknown token was accepted")
+	}
+	for _, header := range []string{"", "Basic czNjcmV0", "Bearer "} {
+		creds := authCore.Credentials{Header: http.Header{"Authorization": []string{header}}}
+		if _, err := a.Authenticate(t.Context(), creds); !errors.Is(err, authCore.ErrNoCredentials) {
+			t.Errorf("Authorization %q = %v, want ErrNoCredentials", header, err)
+		}
+	}
+}
+
+func TestStaticTokenAuthenticatorRejectsIncompleteLines(t *testing.T) {
+	if _, err := NewStaticTokenAuthenticator(writeTokenFile(t, "s3cret\n")); err == nil {
+		t.Error("a line without a user was accepted")
+	}
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	authCore "connect-go/internal/core/auth"
+	"context"
+	"fmt"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// TokenReviewAuthenticator delegates bearer token validation to the Kubernetes API server.
+type TokenReviewAuthenticator struct {
+	client    kubernetes.Interface
+	audiences []string
+}
+
+func NewTokenReviewAuthenticator(client kubernetes.Interface, audiences []string) *TokenReviewAuthenticator {
+	return &TokenReviewAuthenticator{
+		client:    client,
+		audiences: audiences,
+	}
+}
+
+func (a *TokenReviewAuthenticator) Authenticate(ctx context.Context, creds authCore.Credentials) (*authCore.Principal, error) {
+	token, ok := bearerToken(creds.Header)
+	if !ok {
+		return nil, authCore.ErrNoCredentials
+	}
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: a.audiences,
+		},
+	}
+	result, err := a.client.AuthenticationV1().TokenReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("token review request failed: %w", err)
+	}
+	if !result.Status.Authenticated {
+		return nil, fmt.Errorf("token review: %s", result.Status.Error)
+	}
+	return &authCore.Principal{
+		Name:   result.Status.User.Username,
+		Groups: result.Status.User.Groups,
+		Method: "tokenreview",
+	}, nil
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// reviewer answers TokenReviews: the token "valid" is alice, any other is
+// rejected.
+func reviewer(audiences *[]string) *fake.Clientset {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		*audiences = review.Spec.Audiences
+		switch review.Spec.Token {
+		case "valid":
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authenticationv1.UserInfo{Username: "system:serviceaccount:ci:deployer", Groups: []string{"system:serviceaccounts"}},
+			}
+		case "unreachable":
+			return true, nil, errors.New("connection refused")
+		default:
+			review.Status = authenticationv1.TokenReviewStatus{Error: "token expired"}
+		}
+		return true, review, nil
+	})
+	return clientset
+}
+
+func TestTokenReviewAuthenticator(t *testing.T) {
+	var audiences []string
+	a := NewTokenReviewAuthenticator(reviewer(&audiences), []string{"vllm-control-plane"})
+
+	p, err := a.Authenticate(t.Context(), bearer("valid"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "system:serviceaccount:ci:deployer" || !slices.Equal(p.Groups, []string{"system:serviceaccounts"}) || p.Method != "tokenreview" {
+		t.Errorf("principal = %+v", p)
+	}
+	if !slices.Equal(audiences, []string{"vllm-control-plane"}) {
+		t.Errorf("review audiences = %v, want the configured ones", audiences)
+	}
+	for _, token := range []string{"expired", "unreachable"} {
+		if _, err := a.Authenticate(t.Context(), bearer(token)); err == nil {
+			t.Errorf("token %q was accepted", token)
+		}
+	}
+}
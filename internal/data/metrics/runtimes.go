@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	metricsCore "connect-go/internal/core/metrics"
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	runtimesDesc = prometheus.NewDesc(
+		"vllm_control_plane_runtimes",
+		"VLLM runtimes by namespace, model and status phase.",
+		[]string{"namespace", "model", "phase"}, nil,
+	)
+	gpusDesc = prometheus.NewDesc(
+		"vllm_control_plane_gpus_allocated",
+		"GPUs requested by Running VLLM runtimes (per-replica limit times replicas).",
+		[]string{"namespace", "model"}, nil,
+	)
+)
+
+// RuntimeWatcher watches VLLM resources in all namespaces. It records phase
+// transitions as they happen and reports per-phase and GPU gauges on scrape.
+// It shares the VLLM informer the controllers list from, so the caller starts
+// the informer.
+//
+// Every replica watches the same resources, so the transitions and gauges are
+// only reported while Run runs, which the server does under the leader Lease;
+// summing them across replicas counts each runtime once. Starts are timed on
+// whichever replica accepted them, whether it leads or not.
+type RuntimeWatcher struct {
+	informer cache.SharedIndexInformer
+	leading  atomic.Bool
+}
+
+func NewRuntimeWatcher(informer cache.SharedIndexInformer) (*RuntimeWatcher, error) {
+	w := &RuntimeWatcher{informer: informer}
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if v, ok := obj.(*vllmv1.VLLM); ok && !isInInitialList {
+				w.observe(v, "")
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldV, ok1 := oldObj.(*vllmv1.VLLM)
+			newV, ok2 := newObj.(*vllmv1.VLLM)
+			if ok1 && ok2 {
+				w.observe(newV, oldV.Status.Phase)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if v, ok := obj.(*vllmv1.VLLM); ok {
+				metricsCore.RuntimeDeleted(v.Namespace, v.Name)
+			}
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add VLLM event handler: %w", err)
+	}
+	return w, nil
+}
+
+// Run reports the runtimes until ctx is cancelled.
+func (w *RuntimeWatcher) Run(ctx context.Context) error {
+	w.leading.Store(true)
+	defer w.leading.Store(false)
+	<-ctx.Done()
+	return nil
+}
+
+func (w *RuntimeWatcher) observe(v *vllmv1.VLLM, from string) {
+	if w.leading.Load() {
+		metricsCore.CountPhase(v.Namespace, v.Spec.Model, from, v.Status.Phase)
+	}
+	metricsCore.ObservePhase(v.Namespace, v.Name, v.Spec.Model, from, v.Status.Phase, time.Now())
+}
+
+func (w *RuntimeWatcher) Describe(ch chan<- *prometheus.Desc) {
+	ch <- runtimesDesc
+	ch <- gpusDesc
+}
+
+func (w *RuntimeWatcher) Collect(ch chan<- prometheus.Metric) {
+	if !w.leading.Load() {
+		return
+	}
+	type runtimeKey struct{ namespace, model, phase string }
+	type gpuKey struct{ namespace, model string }
+	runtimes := map[runtimeKey]int{}
+	gpus := map[gpuKey]int64{}
+	for _, obj := range w.informer.GetStore().List() {
+		v, ok := obj.(*vllmv1.VLLM)
+		if !ok {
+			continue
+		}
+		runtimes[runtimeKey{v.Namespace, v.Spec.Model, v.Status.Phase}]++
+		if v.Status.Phase == "Running" {
+			gpus[gpuKey{v.Namespace, v.Spec.Model}] += gpusOf(v)
+		}
+	}
+	for k, n := range runtimes {
+		ch <- prometheus.MustNewConstMetric(runtimesDesc, prometheus.GaugeValue, float64(n), k.namespace, k.model, k.phase)
+	}
+	for k, n := range gpus {
+		ch <- prometheus.MustNewConstMetric(gpusDesc, prometheus.GaugeValue, float64(n), k.namespace, k.model)
+	}
+}
+
+// gpusOf returns the GPU limit per replica multiplied by spec.replicas.
+func gpusOf(v *vllmv1.VLLM) int64 {
+	perReplica := v.Spec.DeploymentConfig.Resources.Limits.Name("nvidia.com/gpu", "").Value()
+	replicas := int64(1)
+	if v.Spec.Replicas != nil {
+		replicas = int64(*v.Spec.Replicas)
+	}
+	return perReplica * replicas
+}
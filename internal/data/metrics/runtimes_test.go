@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"connect-go/api/vllm/client/clientset/versioned/fake"
+	"connect-go/api/vllm/client/informers/externalversions"
+	vllmv1 "connect-go/api/vllm/v1"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// series gathers w and counts the series of each metric.
+func series(t *testing.T, w *RuntimeWatcher) map[string]int {
+	t.Helper()
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(w)
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]int{}
+	for _, f := range families {
+		counts[f.GetName()] = len(f.GetMetric())
+	}
+	return counts
+}
+
+func TestRuntimeWatcherReportsOnlyWhileLeading(t *testing.T) {
+	replicas := int32(2)
+	running := &vllmv1.VLLM{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama"},
+		Spec: vllmv1.VLLMSpec{
+			Model:    "meta-llama/Llama-3.1-8B",
+			Replicas: &replicas,
+			DeploymentConfig: vllmv1.DeploymentConfig{Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+			}},
+		},
+		Status: vllmv1.VLLMStatus{Phase: "Running"},
+	}
+	informers := externalversions.NewSharedInformerFactory(fake.NewSimpleClientset(running), 0)
+	w, err := NewRuntimeWatcher(informers.Vllm().V1().VLLMs().Informer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	informers.Start(t.Context().Done())
+	informers.WaitForCacheSync(t.Context().Done())
+
+	if s := series(t, w); len(s) != 0 {
+		t.Errorf("a replica not leading reported %v, want nothing", s)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = w.Run(ctx)
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for !w.leading.Load() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if s := series(t, w); s["vllm_control_plane_runtimes"] != 1 || s["vllm_control_plane_gpus_allocated"] != 1 {
+		t.Errorf("leader reported %v, want one runtime and one GPU series", s)
+	}
+	cancel()
+	<-done
+	if s := series(t, w); len(s) != 0 {
+		t.Errorf("a replica that lost the Lease reported %v, want nothing", s)
+	}
+}
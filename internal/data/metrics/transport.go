@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	metricsCore "connect-go/internal/core/metrics"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InstrumentTransport wraps a Kubernetes client transport so every API call is
+// counted and timed. It is meant for rest.Config.Wrap.
+func InstrumentTransport(rt http.RoundTripper) http.RoundTripper {
+	return &instrumentedTransport{next: rt}
+}
+
+type instrumentedTransport struct {
+	next http.RoundTripper
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+
+	method := req.Method
+	if req.URL.Query().Get("watch") == "true" {
+		method = "WATCH"
+	}
+	resource := resourceFromPath(req.URL.Path)
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	metricsCore.KubernetesRequests.WithLabelValues(method, resource, code).Inc()
+	// Watches stay open for minutes and would swamp the latency histogram.
+	if method != "WATCH" {
+		metricsCore.KubernetesDuration.WithLabelValues(method, resource).Observe(time.Since(start).Seconds())
+	}
+	return resp, err
+}
+
+// resourceFromPath extracts the resource (and subresource) from a Kubernetes API
+// path, dropping namespaces and object names to keep label cardinality bounded.
+func resourceFromPath(path string) string {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(segs) >= 3 && segs[0] == "api":
+		segs = segs[2:]
+	case len(segs) >= 4 && segs[0] == "apis":
+		segs = segs[3:]
+	default:
+		return "other"
+	}
+	if segs[0] == "namespaces" && len(segs) >= 3 {
+		segs = segs[2:]
+	}
+	resource := segs[0]
+	if len(segs) >= 3 {
+		resource += "/" + segs[2]
+	}
+	return resource
+}
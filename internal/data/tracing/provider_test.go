@@ -0,0 +1,89 @@
+package tracing
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collector is an in-process OTLP/HTTP trace receiver.
+type collector struct {
+	mu    sync.Mutex
+	spans []*tracepb.Span
+	attrs map[string]string
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil || r.URL.Path != "/v1/traces" {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	var req collectortrace.ExportTraceServiceRequest
+	if err := proto.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, rs := range req.ResourceSpans {
+		for _, kv := range rs.GetResource().GetAttributes() {
+			c.attrs[kv.Key] = kv.Value.GetStringValue()
+		}
+		for _, ss := range rs.ScopeSpans {
+			c.spans = append(c.spans, ss.Spans...)
+		}
+	}
+	out, _ := proto.Marshal(&collectortrace.ExportTraceServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(out)
+}
+
+func TestProviderExportsToCollector(t *testing.T) {
+	c := &collector{attrs: map[string]string{}}
+	server := httptest.NewServer(c)
+	defer server.Close()
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", server.URL)
+	t.Setenv("OTEL_SERVICE_NAME", "")
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "")
+
+	exporter, err := NewOTLPExporter(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tp, err := NewProvider(t.Context(), "connect-go-test", exporter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracer := tp.Tracer("test")
+	ctx, parent := tracer.Start(t.Context(), "VLLMServiceImpl.Start")
+	_, child := tracer.Start(ctx, "kubernetes.patch")
+	child.End()
+	parent.End()
+	if err := tp.Shutdown(t.Context()); err != nil {
+		t.Fatalf("Shutdown() = %v", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if got := c.attrs["service.name"]; got != "connect-go-test" {
+		t.Errorf("service.name = %q, want connect-go-test", got)
+	}
+	byName := map[string]*tracepb.Span{}
+	for _, s := range c.spans {
+		byName[s.Name] = s
+	}
+	p, ch := byName["VLLMServiceImpl.Start"], byName["kubernetes.patch"]
+	if p == nil || ch == nil {
+		t.Fatalf("collector got spans %v, want the parent and child", c.spans)
+	}
+	if string(ch.ParentSpanId) != string(p.SpanId) || string(ch.TraceId) != string(p.TraceId) {
+		t.Errorf("child span is not parented to the service span")
+	}
+}
@@ -0,0 +1,205 @@
+package vllm
+
+import (
+	"bytes"
+	logCore "connect-go/internal/core/logging"
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// AdapterTarget reads a runtime together with the adapters in spec.adapters.
+func (a *VLLMAPI) AdapterTarget(ctx context.Context, namespace, name string) (*domain.AdapterTarget, string, error) {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, "", err
+	}
+	obj, err := newTracedResource(dynamicClient, namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, "", fmt.Errorf("%w: VLLM resource %q not found", domain.ErrAdapterNotFound, name)
+		}
+		return nil, "", fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+	}
+	v, err := typedVLLM(obj)
+	if err != nil {
+		return nil, "", err
+	}
+	t := &domain.AdapterTarget{
+		Namespace: namespace,
+		Name:      name,
+		Model:     v.Spec.Model,
+		Phase:     v.Status.Phase,
+		Endpoint:  engineEndpoint(v),
+		Args:      v.Spec.Args,
+	}
+	for _, ad := range v.Spec.Adapters {
+		t.Adapters = append(t.Adapters, domain.Adapter{Name: ad.Name, StorageURI: ad.StorageURI})
+	}
+	return t, v.ResourceVersion, nil
+}
+
+// SetAdapters writes spec.adapters and reconciles spec.args and the engine
+// environment so a restarted runtime serves the same adapters. resourceVersion
+// guards against concurrent changes.
+func (a *VLLMAPI) SetAdapters(ctx context.Context, namespace, name, resourceVersion string, adapters []domain.Adapter) (*domain.SpecChange, error) {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	resourceClient := newTracedResource(dynamicClient, namespace)
+	existing, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+	}
+	args, _, _ := unstructured.NestedStringSlice(existing.Object, "spec", "args")
+	if args, err = domain.LoRAArgs(args, adapters); err != nil {
+		return nil, err
+	}
+	env, _, _ := unstructured.NestedSlice(existing.Object, "spec", "vllmConfig", "env")
+	if len(adapters) > 0 {
+		env = withEnv(env, domain.EnvRuntimeLoRAUpdating, "True")
+	}
+	specAdapters := make([]map[string]interface{}, 0, len(adapters))
+	for _, ad := range adapters {
+		specAdapters = append(specAdapters, map[string]interface{}{"name": ad.Name, "storageUri": ad.StorageURI})
+	}
+
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": resourceVersion,
+		},
+		"spec": map[string]interface{}{
+			"adapters": specAdapters,
+			"args":     args,
+			"vllmConfig": map[string]interface{}{
+				"env": env,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patch: %w", err)
+	}
+	patched, err := resourceClient.Patch(ctx, name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch adapters of VLLM resource %q: %w", name, err)
+	}
+	return &domain.SpecChange{Resource: name, Before: specOf(existing), After: specOf(patched)}, nil
+}
+
+// SetAdapterStatus records the adapters and whether the engine serves them in status.adapters.
+func (a *VLLMAPI) SetAdapterStatus(ctx context.Context, namespace, name string, adapters []domain.AdapterStatus) error {
+	list := make([]map[string]interface{}, 0, len(adapters))
+	for _, ad := range adapters {
+		list = append(list, map[string]interface{}{
+			"name":       ad.Name,
+			"storageUri": ad.StorageURI,
+			"loaded":     ad.Loaded,
+		})
+	}
+	return a.patchStatus(ctx, namespace, name, map[string]interface{}{
+		"adapters": list,
+	})
+}
+
+func withEnv(env []interface{}, name, value string) []interface{} {
+	for _, e := range env {
+		if m, ok := e.(map[string]interface{}); ok && m["name"] == name {
+			m["value"] = value
+			return env
+		}
+	}
+	return append(env, map[string]interface{}{"name": name, "value": value})
+}
+
+// LoRAClient calls vLLM's dynamic LoRA endpoints on a running engine.
+type LoRAClient struct {
+	http *http.Client
+}
+
+func NewLoRAClient(httpClient *http.Client) *LoRAClient {
+	if httpClient == nil {
+		// Loading an adapter reads its weights, which can take a while.
+		httpClient = &http.Client{Timeout: 2 * time.Minute, Transport: logCore.RequestIDTransport(nil)}
+	}
+	return &LoRAClient{http: httpClient}
+}
+
+func (c *LoRAClient) Load(ctx context.Context, endpoint, name, path string) (err error) {
+	ctx, span := tracing.Start(ctx, "LoRAClient.Load", trace.WithAttributes(attribute.String("vllm.adapter", name)))
+	defer func() { tracing.End(span, err) }()
+	return c.post(ctx, endpoint+"/v1/load_lora_adapter", map[string]string{"lora_name": name, "lora_path": path})
+}
+
+func (c *LoRAClient) Unload(ctx context.Context, endpoint, name string) (err error) {
+	ctx, span := tracing.Start(ctx, "LoRAClient.Unload", trace.WithAttributes(attribute.String("vllm.adapter", name)))
+	defer func() { tracing.End(span, err) }()
+	return c.post(ctx, endpoint+"/v1/unload_lora_adapter", map[string]string{"lora_name": name})
+}
+
+// Loaded returns the names of the adapters the engine currently serves, i.e. the
+// models listed by /v1/models that have a parent model.
+func (c *LoRAClient) Loaded(ctx context.Context, endpoint string) (map[string]bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/v1/models", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s from %s/v1/models", resp.Status, endpoint)
+	}
+	var models struct {
+		Data []struct {
+			ID     string `json:"id"`
+			Parent string `json:"parent"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&models); err != nil {
+		return nil, fmt.Errorf("failed to decode models from %s: %w", endpoint, err)
+	}
+	loaded := map[string]bool{}
+	for _, m := range models.Data {
+		if m.Parent != "" {
+			loaded[m.ID] = true
+		}
+	}
+	return loaded, nil
+}
+
+func (c *LoRAClient) post(ctx context.Context, url string, body interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s returned %s: %s", url, resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
@@ -1,29 +1,39 @@
 package vllm
 
 import (
+	vllmlisters "connect-go/api/vllm/client/listers/vllm/v1"
+	vllmv1 "connect-go/api/vllm/v1"
+	"connect-go/internal/core/tracing"
 	domain "connect-go/internal/core/vllm"
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
-	"os/exec"
+	"log/slog"
+	"slices"
 
-	"go.yaml.in/yaml/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 )
 
-var vllmGVR = schema.GroupVersionResource{
-	Group:    "vllm.ai",
-	Version:  "v1",
-	Resource: "vllms",
-}
+var vllmGVR = vllmv1.VLLMResource
 
 type VLLMAPI struct {
 	Endpoint string
+	// Client is used for all VLLM calls when set; otherwise a client is built
+	// from KUBECONFIG on every call.
+	Client dynamic.Interface
+	// Storage, when set, checks spec.storageUri and generates the volumes the
+	// runtime needs to reach the artifact before every start.
+	Storage *StorageResolver
+	// Lister, when set, serves lists of VLLM resources from a shared informer
+	// cache instead of the API server. Reads before a write still go to the
+	// API server.
+	Lister vllmlisters.VLLMLister
 }
 
 func NewVLLMAPI(endpoint string) *VLLMAPI {
@@ -33,71 +43,93 @@ func NewVLLMAPI(endpoint string) *VLLMAPI {
 }
 
 // Start creates or updates a vLLM resource in Kubernetes to initiate the start action.
-func (a *VLLMAPI) Start(namespace, model string) error {
+// With dryRun the API server validates and renders the change without storing it.
+func (a *VLLMAPI) Start(ctx context.Context, namespace, model string, dryRun bool) (_ *domain.SpecChange, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMAPI.Start", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.model", model),
+		attribute.Bool("vllm.dry_run", dryRun),
+	))
+	defer func() { tracing.End(span, err) }()
+
 	obj, err := loadAndValidateYAML(model)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	resourceName := obj.GetName()
 	if resourceName == "" {
-		return fmt.Errorf("YAML must specify metadata.name for the resource")
+		return nil, fmt.Errorf("YAML must specify metadata.name for the resource")
 	}
+	change := &domain.SpecChange{Resource: resourceName, Template: templatePath(model), DryRun: dryRun}
 
 	// Override model and runtimeName if necessary.
 	modelInYaml, found, err := unstructured.NestedString(obj.Object, "spec", "model")
 	if err != nil {
-		return fmt.Errorf("failed to get spec.model from YAML: %w", err)
+		return nil, fmt.Errorf("failed to get spec.model from YAML: %w", err)
 	}
 	if !found || model != modelInYaml {
 		if !found {
-			fmt.Printf("spec.model not found in YAML; setting to %s\n", model)
+			slog.DebugContext(ctx, "spec.model not set in template; using requested model", "model", model)
 		} else {
-			fmt.Printf("Overriding spec.model from %s to %s\n", modelInYaml, model)
+			slog.InfoContext(ctx, "overriding spec.model from template", "from", modelInYaml, "to", model)
 		}
 		if err := unstructured.SetNestedField(obj.Object, model, "spec", "model"); err != nil {
-			return fmt.Errorf("failed to set spec.model: %w", err)
+			return nil, fmt.Errorf("failed to set spec.model: %w", err)
 		}
 		if err := unstructured.SetNestedField(obj.Object, model, "spec", "runtimeName"); err != nil {
-			return fmt.Errorf("failed to set spec.runtimeName: %w", err)
+			return nil, fmt.Errorf("failed to set spec.runtimeName: %w", err)
 		}
 	}
 
 	// Set action to "start" in the object (for creation or as base for patch).
 	if err := unstructured.SetNestedField(obj.Object, "start", "spec", "action"); err != nil {
-		return fmt.Errorf("failed to set spec.action: %w", err)
+		return nil, fmt.Errorf("failed to set spec.action: %w", err)
 	}
 
 	dynamicClient, err := a.getDynamicClient()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if namespace == "" {
 		namespace = "default"
 	}
 
-	ctx := context.Background()
-	resourceClient := dynamicClient.Resource(vllmGVR).Namespace(namespace)
+	var artifact *domain.Artifact
+	if a.Storage != nil {
+		if artifact, err = a.Storage.Resolve(ctx, namespace, obj); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err == nil && artifact != nil && !dryRun {
+				a.recordArtifact(ctx, namespace, resourceName, artifact)
+			}
+		}()
+	}
+
+	resourceClient := newTracedResource(dynamicClient, namespace)
 
 	// Check if the resource exists.
-	_, err = resourceClient.Get(ctx, resourceName, metav1.GetOptions{})
+	existing, err := resourceClient.Get(ctx, resourceName, metav1.GetOptions{})
 	if err != nil {
 		if !errors.IsNotFound(err) {
-			return fmt.Errorf("failed to get VLLM resource %q: %w", resourceName, err)
+			return nil, fmt.Errorf("failed to get VLLM resource %q: %w", resourceName, err)
 		}
 		// Resource does not exist: create it.
-		_, err = resourceClient.Create(ctx, obj, metav1.CreateOptions{})
+		created, err := resourceClient.Create(ctx, obj, metav1.CreateOptions{DryRun: dryRunOption(dryRun)})
 		if err != nil {
-			return fmt.Errorf("failed to create VLLM resource %q: %w", resourceName, err)
+			return nil, fmt.Errorf("failed to create VLLM resource %q: %w", resourceName, err)
 		}
-		fmt.Printf("Created VLLM resource in Kubernetes: %s (model: %s)\n", resourceName, model)
-		return nil
+		slog.InfoContext(ctx, "created VLLM resource", "namespace", namespace, "resource", resourceName, "model", model, "dryRun", dryRun)
+		change.After, change.Object = specOf(created), created.Object
+		return change, nil
 	}
+	change.Before = specOf(existing)
 
 	// Resource exists: patch spec.action to "start" (and model/runtimeName if overridden).
 	updatedSpec, found, err := unstructured.NestedMap(obj.Object, "spec")
 	if err != nil || !found {
-		return fmt.Errorf("failed to extract spec from YAML: %w", err)
+		return nil, fmt.Errorf("failed to extract spec from YAML: %w", err)
 	}
 
 	// Create merge patch for spec.
@@ -106,50 +138,59 @@ func (a *VLLMAPI) Start(namespace, model string) error {
 	}
 	patchBytes, err := json.Marshal(patch)
 	if err != nil {
-		return fmt.Errorf("failed to marshal patch: %w", err)
+		return nil, fmt.Errorf("failed to marshal patch: %w", err)
 	}
 
 	// Apply patch.
-	_, err = resourceClient.Patch(ctx, resourceName, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	patched, err := resourceClient.Patch(ctx, resourceName, types.MergePatchType, patchBytes, metav1.PatchOptions{DryRun: dryRunOption(dryRun)})
 	if err != nil {
-		return fmt.Errorf("failed to patch VLLM resource %q: %w", resourceName, err)
+		return nil, fmt.Errorf("failed to patch VLLM resource %q: %w", resourceName, err)
 	}
 
-	fmt.Printf("Updated VLLM resource in Kubernetes: %s (model: %s, action: start)\n", resourceName, model)
-	return nil
+	slog.InfoContext(ctx, "patched VLLM resource", "namespace", namespace, "resource", resourceName, "model", model, "action", domain.ActionStart, "dryRun", dryRun)
+	change.After, change.Object = specOf(patched), patched.Object
+	return change, nil
 }
 
 // Stop updates an existing vLLM resource in Kubernetes to initiate the stop action.
-func (a *VLLMAPI) Stop(namespace, model string) error {
+func (a *VLLMAPI) Stop(ctx context.Context, namespace, model string, dryRun bool) (_ *domain.SpecChange, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMAPI.Stop", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.model", model),
+		attribute.Bool("vllm.dry_run", dryRun),
+	))
+	defer func() { tracing.End(span, err) }()
+
 	obj, err := loadAndValidateYAML(model)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	resourceName := obj.GetName()
 	if resourceName == "" {
-		return fmt.Errorf("YAML must specify metadata.name for the resource")
+		return nil, fmt.Errorf("YAML must specify metadata.name for the resource")
 	}
+	change := &domain.SpecChange{Resource: resourceName, Template: templatePath(model), DryRun: dryRun}
 
 	dynamicClient, err := a.getDynamicClient()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if namespace == "" {
 		namespace = "default"
 	}
 
-	ctx := context.Background()
-	resourceClient := dynamicClient.Resource(vllmGVR).Namespace(namespace)
+	resourceClient := newTracedResource(dynamicClient, namespace)
 
 	// Check if the resource exists.
-	_, err = resourceClient.Get(ctx, resourceName, metav1.GetOptions{})
+	existing, err := resourceClient.Get(ctx, resourceName, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return fmt.Errorf("VLLM resource %q not found; cannot stop", resourceName)
+			return nil, fmt.Errorf("%w: %q; cannot stop", domain.ErrRuntimeNotFound, resourceName)
 		}
-		return fmt.Errorf("failed to get VLLM resource %q: %w", resourceName, err)
+		return nil, fmt.Errorf("failed to get VLLM resource %q: %w", resourceName, err)
 	}
+	change.Before = specOf(existing)
 
 	// Create merge patch for spec.action.
 	patch := map[string]interface{}{
@@ -159,60 +200,56 @@ func (a *VLLMAPI) Stop(namespace, model string) error {
 	}
 	patchBytes, err := json.Marshal(patch)
 	if err != nil {
-		return fmt.Errorf("failed to marshal patch: %w", err)
+		return nil, fmt.Errorf("failed to marshal patch: %w", err)
 	}
 
 	// Apply patch.
-	_, err = resourceClient.Patch(ctx, resourceName, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	patched, err := resourceClient.Patch(ctx, resourceName, types.MergePatchType, patchBytes, metav1.PatchOptions{DryRun: dryRunOption(dryRun)})
 	if err != nil {
-		return fmt.Errorf("failed to patch VLLM resource %q: %w", resourceName, err)
+		return nil, fmt.Errorf("failed to patch VLLM resource %q: %w", resourceName, err)
 	}
 
-	fmt.Printf("Updated VLLM resource in Kubernetes to stop: %s (model: %s)\n", resourceName, model)
-	return nil
+	slog.InfoContext(ctx, "patched VLLM resource", "namespace", namespace, "resource", resourceName, "model", model, "action", domain.ActionStop, "dryRun", dryRun)
+	change.After, change.Object = specOf(patched), patched.Object
+	return change, nil
 }
 
 // Get lists and displays all vLLM custom resources in the namespace that are currently in the "Running" status phase.
-func (a *VLLMAPI) Get(namespace string) ([]domain.VLLMResource, error) {
+func (a *VLLMAPI) Get(ctx context.Context, namespace string) (_ []domain.VLLMResource, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMAPI.Get", trace.WithAttributes(attribute.String("vllm.namespace", namespace)))
+	defer func() { tracing.End(span, err) }()
+
 	if namespace == "" {
 		namespace = "default"
 	}
 
-	dynamicClient, err := a.getDynamicClient()
+	list, err := a.listVLLMs(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx := context.Background()
-	resourceClient := dynamicClient.Resource(vllmGVR).Namespace(namespace)
-
-	// List all vLLM resources.
-	list, err := resourceClient.List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list VLLM resources: %w", err)
-	}
-
 	var runningResources []domain.VLLMResource
-	for _, item := range list.Items {
-		phase, foundPhase, err := unstructured.NestedString(item.Object, "status", "phase")
-		if err != nil || !foundPhase || phase != "Running" {
+	for _, v := range list {
+		if v.Status.Phase != "Running" {
 			continue
 		}
 
-		model, foundModel, err := unstructured.NestedString(item.Object, "spec", "model")
-		if err != nil || !foundModel {
+		model := v.Spec.Model
+		if model == "" {
 			model = "unknown"
 		}
 
-		resourceName := item.GetName()
 		runningResources = append(runningResources, domain.VLLMResource{
-			Name:  resourceName,
-			Model: model,
-			Phase: phase,
+			Name:           v.Name,
+			RuntimeName:    v.Spec.RuntimeName,
+			Model:          model,
+			Phase:          v.Status.Phase,
+			Conditions:     slices.Clone(v.Status.Conditions),
+			NextTransition: nextTransitionOf(v),
 		})
 	}
 	if len(runningResources) == 0 {
-		fmt.Println("No running vLLM resources found.")
+		slog.DebugContext(ctx, "no running VLLM resources found", "namespace", namespace)
 	}
 
 	return runningResources, nil
@@ -230,74 +267,199 @@ type CreateParams struct {
 	TensorParallelSize     int64
 	EnablePromptTokenStats bool
 	Replicas               int
+	// Spec is merged over the generated spec, so callers can set any field.
+	Spec map[string]interface{}
 }
 
-// Create and apply VLLM CR
-func (a *VLLMAPI) Create(p CreateParams) error {
-	// Build args
-	args := buildArgs(p)
+// Create creates a VLLM resource from p. It fails with domain.ErrRuntimeExists
+// if the resource is already there.
+func (a *VLLMAPI) Create(ctx context.Context, p CreateParams, dryRun bool) (_ *domain.SpecChange, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMAPI.Create", trace.WithAttributes(
+		attribute.String("vllm.namespace", p.Namespace),
+		attribute.String("vllm.model", p.Model),
+		attribute.Bool("vllm.dry_run", dryRun),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	if p.Namespace == "" {
+		p.Namespace = "default"
+	}
+	if p.RuntimeName == "" {
+		p.RuntimeName = p.Name
+	}
+	obj, err := toUnstructured(newCR(p))
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := toJSONMap(p.Spec)
+	if err != nil {
+		return nil, err
+	}
+	spec := specOf(obj)
+	mergeSpec(spec, overrides)
+	if model, _, _ := unstructured.NestedString(spec, "model"); model == "" || p.Name == "" {
+		return nil, fmt.Errorf("%w: name and model are required", domain.ErrInvalidSpec)
+	}
+	if err := unstructured.SetNestedMap(obj.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to set spec: %w", err)
+	}
+	change := &domain.SpecChange{Resource: p.Name, DryRun: dryRun}
+
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	if a.Storage != nil {
+		artifact, err := a.Storage.Resolve(ctx, p.Namespace, obj)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err == nil && artifact != nil && !dryRun {
+				a.recordArtifact(ctx, p.Namespace, p.Name, artifact)
+			}
+		}()
+	}
+
+	created, err := newTracedResource(dynamicClient, p.Namespace).Create(ctx, obj, metav1.CreateOptions{DryRun: dryRunOption(dryRun)})
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("%w: %s/%s", domain.ErrRuntimeExists, p.Namespace, p.Name)
+		}
+		return nil, fmt.Errorf("failed to create VLLM resource %q: %w", p.Name, err)
+	}
+	slog.InfoContext(ctx, "created VLLM resource", "namespace", p.Namespace, "resource", p.Name, "dryRun", dryRun)
+	change.After, change.Object = specOf(created), created.Object
+	return change, nil
+}
 
-	// Build DeviceRequests
-	deviceRequests := buildDeviceRequests(p.DeviceIDs)
+// Update merges spec into the spec of an existing VLLM resource. The write is
+// conditional on the resourceVersion that was read.
+func (a *VLLMAPI) Update(ctx context.Context, namespace, name string, spec map[string]interface{}, dryRun bool) (_ *domain.SpecChange, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMAPI.Update", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.resource", name),
+		attribute.Bool("vllm.dry_run", dryRun),
+	))
+	defer func() { tracing.End(span, err) }()
 
-	// CR struct
-	cr := domain.VLLMCR{
-		APIVersion: "vllm.ai/v1",
-		Kind:       "VLLM",
-		Metadata: map[string]string{
-			"name":      p.Name,
-			"namespace": p.Namespace,
-		},
-		Spec: map[string]interface{}{
-			"namespace":   p.Namespace,
-			"model":       p.Model,
-			"runtimeName": p.RuntimeName,
-			"replicas":    p.Replicas,
-			"args":        args,
-			"storageUri":  p.StorageUri,
-			"action":      "start",
-			"vllmConfig": map[string]interface{}{
-				"port": 8000,
-				"v1":   true,
-				"env": []map[string]string{
-					{"name": "HF_HOME", "value": "/data"},
-				},
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("%w: nothing to update", domain.ErrInvalidSpec)
+	}
+	spec, err = toJSONMap(spec)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	resourceClient := newTracedResource(dynamicClient, namespace)
+	existing, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s/%s", domain.ErrRuntimeNotFound, namespace, name)
+		}
+		return nil, fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+	}
+	change := &domain.SpecChange{Resource: name, Before: specOf(existing), DryRun: dryRun}
+
+	obj := existing.DeepCopy()
+	merged := specOf(obj)
+	if merged == nil {
+		merged = map[string]interface{}{}
+	}
+	mergeSpec(merged, spec)
+	if err := unstructured.SetNestedMap(obj.Object, merged, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to set spec: %w", err)
+	}
+	if _, ok := spec["storageUri"]; ok && a.Storage != nil {
+		artifact, err := a.Storage.Resolve(ctx, namespace, obj)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err == nil && artifact != nil && !dryRun {
+				a.recordArtifact(ctx, namespace, name, artifact)
+			}
+		}()
+	}
+
+	updated, err := resourceClient.Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(dryRun)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update VLLM resource %q: %w", name, err)
+	}
+	slog.InfoContext(ctx, "updated VLLM resource", "namespace", namespace, "resource", name, "dryRun", dryRun)
+	change.After, change.Object = specOf(updated), updated.Object
+	return change, nil
+}
+
+// newCR renders the VLLM resource for p with the default runtime settings.
+func newCR(p CreateParams) domain.VLLMCR {
+	spec := map[string]interface{}{
+		"namespace":   p.Namespace,
+		"model":       p.Model,
+		"runtimeName": p.RuntimeName,
+		"replicas":    p.Replicas,
+		"action":      "start",
+		"vllmConfig": map[string]interface{}{
+			"port": domain.DefaultPort,
+			"v1":   true,
+			"env": []map[string]string{
+				{"name": "HF_HOME", "value": domain.DefaultHFHome},
 			},
-			"deploymentConfig": map[string]interface{}{
-				"resources": map[string]interface{}{
-					"limits": map[string]string{
-						"nvidia.com/gpu": fmt.Sprintf("%d", len(p.DeviceIDs)),
-					},
-					"requests": map[string]string{
-						"cpu":    "10",
-						"memory": "32Gi",
-					},
+		},
+		"deploymentConfig": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"limits": map[string]string{
+					domain.ResourceGPU: fmt.Sprintf("%d", len(p.DeviceIDs)),
 				},
-				"deviceRequests": deviceRequests,
-				"image": map[string]string{
-					"registry":   "docker.io",
-					"name":       "lmcache/vllm-openai:2025-05-27-v1",
-					"pullPolicy": "IfNotPresent",
+				"requests": map[string]string{
+					"cpu":    "10",
+					"memory": "32Gi",
 				},
 			},
+			"image": map[string]string{
+				"registry":   domain.DefaultImageRegistry,
+				"name":       domain.DefaultImageName,
+				"pullPolicy": domain.DefaultImagePullPolicy,
+			},
 		},
 	}
-
-	// Write YAML
-	yamlFile := fmt.Sprintf("%s.yaml", p.Name)
-	if err := writeYAML(cr, yamlFile); err != nil {
-		return err
+	if args := buildArgs(p); len(args) > 0 {
+		spec["args"] = args
+	}
+	if p.StorageUri != "" {
+		spec["storageUri"] = p.StorageUri
+	}
+	if deviceRequests := buildDeviceRequests(p.DeviceIDs); deviceRequests != nil {
+		spec["deploymentConfig"].(map[string]interface{})["deviceRequests"] = deviceRequests
+	}
+	return domain.VLLMCR{
+		APIVersion: "vllm.ai/v1",
+		Kind:       "VLLM",
+		Metadata: map[string]string{
+			"name":      p.Name,
+			"namespace": p.Namespace,
+		},
+		Spec: spec,
 	}
-
-	// Apply CR
-	return applyYAML(yamlFile)
 }
 
+// buildArgs turns the engine options that are set into vLLM flags.
 func buildArgs(p CreateParams) []string {
-	args := []string{
-		fmt.Sprintf("--gpu-memory-utilization=%.1f", p.GPUMemoryUtilization),
-		fmt.Sprintf("--max-model-len=%d", p.MaxModelLen),
-		fmt.Sprintf("--tensor-parallel-size=%d", p.TensorParallelSize),
+	var args []string
+	if p.GPUMemoryUtilization > 0 {
+		args = append(args, fmt.Sprintf("--gpu-memory-utilization=%.1f", p.GPUMemoryUtilization))
+	}
+	if p.MaxModelLen > 0 {
+		args = append(args, fmt.Sprintf("--max-model-len=%d", p.MaxModelLen))
+	}
+	if p.TensorParallelSize > 0 {
+		args = append(args, fmt.Sprintf("--tensor-parallel-size=%d", p.TensorParallelSize))
 	}
 	if p.EnablePromptTokenStats {
 		args = append(args, "--enable-prompt-tokens-details")
@@ -319,25 +481,57 @@ func buildDeviceRequests(deviceIDs []string) []map[string]interface{} {
 	}
 }
 
-func writeYAML(cr interface{}, file string) error {
-	yamlBytes, err := yaml.Marshal(cr)
+// toUnstructured converts a typed object to its JSON form.
+func toUnstructured(v interface{}) (*unstructured.Unstructured, error) {
+	b, err := json.Marshal(v)
 	if err != nil {
-		return fmt.Errorf("failed to marshal YAML: %w", err)
+		return nil, fmt.Errorf("failed to marshal VLLM resource: %w", err)
 	}
-	if err := os.WriteFile(file, yamlBytes, 0644); err != nil {
-		return fmt.Errorf("failed to write YAML file: %w", err)
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(b); err != nil {
+		return nil, fmt.Errorf("failed to decode VLLM resource: %w", err)
 	}
-	fmt.Printf("✅ VLLM CR YAML generated: %s\n", file)
-	return nil
+	return obj, nil
 }
 
-func applyYAML(file string) error {
-	cmd := exec.Command("kubectl", "apply", "-f", file)
-	cmd.Env = os.Environ()
-	output, err := cmd.CombinedOutput()
+// toJSONMap normalizes caller-supplied values to the types JSON decoding
+// produces, which is all unstructured objects can hold.
+func toJSONMap(m map[string]interface{}) (map[string]interface{}, error) {
+	if m == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(m)
 	if err != nil {
-		return fmt.Errorf("kubectl apply error: %v\n%s", err, string(output))
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidSpec, err)
+	}
+	var out map[string]interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidSpec, err)
+	}
+	return out, nil
+}
+
+// mergeSpec merges src into dst like a JSON merge patch: maps merge
+// recursively, other values replace, and nil deletes.
+func mergeSpec(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		if sm, ok := v.(map[string]interface{}); ok {
+			if dm, ok := dst[k].(map[string]interface{}); ok {
+				mergeSpec(dm, sm)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+func dryRunOption(dryRun bool) []string {
+	if dryRun {
+		return []string{metav1.DryRunAll}
 	}
-	fmt.Printf("✅ kubectl apply success:\n%s\n", string(output))
 	return nil
 }
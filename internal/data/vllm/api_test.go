@@ -0,0 +1,175 @@
+package vllm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+func TestMergeSpec(t *testing.T) {
+	dst := map[string]interface{}{
+		"model":    "llama",
+		"replicas": int64(1),
+		"args":     []interface{}{"--max-model-len=4096"},
+		"deploymentConfig": map[string]interface{}{
+			"image":        map[string]interface{}{"name": "vllm/vllm-openai:v0.9.0", "pullPolicy": "IfNotPresent"},
+			"nodeSelector": map[string]interface{}{"gpu": "h100"},
+		},
+	}
+	mergeSpec(dst, map[string]interface{}{
+		"replicas": int64(2),
+		"args":     []interface{}{"--enforce-eager"},
+		"deploymentConfig": map[string]interface{}{
+			"image":        map[string]interface{}{"name": "vllm/vllm-openai:v0.10.0"},
+			"nodeSelector": nil,
+		},
+		"storageUri": "s3://weights/llama",
+	})
+	want := map[string]interface{}{
+		"model":    "llama",
+		"replicas": int64(2),
+		// Lists are replaced, not merged.
+		"args": []interface{}{"--enforce-eager"},
+		"deploymentConfig": map[string]interface{}{
+			"image": map[string]interface{}{"name": "vllm/vllm-openai:v0.10.0", "pullPolicy": "IfNotPresent"},
+		},
+		"storageUri": "s3://weights/llama",
+	}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("mergeSpec() = %v, want %v", dst, want)
+	}
+}
+
+func TestDryRunOption(t *testing.T) {
+	if got := dryRunOption(true); !slices.Equal(got, []string{metav1.DryRunAll}) {
+		t.Errorf("dryRunOption(true) = %v, want [All]", got)
+	}
+	if got := dryRunOption(false); got != nil {
+		t.Errorf("dryRunOption(false) = %v, want nil", got)
+	}
+}
+
+// dryRunRecorder records the DryRun option of every write to VLLM resources.
+type dryRunRecorder struct {
+	dynamic.Interface
+	writes map[string][]string
+}
+
+func (r *dryRunRecorder) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return recordingResource{r.Interface.Resource(gvr), r}
+}
+
+type recordingResource struct {
+	dynamic.NamespaceableResourceInterface
+	recorder *dryRunRecorder
+}
+
+func (r recordingResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return recordingNamespacedResource{r.NamespaceableResourceInterface.Namespace(namespace), r.recorder}
+}
+
+type recordingNamespacedResource struct {
+	dynamic.ResourceInterface
+	recorder *dryRunRecorder
+}
+
+func (r recordingNamespacedResource) Create(ctx context.Context, obj *unstructured.Unstructured, opts metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	r.recorder.writes["create"] = opts.DryRun
+	return r.ResourceInterface.Create(ctx, obj, opts, subresources...)
+}
+
+func (r recordingNamespacedResource) Update(ctx context.Context, obj *unstructured.Unstructured, opts metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	r.recorder.writes["update"] = opts.DryRun
+	return r.ResourceInterface.Update(ctx, obj, opts, subresources...)
+}
+
+func (r recordingNamespacedResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	r.recorder.writes["patch"] = opts.DryRun
+	return r.ResourceInterface.Patch(ctx, name, pt, data, opts, subresources...)
+}
+
+func (r recordingNamespacedResource) Delete(ctx context.Context, name string, opts metav1.DeleteOptions, subresources ...string) error {
+	r.recorder.writes["delete"] = opts.DryRun
+	return r.ResourceInterface.Delete(ctx, name, opts, subresources...)
+}
+
+// useTemplate makes Start and Stop read a "llama" template from a temporary
+// working directory.
+func useTemplate(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "config", "samples"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	template := "apiVersion: vllm.ai/v1\nkind: VLLM\nmetadata:\n  name: llama\nspec:\n  model: llama\n  runtimeName: llama\n"
+	if err := os.WriteFile(filepath.Join(dir, templatePath("llama")), []byte(template), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+}
+
+func TestDryRunIsPassedOnWrites(t *testing.T) {
+	useTemplate(t)
+	tests := []struct {
+		name     string
+		existing bool
+		write    string
+		call     func(ctx context.Context, a *VLLMAPI, dryRun bool) error
+	}{
+		{"start creates", false, "create", func(ctx context.Context, a *VLLMAPI, dryRun bool) error {
+			_, err := a.Start(ctx, "default", "llama", dryRun)
+			return err
+		}},
+		{"start patches", true, "patch", func(ctx context.Context, a *VLLMAPI, dryRun bool) error {
+			_, err := a.Start(ctx, "default", "llama", dryRun)
+			return err
+		}},
+		{"stop", true, "patch", func(ctx context.Context, a *VLLMAPI, dryRun bool) error {
+			_, err := a.Stop(ctx, "default", "llama", dryRun)
+			return err
+		}},
+		{"create", false, "create", func(ctx context.Context, a *VLLMAPI, dryRun bool) error {
+			_, err := a.Create(ctx, CreateParams{Namespace: "default", Name: "llama", Model: "llama", Replicas: 1}, dryRun)
+			return err
+		}},
+		{"update", true, "update", func(ctx context.Context, a *VLLMAPI, dryRun bool) error {
+			_, err := a.Update(ctx, "default", "llama", map[string]interface{}{"replicas": 2}, dryRun)
+			return err
+		}},
+		{"delete", true, "delete", func(ctx context.Context, a *VLLMAPI, dryRun bool) error {
+			_, err := a.Delete(ctx, "default", "llama", dryRun)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		for _, dryRun := range []bool{true, false} {
+			t.Run(fmt.Sprintf("%s dryRun=%t", tt.name, dryRun), func(t *testing.T) {
+				client := newFakeDynamic()
+				if tt.existing {
+					client = newFakeDynamic(runningVLLM("default", "llama"))
+				}
+				recorder := &dryRunRecorder{Interface: client, writes: map[string][]string{}}
+				if err := tt.call(t.Context(), &VLLMAPI{Client: recorder}, dryRun); err != nil {
+					t.Fatal(err)
+				}
+				got, ok := recorder.writes[tt.write]
+				if !ok {
+					t.Fatalf("writes = %v, want a %s", recorder.writes, tt.write)
+				}
+				if !slices.Equal(got, dryRunOption(dryRun)) {
+					t.Errorf("%s with dryRun=%t sent DryRun %v", tt.write, dryRun, got)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// FileStore checks file:// artifacts on a filesystem the server shares with the
+// runtimes, e.g. the same volume mounted at Root.
+type FileStore struct {
+	Root string
+}
+
+func (s FileStore) Stat(_ context.Context, _ string, loc domain.StorageLocation) (*domain.Artifact, error) {
+	root := filepath.Join(s.Root, filepath.FromSlash(loc.Path))
+	artifact := &domain.Artifact{Location: loc}
+	err := filepath.WalkDir(root, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			artifact.SizeBytes += info.Size()
+			artifact.Files++
+		}
+		return nil
+	})
+	if os.IsNotExist(err) || (err == nil && artifact.Files == 0) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrArtifactNotFound, loc.URI)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", loc.URI, err)
+	}
+	return artifact, nil
+}
+
+// PVCStore checks that the claim of a pvc:// artifact exists and is bound. The
+// reported size is the claim capacity, since its contents are not visible here.
+type PVCStore struct {
+	Clientset kubernetes.Interface
+}
+
+func (s PVCStore) Stat(ctx context.Context, namespace string, loc domain.StorageLocation) (*domain.Artifact, error) {
+	pvc, err := s.Clientset.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, loc.Claim, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: PersistentVolumeClaim %s/%s does not exist", domain.ErrArtifactNotFound, namespace, loc.Claim)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PersistentVolumeClaim %q: %w", loc.Claim, err)
+	}
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return nil, fmt.Errorf("%w: PersistentVolumeClaim %s/%s is %s", domain.ErrArtifactNotFound, namespace, loc.Claim, pvc.Status.Phase)
+	}
+	artifact := &domain.Artifact{Location: loc, SizeBytes: -1}
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		artifact.SizeBytes = capacity.Value()
+	}
+	return artifact, nil
+}
+
+// S3Store lists s3:// artifacts in any S3-compatible object store.
+type S3Store struct {
+	client *minio.Client
+}
+
+// NewS3Store connects to endpoint (host[:port]) with static credentials.
+func NewS3Store(endpoint, accessKey, secretKey, region string, secure bool) (*S3Store, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: secure,
+		Region: region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+	return &S3Store{client: client}, nil
+}
+
+func (s *S3Store) Stat(ctx context.Context, _ string, loc domain.StorageLocation) (*domain.Artifact, error) {
+	artifact := &domain.Artifact{Location: loc}
+	prefix := strings.TrimSuffix(loc.Path, "/") + "/"
+	for obj := range s.client.ListObjects(ctx, loc.Bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			if minio.ToErrorResponse(obj.Err).Code == "NoSuchBucket" {
+				return nil, fmt.Errorf("%w: bucket %q does not exist", domain.ErrArtifactNotFound, loc.Bucket)
+			}
+			return nil, fmt.Errorf("failed to list %s: %w", loc.URI, obj.Err)
+		}
+		artifact.SizeBytes += obj.Size
+		artifact.Files++
+	}
+	if artifact.Files == 0 {
+		return nil, fmt.Errorf("%w: no objects under %s", domain.ErrArtifactNotFound, loc.URI)
+	}
+	return artifact, nil
+}
+
+// HFStore looks up hf:// artifacts through the Hugging Face Hub API.
+type HFStore struct {
+	// Endpoint defaults to https://huggingface.co; a mirror can be used instead.
+	Endpoint string
+	Token    string
+	HTTP     *http.Client
+}
+
+func (s HFStore) Stat(ctx context.Context, _ string, loc domain.StorageLocation) (*domain.Artifact, error) {
+	endpoint := s.Endpoint
+	if endpoint == "" {
+		endpoint = "https://huggingface.co"
+	}
+	client := s.HTTP
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	u := fmt.Sprintf("%s/api/models/%s/revision/%s?blobs=true", strings.TrimRight(endpoint, "/"), loc.Repo, url.PathEscape(loc.Revision))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.Token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %s: %w", loc.URI, err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusUnauthorized, http.StatusForbidden:
+		// The Hub answers 401 for private or missing repositories without a token.
+		return nil, fmt.Errorf("%w: %s (%s)", domain.ErrArtifactNotFound, loc.URI, resp.Status)
+	default:
+		return nil, fmt.Errorf("unexpected status %s looking up %s", resp.Status, loc.URI)
+	}
+	var info struct {
+		Siblings []struct {
+			Size int64 `json:"size"`
+		} `json:"siblings"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", loc.URI, err)
+	}
+	artifact := &domain.Artifact{Location: loc}
+	for _, f := range info.Siblings {
+		artifact.SizeBytes += f.Size
+		artifact.Files++
+	}
+	return artifact, nil
+}
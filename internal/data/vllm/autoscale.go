@@ -0,0 +1,119 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ListAutoscaled returns every Running VLLM resource, in all namespaces, whose
+// spec.autoscaling is enabled and valid.
+func (a *VLLMAPI) ListAutoscaled(ctx context.Context) ([]domain.AutoscaleTarget, error) {
+	list, err := a.listVLLMs(ctx, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []domain.AutoscaleTarget
+	for _, v := range list {
+		if v.Status.Phase != string(domain.StatusRunning) {
+			continue
+		}
+		spec, ok := autoscalingSpecOf(v)
+		if !ok || !spec.Enabled {
+			continue
+		}
+		replicas := int32(1)
+		if v.Spec.Replicas != nil {
+			replicas = *v.Spec.Replicas
+		}
+		target := domain.AutoscaleTarget{
+			Namespace:   v.Namespace,
+			Name:        v.Name,
+			Model:       v.Spec.Model,
+			Replicas:    replicas,
+			Autoscaling: spec,
+			Conditions:  slices.Clone(v.Status.Conditions),
+		}
+		if v.Status.LastScaleTime != nil {
+			target.LastScaleTime = v.Status.LastScaleTime.Time
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// Scale sets spec.replicas and records the decision and scale time in status.
+func (a *VLLMAPI) Scale(ctx context.Context, namespace, name string, replicas int32, cond metav1.Condition) error {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return err
+	}
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch: %w", err)
+	}
+	resourceClient := newTracedResource(dynamicClient, namespace)
+	if _, err := resourceClient.Patch(ctx, name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to scale VLLM resource %q: %w", name, err)
+	}
+	if err := a.patchStatus(ctx, namespace, name, map[string]interface{}{
+		"lastScaleTime": cond.LastTransitionTime,
+	}); err != nil {
+		return err
+	}
+	return setConditions(ctx, dynamicClient, namespace, name, cond)
+}
+
+func (a *VLLMAPI) patchStatus(ctx context.Context, namespace, name string, status map[string]interface{}) error {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return err
+	}
+	patchBytes, err := json.Marshal(map[string]interface{}{"status": status})
+	if err != nil {
+		return fmt.Errorf("failed to marshal status patch: %w", err)
+	}
+	resourceClient := newTracedResource(dynamicClient, namespace)
+	if _, err := resourceClient.Patch(ctx, name, types.MergePatchType, patchBytes, metav1.PatchOptions{}, "status"); err != nil {
+		return fmt.Errorf("failed to patch status of VLLM resource %q: %w", name, err)
+	}
+	return nil
+}
+
+func autoscalingSpecOf(v *vllmv1.VLLM) (domain.AutoscalingSpec, bool) {
+	as := v.Spec.Autoscaling
+	if as == nil {
+		return domain.AutoscalingSpec{}, false
+	}
+	spec := domain.AutoscalingSpec{
+		Enabled:           as.Enabled,
+		MinReplicas:       as.MinReplicas,
+		MaxReplicas:       as.MaxReplicas,
+		Metric:            as.Metric,
+		Target:            as.Target,
+		ScaleUpCooldown:   time.Minute,
+		ScaleDownCooldown: 5 * time.Minute,
+	}
+	if spec.Metric == "" {
+		spec.Metric = domain.AutoscaleMetricQueueDepth
+	}
+	if as.ScaleUpCooldownSeconds != nil {
+		spec.ScaleUpCooldown = time.Duration(*as.ScaleUpCooldownSeconds) * time.Second
+	}
+	if as.ScaleDownCooldownSeconds != nil {
+		spec.ScaleDownCooldown = time.Duration(*as.ScaleDownCooldownSeconds) * time.Second
+	}
+	return spec, spec.Validate() == nil
+}
@@ -0,0 +1,36 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ListBatchTargets returns the VLLM resources in namespace matching the label
+// selector, or all of them when it is empty. Resources being deleted are left
+// out.
+func (a *VLLMAPI) ListBatchTargets(ctx context.Context, namespace, selector string) ([]domain.BatchTarget, error) {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	list, err := newTracedResource(dynamicClient, namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VLLM resources: %w", err)
+	}
+	targets := make([]domain.BatchTarget, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.GetDeletionTimestamp() != nil {
+			continue
+		}
+		action, _, _ := unstructured.NestedString(item.Object, "spec", "action")
+		targets = append(targets, domain.BatchTarget{
+			Name:    item.GetName(),
+			Running: action != domain.ActionStop,
+		})
+	}
+	return targets, nil
+}
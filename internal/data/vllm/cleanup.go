@@ -0,0 +1,272 @@
+package vllm
+
+import (
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// Cleaner removes what a deleted runtime leaves behind: its router
+// registration, the Deployments, Services and PodDisruptionBudgets labelled
+// with domain.LabelRuntime, the PersistentVolumeClaims so labelled that the
+// VLLM resource also owns, and its warm-up DaemonSet. Claims the runtime
+// merely mounts are left alone.
+type Cleaner struct {
+	clientset kubernetes.Interface
+	warmer    *Warmer
+	router    ModelRouter
+}
+
+// NewCleaner returns a Cleaner. warmer and router may be nil.
+func NewCleaner(clientset kubernetes.Interface, warmer *Warmer, router ModelRouter) *Cleaner {
+	return &Cleaner{
+		clientset: clientset,
+		warmer:    warmer,
+		router:    router,
+	}
+}
+
+// ownedKind lists and deletes one kind of namespaced object. Objects of a
+// kind that requires an owner are only deleted when the VLLM resource owns
+// them.
+type ownedKind struct {
+	kind          string
+	requiresOwner bool
+	list          func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, error)
+	delete        func(ctx context.Context, name string, opts metav1.DeleteOptions) error
+}
+
+// Cleanup deregisters the runtime and deletes its objects. It keeps going
+// after a failure and returns every error, so the next pass only retries what
+// is left.
+func (c *Cleaner) Cleanup(ctx context.Context, t domain.CleanupTarget) (err error) {
+	ctx, span := tracing.Start(ctx, "Cleaner.Cleanup", trace.WithAttributes(
+		attribute.String("vllm.namespace", t.Namespace),
+		attribute.String("vllm.resource", t.Name),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	var errs []error
+	// Take the runtime out of the router first, so no request is sent to a
+	// Service that is about to disappear.
+	if c.router != nil {
+		if err := c.router.Deregister(ctx, t.Namespace, t.Name); err != nil {
+			errs = append(errs, fmt.Errorf("failed to deregister from router: %w", err))
+		}
+	}
+	propagation := metav1.DeletePropagationBackground
+	selector := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{domain.LabelRuntime: t.Name}).String(),
+	}
+	for _, k := range c.ownedKinds(t.Namespace) {
+		objs, err := k.list(ctx, selector)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list %ss: %w", k.kind, err))
+			continue
+		}
+		for _, obj := range objs {
+			if k.requiresOwner && !ownedBy(obj, t) {
+				continue
+			}
+			err := k.delete(ctx, obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to delete %s %q: %w", k.kind, obj.GetName(), err))
+			}
+		}
+	}
+	if c.warmer != nil {
+		if err := c.warmer.Cleanup(ctx, t.Namespace, t.Name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Cleaner) ownedKinds(namespace string) []ownedKind {
+	deployments := c.clientset.AppsV1().Deployments(namespace)
+	services := c.clientset.CoreV1().Services(namespace)
+	pdbs := c.clientset.PolicyV1().PodDisruptionBudgets(namespace)
+	claims := c.clientset.CoreV1().PersistentVolumeClaims(namespace)
+	return []ownedKind{
+		{"Deployment", false, func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, error) {
+			l, err := deployments.List(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			return objectsOf(l.Items), nil
+		}, deployments.Delete},
+		{"Service", false, func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, error) {
+			l, err := services.List(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			return objectsOf(l.Items), nil
+		}, services.Delete},
+		{"PodDisruptionBudget", false, func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, error) {
+			l, err := pdbs.List(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			return objectsOf(l.Items), nil
+		}, pdbs.Delete},
+		// A claim may hold weights shared with other runtimes; only the
+		// claims created for this one go.
+		{"PersistentVolumeClaim", true, func(ctx context.Context, opts metav1.ListOptions) ([]metav1.Object, error) {
+			l, err := claims.List(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			return objectsOf(l.Items), nil
+		}, claims.Delete},
+	}
+}
+
+func objectsOf[T any, PT interface {
+	*T
+	metav1.Object
+}](items []T) []metav1.Object {
+	objs := make([]metav1.Object, len(items))
+	for i := range items {
+		objs[i] = PT(&items[i])
+	}
+	return objs
+}
+
+// ownedBy reports whether obj has an owner reference to the VLLM resource.
+func ownedBy(obj metav1.Object, t domain.CleanupTarget) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if t.UID != "" && string(ref.UID) == t.UID {
+			return true
+		}
+	}
+	return false
+}
+
+// Delete deletes a VLLM resource. The resource stays, marked for deletion,
+// until the cleanup controller has removed what the runtime leaves behind.
+// With dryRun the API server only checks that the deletion would succeed.
+func (a *VLLMAPI) Delete(ctx context.Context, namespace, name string, dryRun bool) (_ *domain.SpecChange, err error) {
+	ctx, span := tracing.Start(ctx, "VLLMAPI.Delete", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.resource", name),
+		attribute.Bool("vllm.dry_run", dryRun),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	resourceClient := newTracedResource(dynamicClient, namespace)
+	obj, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s/%s", domain.ErrRuntimeNotFound, namespace, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+	}
+	change := &domain.SpecChange{Resource: name, Object: obj.Object, DryRun: dryRun}
+	change.Before, _ = obj.Object["spec"].(map[string]interface{})
+
+	// The UID precondition keeps a resource recreated since the Get alive.
+	uid := obj.GetUID()
+	propagation := metav1.DeletePropagationBackground
+	err = resourceClient.Delete(ctx, name, metav1.DeleteOptions{
+		DryRun:            dryRunOption(dryRun),
+		PropagationPolicy: &propagation,
+		Preconditions:     &metav1.Preconditions{UID: &uid},
+	})
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s/%s", domain.ErrRuntimeNotFound, namespace, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete VLLM resource %q: %w", name, err)
+	}
+	return change, nil
+}
+
+// ListCleanupTargets returns every VLLM resource in all namespaces with its
+// deletion and finalizer state.
+func (a *VLLMAPI) ListCleanupTargets(ctx context.Context) ([]domain.CleanupTarget, error) {
+	list, err := a.listVLLMs(ctx, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]domain.CleanupTarget, 0, len(list))
+	for _, v := range list {
+		targets = append(targets, domain.CleanupTarget{
+			Namespace:    v.Namespace,
+			Name:         v.Name,
+			UID:          string(v.UID),
+			Deleting:     v.DeletionTimestamp != nil,
+			HasFinalizer: slices.Contains(v.Finalizers, domain.FinalizerCleanup),
+		})
+	}
+	return targets, nil
+}
+
+// AddFinalizer adds domain.FinalizerCleanup to a VLLM resource.
+func (a *VLLMAPI) AddFinalizer(ctx context.Context, namespace, name string) error {
+	return a.updateFinalizers(ctx, namespace, name, func(finalizers []string) []string {
+		if slices.Contains(finalizers, domain.FinalizerCleanup) {
+			return finalizers
+		}
+		return append(finalizers, domain.FinalizerCleanup)
+	})
+}
+
+// RemoveFinalizer removes domain.FinalizerCleanup from a VLLM resource, which
+// lets the API server delete it.
+func (a *VLLMAPI) RemoveFinalizer(ctx context.Context, namespace, name string) error {
+	return a.updateFinalizers(ctx, namespace, name, func(finalizers []string) []string {
+		return slices.DeleteFunc(finalizers, func(f string) bool { return f == domain.FinalizerCleanup })
+	})
+}
+
+// updateFinalizers rewrites metadata.finalizers, retrying on conflict so a
+// finalizer added by someone else in between is not dropped.
+func (a *VLLMAPI) updateFinalizers(ctx context.Context, namespace, name string, update func([]string) []string) error {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return err
+	}
+	resourceClient := newTracedResource(dynamicClient, namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+		}
+		before := obj.GetFinalizers()
+		after := update(slices.Clone(before))
+		if slices.Equal(before, after) {
+			return nil
+		}
+		if after == nil {
+			after = []string{}
+		}
+		patchBytes, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"finalizers":      after,
+				"resourceVersion": obj.GetResourceVersion(),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal finalizer patch: %w", err)
+		}
+		_, err = resourceClient.Patch(ctx, name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+		return err
+	})
+}
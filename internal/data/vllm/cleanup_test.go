@@ -0,0 +1,189 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"slices"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// runtimeMeta is the metadata of an object named name, labelled as part of
+// runtime when it is set and owned by the VLLM resource with ownerUID when
+// that is set.
+func runtimeMeta(name, runtime, ownerUID string) metav1.ObjectMeta {
+	m := metav1.ObjectMeta{Namespace: "default", Name: name}
+	if runtime != "" {
+		m.Labels = map[string]string{domain.LabelRuntime: runtime}
+	}
+	if ownerUID != "" {
+		m.OwnerReferences = []metav1.OwnerReference{{APIVersion: "vllm.ai/v1", Kind: "VLLM", Name: runtime, UID: types.UID(ownerUID)}}
+	}
+	return m
+}
+
+func TestCleanerCleanup(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: runtimeMeta("llama", "llama", "llama-uid")},
+		&corev1.Service{ObjectMeta: runtimeMeta("llama", "llama", "llama-uid")},
+		&policyv1.PodDisruptionBudget{ObjectMeta: runtimeMeta("llama", "llama", "")},
+		&corev1.PersistentVolumeClaim{ObjectMeta: runtimeMeta("llama-cache", "llama", "llama-uid")},
+		// Mounted by the runtime, but not created for it.
+		&corev1.PersistentVolumeClaim{ObjectMeta: runtimeMeta("llama-weights", "llama", "")},
+		&corev1.PersistentVolumeClaim{ObjectMeta: runtimeMeta("shared-weights", "", "")},
+		// Other runtimes, including an earlier llama with another UID.
+		&appsv1.Deployment{ObjectMeta: runtimeMeta("qwen", "qwen", "qwen-uid")},
+		&corev1.Service{ObjectMeta: runtimeMeta("qwen", "qwen", "qwen-uid")},
+		&corev1.PersistentVolumeClaim{ObjectMeta: runtimeMeta("qwen-cache", "qwen", "qwen-uid")},
+		&corev1.PersistentVolumeClaim{ObjectMeta: runtimeMeta("old-llama-cache", "llama", "old-llama-uid")},
+		&appsv1.Deployment{ObjectMeta: runtimeMeta("gateway", "", "")},
+	)
+	router := NewMemoryRouter()
+	for _, name := range []string{"llama", "qwen"} {
+		if err := router.Register(t.Context(), domain.RouterBackend{Namespace: "default", Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cleaner := NewCleaner(clientset, nil, router)
+
+	err := cleaner.Cleanup(t.Context(), domain.CleanupTarget{Namespace: "default", Name: "llama", UID: "llama-uid", Deleting: true})
+	if err != nil {
+		t.Fatalf("Cleanup() = %v", err)
+	}
+
+	var deleted []string
+	for _, a := range clientset.Actions() {
+		switch a := a.(type) {
+		case k8stesting.DeleteAction:
+			deleted = append(deleted, a.GetResource().Resource+"/"+a.GetName())
+		case k8stesting.ListAction:
+			if got := a.GetListRestrictions().Labels.String(); got != domain.LabelRuntime+"=llama" {
+				t.Errorf("%s listed with selector %q, want the runtime's label", a.GetResource().Resource, got)
+			}
+		}
+	}
+	slices.Sort(deleted)
+	want := []string{"deployments/llama", "persistentvolumeclaims/llama-cache", "poddisruptionbudgets/llama", "services/llama"}
+	if !slices.Equal(deleted, want) {
+		t.Errorf("deleted %v, want %v", deleted, want)
+	}
+	for _, name := range []string{"llama-weights", "shared-weights", "qwen-cache", "old-llama-cache"} {
+		if _, err := clientset.CoreV1().PersistentVolumeClaims("default").Get(t.Context(), name, metav1.GetOptions{}); err != nil {
+			t.Errorf("claim %s was removed: %v", name, err)
+		}
+	}
+	if got := router.Backends(); len(got) != 1 || got[0].Name != "qwen" {
+		t.Errorf("router backends = %v, want only qwen", got)
+	}
+
+	// Everything is gone, so a retry finds nothing left to do.
+	if err := cleaner.Cleanup(t.Context(), domain.CleanupTarget{Namespace: "default", Name: "llama", UID: "llama-uid", Deleting: true}); err != nil {
+		t.Errorf("second Cleanup() = %v", err)
+	}
+}
+
+func TestCleanerCleanupKeepsGoing(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: runtimeMeta("llama", "llama", "llama-uid")},
+		&corev1.Service{ObjectMeta: runtimeMeta("llama", "llama", "llama-uid")},
+	)
+	clientset.PrependReactor("delete", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(appsv1.Resource("deployments"), "llama", nil)
+	})
+
+	err := NewCleaner(clientset, nil, nil).Cleanup(t.Context(), domain.CleanupTarget{Namespace: "default", Name: "llama", UID: "llama-uid"})
+	if !apierrors.IsForbidden(err) {
+		t.Fatalf("Cleanup() = %v, want the Deployment's error", err)
+	}
+	if _, err := clientset.CoreV1().Services("default").Get(t.Context(), "llama", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Service survived a failed Deployment deletion: %v", err)
+	}
+}
+
+func TestOwnedBy(t *testing.T) {
+	target := domain.CleanupTarget{Namespace: "default", Name: "llama", UID: "llama-uid"}
+	tests := []struct {
+		name   string
+		meta   metav1.ObjectMeta
+		target domain.CleanupTarget
+		want   bool
+	}{
+		{"owned", runtimeMeta("llama-cache", "llama", "llama-uid"), target, true},
+		{"labelled only", runtimeMeta("llama-cache", "llama", ""), target, false},
+		{"earlier resource", runtimeMeta("llama-cache", "llama", "old-llama-uid"), target, false},
+		{"unknown UID", runtimeMeta("llama-cache", "llama", ""), domain.CleanupTarget{Name: "llama"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ownedBy(&corev1.PersistentVolumeClaim{ObjectMeta: tt.meta}, tt.target); got != tt.want {
+				t.Errorf("ownedBy() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeleteUIDPrecondition(t *testing.T) {
+	obj := runningVLLM("default", "llama")
+	obj.SetUID("llama-uid")
+	client := newFakeDynamic(obj)
+	api := &VLLMAPI{Client: client}
+
+	if _, err := api.Delete(t.Context(), "default", "llama", false); err != nil {
+		t.Fatalf("Delete() = %v", err)
+	}
+	var opts *metav1.DeleteOptions
+	for _, a := range client.Actions() {
+		if d, ok := a.(k8stesting.DeleteActionImpl); ok {
+			opts = &d.DeleteOptions
+		}
+	}
+	if opts == nil {
+		t.Fatal("no delete was sent")
+	}
+	if opts.Preconditions == nil || opts.Preconditions.UID == nil || *opts.Preconditions.UID != "llama-uid" {
+		t.Errorf("preconditions = %+v, want the UID that was read", opts.Preconditions)
+	}
+	if opts.PropagationPolicy == nil || *opts.PropagationPolicy != metav1.DeletePropagationBackground {
+		t.Errorf("propagation = %v, want Background", opts.PropagationPolicy)
+	}
+}
+
+func TestUpdateFinalizers(t *testing.T) {
+	obj := runningVLLM("default", "llama")
+	obj.SetFinalizers([]string{"example.com/keep"})
+	api := &VLLMAPI{Client: newFakeDynamic(obj)}
+	finalizers := func() []string {
+		t.Helper()
+		obj, err := newTracedResource(api.Client, "default").Get(t.Context(), "llama", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return obj.GetFinalizers()
+	}
+
+	for range 2 {
+		if err := api.AddFinalizer(t.Context(), "default", "llama"); err != nil {
+			t.Fatalf("AddFinalizer() = %v", err)
+		}
+		if got, want := finalizers(), []string{"example.com/keep", domain.FinalizerCleanup}; !slices.Equal(got, want) {
+			t.Fatalf("finalizers = %v, want %v", got, want)
+		}
+	}
+	if err := api.RemoveFinalizer(t.Context(), "default", "llama"); err != nil {
+		t.Fatalf("RemoveFinalizer() = %v", err)
+	}
+	if got, want := finalizers(), []string{"example.com/keep"}; !slices.Equal(got, want) {
+		t.Errorf("finalizers = %v, want %v", got, want)
+	}
+	if err := api.AddFinalizer(t.Context(), "default", "missing"); !apierrors.IsNotFound(err) {
+		t.Errorf("AddFinalizer(missing) = %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,105 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+)
+
+// ListConditionTargets returns every VLLM resource in all namespaces with the
+// conditions it currently reports.
+func (a *VLLMAPI) ListConditionTargets(ctx context.Context) ([]domain.ConditionTarget, error) {
+	list, err := a.listVLLMs(ctx, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]domain.ConditionTarget, 0, len(list))
+	for _, v := range list {
+		targets = append(targets, domain.ConditionTarget{
+			Namespace:  v.Namespace,
+			Name:       v.Name,
+			Generation: v.Generation,
+			Running:    v.Spec.Action != vllmv1.ActionStop,
+			Conditions: slices.Clone(v.Status.Conditions),
+		})
+	}
+	return targets, nil
+}
+
+// SetConditions merges conds into status.conditions of a VLLM resource. Only
+// conditions whose status changes get a new lastTransitionTime, and a zero
+// observedGeneration is taken to mean the live generation.
+func (a *VLLMAPI) SetConditions(ctx context.Context, namespace, name string, conds ...metav1.Condition) error {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return err
+	}
+	return setConditions(ctx, dynamicClient, namespace, name, conds...)
+}
+
+// setConditions sets conds on the live object and writes its status back
+// through the status subresource, retrying when another writer got in
+// between. The rest of the status, such as the autoscaler's lastScaleTime,
+// is written back as it was read.
+func setConditions(ctx context.Context, client dynamic.Interface, namespace, name string, conds ...metav1.Condition) error {
+	resourceClient := newTracedResource(client, namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+		}
+		before := conditionsOf(obj)
+		after := slices.Clone(before)
+		for _, c := range conds {
+			if c.ObservedGeneration == 0 {
+				c.ObservedGeneration = obj.GetGeneration()
+			}
+			meta.SetStatusCondition(&after, c)
+		}
+		if equality.Semantic.DeepEqual(before, after) {
+			return nil
+		}
+		raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&struct {
+			Conditions []metav1.Condition `json:"conditions"`
+		}{after})
+		if err != nil {
+			return fmt.Errorf("failed to encode conditions: %w", err)
+		}
+		if err := unstructured.SetNestedField(obj.Object, raw["conditions"], "status", "conditions"); err != nil {
+			return fmt.Errorf("failed to set conditions: %w", err)
+		}
+		// The update carries the resourceVersion that was read, so it fails
+		// with a conflict instead of dropping a status written since the Get.
+		_, err = resourceClient.Update(ctx, obj, metav1.UpdateOptions{}, "status")
+		return err
+	})
+}
+
+// conditionsOf decodes status.conditions. A malformed list reads as empty and
+// is replaced on the next write.
+func conditionsOf(obj *unstructured.Unstructured) []metav1.Condition {
+	raw, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+	var conds []metav1.Condition
+	if err := json.Unmarshal(b, &conds); err != nil {
+		return nil
+	}
+	return conds
+}
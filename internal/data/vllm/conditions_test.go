@@ -0,0 +1,120 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// conditionedVLLM is a running runtime that has been Ready since readySince
+// and was last autoscaled at scaled.
+func conditionedVLLM(readySince, scaled time.Time) *unstructured.Unstructured {
+	obj := runningVLLM("default", "llama")
+	obj.SetGeneration(3)
+	obj.Object["status"] = map[string]interface{}{
+		"phase":         "Running",
+		"lastScaleTime": scaled.Format(time.RFC3339),
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"type":               domain.ConditionReady,
+				"status":             "True",
+				"reason":             domain.ReasonServing,
+				"message":            "",
+				"observedGeneration": int64(2),
+				"lastTransitionTime": readySince.Format(time.RFC3339),
+			},
+		},
+	}
+	return obj
+}
+
+func getConditions(t *testing.T, api *VLLMAPI) (*unstructured.Unstructured, []metav1.Condition) {
+	t.Helper()
+	obj, err := newTracedResource(api.Client, "default").Get(t.Context(), "llama", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return obj, conditionsOf(obj)
+}
+
+func TestSetConditionsTransitionTimes(t *testing.T) {
+	readySince := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	api := &VLLMAPI{Client: newFakeDynamic(conditionedVLLM(readySince, readySince))}
+
+	// An unchanged status keeps its transition time, even with a new message.
+	err := api.SetConditions(t.Context(), "default", "llama",
+		metav1.Condition{Type: domain.ConditionReady, Status: metav1.ConditionTrue, Reason: domain.ReasonServing, Message: "serving 2 models"},
+		metav1.Condition{Type: domain.ConditionDegraded, Status: metav1.ConditionFalse, Reason: domain.ReasonAsExpected})
+	if err != nil {
+		t.Fatalf("SetConditions() = %v", err)
+	}
+	_, conds := getConditions(t, api)
+	ready := meta.FindStatusCondition(conds, domain.ConditionReady)
+	if ready == nil || !ready.LastTransitionTime.Time.Equal(readySince) || ready.Message != "serving 2 models" {
+		t.Fatalf("Ready = %+v, want the new message with the transition time %s", ready, readySince)
+	}
+	if ready.ObservedGeneration != 3 {
+		t.Errorf("observedGeneration = %d, want the live generation 3", ready.ObservedGeneration)
+	}
+	degraded := meta.FindStatusCondition(conds, domain.ConditionDegraded)
+	if degraded == nil || degraded.LastTransitionTime.IsZero() {
+		t.Errorf("Degraded = %+v, want it added with a transition time", degraded)
+	}
+
+	// A status change moves the transition time.
+	if err := api.SetConditions(t.Context(), "default", "llama", domain.FailedConditions("OOMKilled", 0)...); err != nil {
+		t.Fatal(err)
+	}
+	_, conds = getConditions(t, api)
+	ready = meta.FindStatusCondition(conds, domain.ConditionReady)
+	if ready.Status != metav1.ConditionFalse || !ready.LastTransitionTime.After(readySince) {
+		t.Errorf("Ready = %+v, want False with a new transition time", ready)
+	}
+}
+
+func TestSetConditionsKeepsAutoscalerStatus(t *testing.T) {
+	readySince := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	client := newFakeDynamic(conditionedVLLM(readySince, readySince))
+	api := &VLLMAPI{Client: client}
+	scaled := readySince.Add(time.Hour)
+	autoscaling := metav1.Condition{
+		Type:               domain.ConditionAutoscaling,
+		Status:             metav1.ConditionTrue,
+		Reason:             "QueueDepthHigh",
+		LastTransitionTime: metav1.NewTime(scaled),
+	}
+	if err := api.Scale(t.Context(), "default", "llama", 3, autoscaling); err != nil {
+		t.Fatalf("Scale() = %v", err)
+	}
+	// The conditions controller then writes its own set.
+	client.ClearActions()
+	err := api.SetConditions(t.Context(), "default", "llama",
+		domain.ReadinessConditions(domain.ReadinessProgress{Phase: domain.ReadinessReady}, 0)...)
+	if err != nil {
+		t.Fatalf("SetConditions() = %v", err)
+	}
+	if a := client.Actions(); len(a) != 2 || a[1].GetVerb() != "update" || a[1].GetSubresource() != "status" {
+		t.Errorf("actions = %v, want a get and an update of the status subresource", a)
+	}
+
+	obj, conds := getConditions(t, api)
+	if c := meta.FindStatusCondition(conds, domain.ConditionAutoscaling); c == nil || c.Reason != "QueueDepthHigh" {
+		t.Errorf("Autoscaling = %+v, want the autoscaler's decision kept", c)
+	}
+	if c := meta.FindStatusCondition(conds, domain.ConditionModelLoaded); c == nil || c.Status != metav1.ConditionTrue {
+		t.Errorf("ModelLoaded = %+v, want True", c)
+	}
+	if got, _, _ := unstructured.NestedString(obj.Object, "status", "lastScaleTime"); got != scaled.Format(time.RFC3339) {
+		t.Errorf("lastScaleTime = %q, want %s", got, scaled.Format(time.RFC3339))
+	}
+	if got, _, _ := unstructured.NestedString(obj.Object, "status", "phase"); got != "Running" {
+		t.Errorf("phase = %q, want Running", got)
+	}
+	if got, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); got != 3 {
+		t.Errorf("replicas = %d, want 3", got)
+	}
+}
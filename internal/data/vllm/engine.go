@@ -0,0 +1,378 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	logCore "connect-go/internal/core/logging"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// vLLM metric names. Newer engines renamed gpu_cache_usage_perc to kv_cache_usage_perc.
+const (
+	metricKVCacheUsage     = "vllm:kv_cache_usage_perc"
+	metricGPUCacheUsage    = "vllm:gpu_cache_usage_perc"
+	metricRequestsRunning  = "vllm:num_requests_running"
+	metricRequestsWaiting  = "vllm:num_requests_waiting"
+	metricPromptTokens     = "vllm:prompt_tokens_total"
+	metricGenerationTokens = "vllm:generation_tokens_total"
+	metricTTFT             = "vllm:time_to_first_token_seconds"
+	metricRequestSuccess   = "vllm:request_success_total"
+)
+
+// finishedReasonAbort marks requests the engine gave up on, which is what the
+// error rate counts.
+const finishedReasonAbort = "abort"
+
+// EngineStatsSource looks up the latest engine stats of a runtime by VLLM
+// resource name or spec.runtimeName.
+type EngineStatsSource interface {
+	Lookup(namespace, name string) (*domain.VLLMResource, bool)
+}
+
+// EngineScraper periodically scrapes the /metrics endpoint of every ready pod
+// of every Running VLLM runtime and keeps the latest stats of each runtime,
+// aggregated across its pods, in memory.
+type EngineScraper struct {
+	clientset kubernetes.Interface
+	api       *VLLMAPI
+	http      *http.Client
+	interval  time.Duration
+	// Concurrency bounds how many engines are scraped at once.
+	Concurrency int
+
+	mu       sync.RWMutex
+	runtimes map[string]*domain.VLLMResource
+	// samples holds the previous scrape of each engine by runtime and pod.
+	samples map[string]map[string]*engineSample
+}
+
+func NewEngineScraper(clientset kubernetes.Interface, api *VLLMAPI, httpClient *http.Client, interval time.Duration) *EngineScraper {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 5 * time.Second, Transport: logCore.RequestIDTransport(nil)}
+	}
+	return &EngineScraper{
+		clientset:   clientset,
+		api:         api,
+		http:        httpClient,
+		interval:    interval,
+		Concurrency: 8,
+		runtimes:    map[string]*domain.VLLMResource{},
+		samples:     map[string]map[string]*engineSample{},
+	}
+}
+
+// Run scrapes every interval until ctx is done.
+func (s *EngineScraper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.ScrapeAll(ctx); err != nil {
+			slog.WarnContext(ctx, "engine metrics scrape failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// engineTarget is one engine of a runtime: a pod, or the runtime's endpoint
+// when its pods cannot be found.
+type engineTarget struct {
+	runtime  string
+	pod      string
+	endpoint string
+	sample   *engineSample
+}
+
+// ScrapeAll scrapes every Running runtime once. Runtimes that are no longer
+// Running, or none of whose engines answered, are forgotten.
+func (s *EngineScraper) ScrapeAll(ctx context.Context) error {
+	list, err := s.api.listVLLMs(ctx, metav1.NamespaceAll)
+	if err != nil {
+		return err
+	}
+	running := map[string]*vllmv1.VLLM{}
+	var targets []*engineTarget
+	for _, v := range list {
+		if v.Status.Phase != string(domain.StatusRunning) {
+			continue
+		}
+		key := v.Namespace + "/" + v.Name
+		running[key] = v
+		found, err := s.engineTargets(ctx, key, v)
+		if err != nil {
+			slog.DebugContext(ctx, "failed to find engines", "namespace", v.Namespace, "resource", v.Name, "error", err)
+			continue
+		}
+		targets = append(targets, found...)
+	}
+
+	sem := make(chan struct{}, max(s.Concurrency, 1))
+	var wg sync.WaitGroup
+	for _, t := range targets {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			sample, err := scrapeEngine(ctx, s.http, t.endpoint)
+			if err != nil {
+				slog.DebugContext(ctx, "failed to scrape engine", "runtime", t.runtime, "pod", t.pod, "endpoint", t.endpoint, "error", err)
+				return
+			}
+			t.sample = sample
+		})
+	}
+	wg.Wait()
+
+	scraped := map[string]map[string]*engineSample{}
+	for _, t := range targets {
+		if t.sample == nil {
+			continue
+		}
+		if scraped[t.runtime] == nil {
+			scraped[t.runtime] = map[string]*engineSample{}
+		}
+		scraped[t.runtime][t.pod] = t.sample
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, samples := range scraped {
+		v := running[key]
+		stats := aggregateStats(samples, s.samples[key])
+		s.samples[key] = samples
+		s.runtimes[key] = &domain.VLLMResource{
+			Name:        v.Name,
+			RuntimeName: v.Spec.RuntimeName,
+			Model:       v.Spec.Model,
+			Phase:       string(domain.StatusRunning),
+			Stats:       &stats,
+		}
+	}
+	for key := range s.runtimes {
+		if scraped[key] == nil {
+			delete(s.runtimes, key)
+			delete(s.samples, key)
+		}
+	}
+	return nil
+}
+
+// engineTargets lists the ready pods behind the runtime's Service, found the
+// same way PodReadinessChecker finds them. A runtime without a Service, such
+// as one served from outside the cluster, is scraped through its endpoint.
+func (s *EngineScraper) engineTargets(ctx context.Context, key string, v *vllmv1.VLLM) ([]*engineTarget, error) {
+	svc, err := s.clientset.CoreV1().Services(v.Namespace).Get(ctx, v.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) || (err == nil && len(svc.Spec.Selector) == 0) {
+		return []*engineTarget{{runtime: key, endpoint: engineEndpoint(v)}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %q: %w", v.Name, err)
+	}
+	pods, err := s.clientset.CoreV1().Pods(v.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of %q: %w", v.Name, err)
+	}
+	port := enginePort(v)
+	var targets []*engineTarget
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil || pod.Status.PodIP == "" || !podConditionTrue(pod, corev1.PodReady) {
+			continue
+		}
+		targets = append(targets, &engineTarget{
+			runtime:  key,
+			pod:      pod.Name,
+			endpoint: "http://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port))),
+		})
+	}
+	return targets, nil
+}
+
+func (s *EngineScraper) Lookup(namespace, name string) (*domain.VLLMResource, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if r, ok := s.runtimes[namespace+"/"+name]; ok {
+		return r, true
+	}
+	for key, r := range s.runtimes {
+		if strings.HasPrefix(key, namespace+"/") && r.RuntimeName == name {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// engineEndpoint prefers status.endpoint and falls back to the runtime's
+// in-cluster Service on vllmConfig.port.
+func engineEndpoint(v *vllmv1.VLLM) string {
+	if v.Status.Endpoint != "" {
+		return strings.TrimRight(v.Status.Endpoint, "/")
+	}
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", v.Name, v.Namespace, enginePort(v))
+}
+
+// engineSample holds the raw values of one scrape. Series with different labels
+// (e.g. several served model names) are summed, except KV cache usage which
+// takes the maximum.
+type engineSample struct {
+	at               time.Time
+	kvCacheUsage     float64
+	running          float64
+	waiting          float64
+	promptTokens     float64
+	generationTokens float64
+	ttftSum          float64
+	ttftCount        float64
+	finished         float64
+	aborted          float64
+}
+
+func scrapeEngine(ctx context.Context, client *http.Client, endpoint string) (*engineSample, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/metrics", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s from %s/metrics", resp.Status, endpoint)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics from %s: %w", endpoint, err)
+	}
+
+	sample := &engineSample{at: time.Now()}
+	for _, name := range []string{metricKVCacheUsage, metricGPUCacheUsage} {
+		for _, m := range families[name].GetMetric() {
+			sample.kvCacheUsage = max(sample.kvCacheUsage, m.GetGauge().GetValue())
+		}
+	}
+	sample.running = sumGauge(families[metricRequestsRunning])
+	sample.waiting = sumGauge(families[metricRequestsWaiting])
+	sample.promptTokens = sumCounter(families[metricPromptTokens])
+	sample.generationTokens = sumCounter(families[metricGenerationTokens])
+	for _, m := range families[metricTTFT].GetMetric() {
+		sample.ttftSum += m.GetHistogram().GetSampleSum()
+		sample.ttftCount += float64(m.GetHistogram().GetSampleCount())
+	}
+	for _, m := range families[metricRequestSuccess].GetMetric() {
+		v := m.GetCounter().GetValue()
+		sample.finished += v
+		for _, l := range m.GetLabel() {
+			if l.GetName() == "finished_reason" && l.GetValue() == finishedReasonAbort {
+				sample.aborted += v
+			}
+		}
+	}
+	return sample, nil
+}
+
+// stats derives rates from the counters in cur and prev. Without a usable
+// previous sample (first scrape or engine restart) rates are zero and TTFT is the
+// lifetime mean; TTFT also falls back to the lifetime mean when no request got
+// its first token during the interval. It also returns how many first tokens
+// and finished requests the TTFT and error rate cover, to weigh them by when
+// engines are aggregated.
+func (cur *engineSample) stats(prev *engineSample) (stats domain.EngineStats, firstTokens, finished float64) {
+	stats = domain.EngineStats{
+		KVCacheUsage:    cur.kvCacheUsage,
+		RequestsRunning: cur.running,
+		RequestsWaiting: cur.waiting,
+		ScrapedAt:       cur.at,
+	}
+	if cur.ttftCount > 0 {
+		stats.TTFTSeconds = cur.ttftSum / cur.ttftCount
+	}
+	firstTokens = cur.ttftCount
+	if prev == nil || cur.promptTokens < prev.promptTokens || cur.generationTokens < prev.generationTokens || cur.ttftCount < prev.ttftCount || cur.finished < prev.finished {
+		return stats, firstTokens, 0
+	}
+	if elapsed := cur.at.Sub(prev.at).Seconds(); elapsed > 0 {
+		stats.PromptTokensPerSecond = (cur.promptTokens - prev.promptTokens) / elapsed
+		stats.GenerationTokensPerSecond = (cur.generationTokens - prev.generationTokens) / elapsed
+	}
+	if n := cur.ttftCount - prev.ttftCount; n > 0 {
+		stats.TTFTSeconds = (cur.ttftSum - prev.ttftSum) / n
+		firstTokens = n
+	}
+	if n := cur.finished - prev.finished; n > 0 {
+		stats.ErrorRate = (cur.aborted - prev.aborted) / n
+		finished = n
+	}
+	return stats, firstTokens, finished
+}
+
+// aggregateStats combines the engines of one runtime, each compared with its
+// own previous sample in prev: request counts and token rates are summed, KV
+// cache usage is the mean and TTFT and error rate are weighted by the requests
+// they cover.
+func aggregateStats(cur, prev map[string]*engineSample) domain.EngineStats {
+	var total domain.EngineStats
+	var firstTokens, finished float64
+	for pod, sample := range cur {
+		stats, n, f := sample.stats(prev[pod])
+		total.Pods++
+		total.KVCacheUsage += stats.KVCacheUsage
+		total.RequestsRunning += stats.RequestsRunning
+		total.RequestsWaiting += stats.RequestsWaiting
+		total.PromptTokensPerSecond += stats.PromptTokensPerSecond
+		total.GenerationTokensPerSecond += stats.GenerationTokensPerSecond
+		total.TTFTSeconds += stats.TTFTSeconds * n
+		total.ErrorRate += stats.ErrorRate * f
+		firstTokens += n
+		finished += f
+		if stats.ScrapedAt.After(total.ScrapedAt) {
+			total.ScrapedAt = stats.ScrapedAt
+		}
+	}
+	if total.Pods > 0 {
+		total.KVCacheUsage /= float64(total.Pods)
+	}
+	if firstTokens > 0 {
+		total.TTFTSeconds /= firstTokens
+	}
+	if finished > 0 {
+		total.ErrorRate /= finished
+	}
+	return total
+}
+
+func sumGauge(mf *dto.MetricFamily) float64 {
+	var sum float64
+	for _, m := range mf.GetMetric() {
+		sum += m.GetGauge().GetValue()
+	}
+	return sum
+}
+
+func sumCounter(mf *dto.MetricFamily) float64 {
+	var sum float64
+	for _, m := range mf.GetMetric() {
+		sum += m.GetCounter().GetValue()
+	}
+	return sum
+}
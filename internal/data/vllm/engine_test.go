@@ -0,0 +1,138 @@
+package vllm
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newFakeDynamic(objs ...runtime.Object) *dfake.FakeDynamicClient {
+	return dfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{vllmGVR: "VLLMList"}, objs...)
+}
+
+func runningVLLM(namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "vllm.ai/v1",
+		"kind":       "VLLM",
+		"metadata":   map[string]interface{}{"namespace": namespace, "name": name},
+		"spec": map[string]interface{}{
+			"model":      "meta-llama/Llama-3.1-8B",
+			"vllmConfig": map[string]interface{}{"port": int64(8000)},
+		},
+		"status": map[string]interface{}{"phase": "Running"},
+	}}
+}
+
+func enginePod(name, ip string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: map[string]string{"app": "llama"}},
+		Status: corev1.PodStatus{
+			PodIP:      ip,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+// fakeEngine serves a vLLM /metrics page.
+func fakeEngine(t *testing.T, waiting, kvCache, ttftSum float64, ttftCount int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `# TYPE vllm:num_requests_waiting gauge
+vllm:num_requests_waiting{model_name="llama"} %g
+# TYPE vllm:num_requests_running gauge
+vllm:num_requests_running{model_name="llama"} 1
+# TYPE vllm:kv_cache_usage_perc gauge
+vllm:kv_cache_usage_perc{model_name="llama"} %g
+# TYPE vllm:time_to_first_token_seconds histogram
+vllm:time_to_first_token_seconds_bucket{model_name="llama",le="+Inf"} %d
+vllm:time_to_first_token_seconds_sum{model_name="llama"} %g
+vllm:time_to_first_token_seconds_count{model_name="llama"} %d
+`, waiting, kvCache, ttftCount, ttftSum, ttftCount)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// dialing routes connections for the given addresses to test servers.
+func dialing(t *testing.T, routes map[string]*httptest.Server) *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			server, ok := routes[addr]
+			if !ok {
+				t.Errorf("unexpected scrape of %s", addr)
+				return nil, fmt.Errorf("no route to %s", addr)
+			}
+			return (&net.Dialer{}).DialContext(ctx, network, strings.TrimPrefix(server.URL, "http://"))
+		},
+	}}
+}
+
+func TestEngineScraperAggregatesPods(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama"},
+			Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "llama"}},
+		},
+		enginePod("llama-a", "10.0.0.1", true),
+		enginePod("llama-b", "10.0.0.2", true),
+		enginePod("llama-c", "10.0.0.3", false),
+	)
+	external := runningVLLM("default", "external")
+	unstructured.SetNestedField(external.Object, "http://external.example:8000", "status", "endpoint")
+	stopped := runningVLLM("default", "stopped")
+	unstructured.SetNestedField(stopped.Object, "Stopped", "status", "phase")
+	client := newFakeDynamic(runningVLLM("default", "llama"), external, stopped)
+
+	httpClient := dialing(t, map[string]*httptest.Server{
+		"10.0.0.1:8000":         fakeEngine(t, 3, 0.2, 2, 4),
+		"10.0.0.2:8000":         fakeEngine(t, 1, 0.6, 3, 1),
+		"external.example:8000": fakeEngine(t, 5, 0.5, 1, 1),
+	})
+	scraper := NewEngineScraper(clientset, &VLLMAPI{Client: client}, httpClient, 0)
+	scraper.Concurrency = 1
+	if err := scraper.ScrapeAll(t.Context()); err != nil {
+		t.Fatalf("ScrapeAll() = %v", err)
+	}
+
+	r, ok := scraper.Lookup("default", "llama")
+	if !ok {
+		t.Fatal("Lookup(llama) found nothing")
+	}
+	got := *r.Stats
+	if got.Pods != 2 || got.RequestsWaiting != 4 || got.RequestsRunning != 2 {
+		t.Errorf("stats = %+v, want 2 pods with 4 waiting and 2 running requests", got)
+	}
+	if math.Abs(got.KVCacheUsage-0.4) > 1e-9 {
+		t.Errorf("KVCacheUsage = %g, want the mean 0.4", got.KVCacheUsage)
+	}
+	// 5 seconds over 5 first tokens, not the mean of the two engines' means.
+	if math.Abs(got.TTFTSeconds-1) > 1e-9 {
+		t.Errorf("TTFTSeconds = %g, want 1", got.TTFTSeconds)
+	}
+
+	r, ok = scraper.Lookup("default", "external")
+	if !ok || r.Stats.Pods != 1 || r.Stats.RequestsWaiting != 5 {
+		t.Errorf("Lookup(external) = %+v, want the endpoint scraped", r)
+	}
+	if _, ok := scraper.Lookup("default", "stopped"); ok {
+		t.Error("Lookup(stopped) found a runtime that is not Running")
+	}
+}
@@ -0,0 +1,95 @@
+package vllm
+
+import (
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+var httpRouteGVR = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Version:  "v1",
+	Resource: "httproutes",
+}
+
+// TrafficRouter splits a route's traffic between runtime Services.
+type TrafficRouter interface {
+	SetWeights(ctx context.Context, namespace, route string, backends []domain.BackendWeight) error
+}
+
+// GatewayRouter shifts traffic by rewriting the backendRefs of a Gateway API
+// HTTPRoute. Only rules that already point at one of the backends are changed.
+type GatewayRouter struct {
+	client dynamic.Interface
+}
+
+func NewGatewayRouter(client dynamic.Interface) *GatewayRouter {
+	return &GatewayRouter{client: client}
+}
+
+func (g *GatewayRouter) SetWeights(ctx context.Context, namespace, route string, backends []domain.BackendWeight) (err error) {
+	ctx, span := tracing.Start(ctx, "GatewayRouter.SetWeights", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("gateway.route", route),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	routes := g.client.Resource(httpRouteGVR).Namespace(namespace)
+	obj, err := routes.Get(ctx, route, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get HTTPRoute %q: %w", route, err)
+	}
+	rules, _, err := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	if err != nil {
+		return fmt.Errorf("failed to read rules of HTTPRoute %q: %w", route, err)
+	}
+
+	names := make([]string, len(backends))
+	refs := make([]interface{}, len(backends))
+	for i, b := range backends {
+		names[i] = b.Service
+		refs[i] = map[string]interface{}{
+			"name":   b.Service,
+			"port":   int64(b.Port),
+			"weight": int64(b.Weight),
+		}
+	}
+	matched := 0
+	for i, rule := range rules {
+		r, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		existing, _, _ := unstructured.NestedSlice(r, "backendRefs")
+		if !slices.ContainsFunc(existing, func(ref interface{}) bool {
+			name, _, _ := unstructured.NestedString(ref.(map[string]interface{}), "name")
+			return slices.Contains(names, name)
+		}) {
+			continue
+		}
+		r["backendRefs"] = refs
+		rules[i] = r
+		matched++
+	}
+	if matched == 0 {
+		return fmt.Errorf("HTTPRoute %q has no rule routing to %v", route, names)
+	}
+	if err := unstructured.SetNestedSlice(obj.Object, rules, "spec", "rules"); err != nil {
+		return fmt.Errorf("failed to set rules of HTTPRoute %q: %w", route, err)
+	}
+	if _, err := routes.Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update HTTPRoute %q: %w", route, err)
+	}
+	slog.InfoContext(ctx, "updated HTTPRoute weights", "namespace", namespace, "route", route, "backends", backends)
+	return nil
+}
@@ -0,0 +1,250 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// IdempotencyStore remembers requests made with an idempotency key.
+type IdempotencyStore interface {
+	// Reserve claims the key of rec for rec, unless a record that is still
+	// held has it; that record is returned instead.
+	Reserve(ctx context.Context, rec *domain.IdempotencyRecord) (*domain.IdempotencyRecord, error)
+	// Complete stores rec, now done, under its key.
+	Complete(ctx context.Context, rec *domain.IdempotencyRecord) error
+	// Release gives up the key rec reserved, so a retry runs the request
+	// again. A record that has since replaced rec is left alone.
+	Release(ctx context.Context, rec *domain.IdempotencyRecord) error
+	// Prune drops expired records.
+	Prune(ctx context.Context) error
+}
+
+// MemoryIdempotencyStore keeps records in memory. Keys are only deduplicated
+// by the replica that saw them first and are forgotten on restart.
+type MemoryIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]domain.IdempotencyRecord
+}
+
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{records: map[string]domain.IdempotencyRecord{}}
+}
+
+func memoryIdempotencyKey(rec *domain.IdempotencyRecord) string {
+	return rec.Namespace + "/" + rec.Key
+}
+
+func (s *MemoryIdempotencyStore) Reserve(_ context.Context, rec *domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.prune(now)
+	if existing, ok := s.records[memoryIdempotencyKey(rec)]; ok && existing.Held(now) {
+		return &existing, nil
+	}
+	s.records[memoryIdempotencyKey(rec)] = *rec
+	return nil, nil
+}
+
+func (s *MemoryIdempotencyStore) Complete(_ context.Context, rec *domain.IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[memoryIdempotencyKey(rec)] = *rec
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) Release(_ context.Context, rec *domain.IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.records[memoryIdempotencyKey(rec)]; ok && sameReservation(&existing, rec) {
+		delete(s.records, memoryIdempotencyKey(rec))
+	}
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) Prune(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prune(time.Now())
+	return nil
+}
+
+func (s *MemoryIdempotencyStore) prune(now time.Time) {
+	for k, r := range s.records {
+		if r.Expired(now) {
+			delete(s.records, k)
+		}
+	}
+}
+
+// sameReservation reports whether a and b are the same use of a key.
+func sameReservation(a, b *domain.IdempotencyRecord) bool {
+	return a.Fingerprint == b.Fingerprint && a.CreatedAt.Equal(b.CreatedAt)
+}
+
+const (
+	// labelIdempotency marks the ConfigMaps holding idempotency records.
+	labelIdempotency = "vllm.ai/idempotency-record"
+	idempotencyKey   = "record.json"
+)
+
+// ConfigMapIdempotencyStore keeps each record as a ConfigMap of its own in the
+// record's namespace, named after a hash of the key, so every replica sees it
+// and it survives restarts. A key is reserved by creating its ConfigMap, which
+// the API server lets only one replica do, and taken over once expired with
+// an Update conditional on the expired record's resourceVersion.
+type ConfigMapIdempotencyStore struct {
+	clientset kubernetes.Interface
+}
+
+func NewConfigMapIdempotencyStore(clientset kubernetes.Interface) *ConfigMapIdempotencyStore {
+	return &ConfigMapIdempotencyStore{clientset: clientset}
+}
+
+func idempotencyConfigMapName(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return "vllm-idempotency-" + hex.EncodeToString(sum[:16])
+}
+
+func (s *ConfigMapIdempotencyStore) Reserve(ctx context.Context, rec *domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	configMaps := s.clientset.CoreV1().ConfigMaps(rec.Namespace)
+	var existing *domain.IdempotencyRecord
+	err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		// Another replica changed or removed the record since it was read.
+		return apierrors.IsConflict(err) || apierrors.IsNotFound(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		existing = nil
+		cm, err := newIdempotencyConfigMap(rec)
+		if err != nil {
+			return err
+		}
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		current, err := configMaps.Get(ctx, cm.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if r, err := decodeIdempotencyRecord(current); err == nil && r.Held(time.Now()) {
+			existing = r
+			return nil
+		}
+		// The record expired or its request was abandoned: take the key over.
+		cm.ResourceVersion = current.ResourceVersion
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	return existing, nil
+}
+
+func (s *ConfigMapIdempotencyStore) Complete(ctx context.Context, rec *domain.IdempotencyRecord) error {
+	configMaps := s.clientset.CoreV1().ConfigMaps(rec.Namespace)
+	cm, err := newIdempotencyConfigMap(rec)
+	if err != nil {
+		return err
+	}
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := configMaps.Get(ctx, cm.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		cm.ResourceVersion = current.ResourceVersion
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store idempotency record: %w", err)
+	}
+	return nil
+}
+
+func (s *ConfigMapIdempotencyStore) Release(ctx context.Context, rec *domain.IdempotencyRecord) error {
+	configMaps := s.clientset.CoreV1().ConfigMaps(rec.Namespace)
+	name := idempotencyConfigMapName(rec.Key)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if r, err := decodeIdempotencyRecord(current); err == nil && !sameReservation(r, rec) {
+			return nil
+		}
+		return configMaps.Delete(ctx, name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{ResourceVersion: &current.ResourceVersion},
+		})
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (s *ConfigMapIdempotencyStore) Prune(ctx context.Context) error {
+	list, err := s.clientset.CoreV1().ConfigMaps(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: labelIdempotency})
+	if err != nil {
+		return fmt.Errorf("failed to list idempotency records: %w", err)
+	}
+	now := time.Now()
+	var errs []error
+	for i := range list.Items {
+		cm := &list.Items[i]
+		if r, err := decodeIdempotencyRecord(cm); err == nil && !r.Expired(now) {
+			continue
+		}
+		err := s.clientset.CoreV1().ConfigMaps(cm.Namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{ResourceVersion: &cm.ResourceVersion},
+		})
+		// A conflict means the key was taken over since the List.
+		if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			errs = append(errs, fmt.Errorf("failed to delete idempotency record %s/%s: %w", cm.Namespace, cm.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func newIdempotencyConfigMap(rec *domain.IdempotencyRecord) (*corev1.ConfigMap, error) {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode idempotency record: %w", err)
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      idempotencyConfigMapName(rec.Key),
+			Namespace: rec.Namespace,
+			Labels: map[string]string{
+				labelIdempotency:               "true",
+				"app.kubernetes.io/managed-by": "connect-go",
+			},
+		},
+		Data: map[string]string{idempotencyKey: string(b)},
+	}, nil
+}
+
+func decodeIdempotencyRecord(cm *corev1.ConfigMap) (*domain.IdempotencyRecord, error) {
+	var r domain.IdempotencyRecord
+	if err := json.Unmarshal([]byte(cm.Data[idempotencyKey]), &r); err != nil {
+		return nil, fmt.Errorf("failed to decode idempotency record in ConfigMap %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	return &r, nil
+}
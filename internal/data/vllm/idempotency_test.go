@@ -0,0 +1,106 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func idempotencyRecord(key, fingerprint string, created time.Time) *domain.IdempotencyRecord {
+	return &domain.IdempotencyRecord{
+		Key:         key,
+		Namespace:   "default",
+		Resource:    "llama",
+		Action:      "start",
+		Fingerprint: fingerprint,
+		CreatedAt:   created,
+		ExpiresAt:   created.Add(domain.DefaultIdempotencyWindow),
+	}
+}
+
+func TestConfigMapIdempotencyStore(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	store := NewConfigMapIdempotencyStore(clientset)
+	now := time.Now()
+
+	first := idempotencyRecord("key-1", "a", now)
+	if existing, err := store.Reserve(t.Context(), first); err != nil || existing != nil {
+		t.Fatalf("Reserve = %+v, %v; want the key reserved", existing, err)
+	}
+	// The reservation holds even for a resource that does not exist yet.
+	existing, err := store.Reserve(t.Context(), idempotencyRecord("key-1", "a", now.Add(time.Second)))
+	if err != nil || existing == nil || existing.Done || !existing.CreatedAt.Equal(now) {
+		t.Fatalf("second Reserve = %+v, %v; want the pending first reservation", existing, err)
+	}
+
+	first.Done, first.Response = true, []byte(`{"ok":true}`)
+	if err := store.Complete(t.Context(), first); err != nil {
+		t.Fatal(err)
+	}
+	existing, err = store.Reserve(t.Context(), idempotencyRecord("key-1", "a", now.Add(time.Second)))
+	if err != nil || existing == nil || !existing.Done || string(existing.Response) != `{"ok":true}` {
+		t.Fatalf("Reserve after Complete = %+v, %v; want the stored response", existing, err)
+	}
+
+	// Releasing a reservation that has been replaced leaves the record alone.
+	if err := store.Release(t.Context(), idempotencyRecord("key-1", "a", now.Add(-time.Hour))); err != nil {
+		t.Fatal(err)
+	}
+	if existing, _ := store.Reserve(t.Context(), idempotencyRecord("key-1", "a", now)); existing == nil {
+		t.Fatal("Release of another reservation removed the record")
+	}
+	if err := store.Release(t.Context(), first); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Release(t.Context(), first); err != nil {
+		t.Errorf("releasing a missing key = %v, want nil", err)
+	}
+	if _, err := clientset.CoreV1().ConfigMaps("default").Get(t.Context(), idempotencyConfigMapName("key-1"), metav1.GetOptions{}); err == nil {
+		t.Error("Release kept the ConfigMap")
+	}
+}
+
+func TestConfigMapIdempotencyStoreTakesOverExpired(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	store := NewConfigMapIdempotencyStore(clientset)
+	// Abandoned by a replica that stopped before completing it.
+	abandoned := idempotencyRecord("key-1", "a", time.Now().Add(-2*domain.IdempotencyLease))
+	if _, err := store.Reserve(t.Context(), abandoned); err != nil {
+		t.Fatal(err)
+	}
+	expired := idempotencyRecord("key-2", "a", time.Now().Add(-2*domain.DefaultIdempotencyWindow))
+	expired.Done = true
+	if err := store.Complete(t.Context(), expired); err != nil {
+		t.Fatal(err)
+	}
+	current := idempotencyRecord("key-3", "a", time.Now())
+	if _, err := store.Reserve(t.Context(), current); err != nil {
+		t.Fatal(err)
+	}
+
+	retry := idempotencyRecord("key-1", "b", time.Now())
+	if existing, err := store.Reserve(t.Context(), retry); err != nil || existing != nil {
+		t.Fatalf("Reserve of an abandoned key = %+v, %v; want it taken over", existing, err)
+	}
+	if existing, _ := store.Reserve(t.Context(), idempotencyRecord("key-1", "c", time.Now())); existing == nil || existing.Fingerprint != "b" {
+		t.Errorf("record after takeover = %+v, want the new reservation", existing)
+	}
+
+	if err := store.Prune(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	list, err := clientset.CoreV1().ConfigMaps("default").List(t.Context(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, cm := range list.Items {
+		names = append(names, cm.Name)
+	}
+	if len(names) != 2 {
+		t.Errorf("ConfigMaps after Prune = %v, want key-1 and key-3 only", names)
+	}
+}
@@ -1,19 +1,33 @@
 package vllm
 
 import (
+	vllmv1 "connect-go/api/vllm/v1"
+	"connect-go/internal/core/tracing"
+	"context"
 	"fmt"
+	"log/slog"
 	"os"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
 // Kubernetes client helper functions
 
-// getDynamicClient creates a dynamic Kubernetes client using the kubeconfig.
+// getDynamicClient returns the injected client, or creates a dynamic Kubernetes client using the kubeconfig.
 func (a *VLLMAPI) getDynamicClient() (dynamic.Interface, error) {
+	if a.Client != nil {
+		return a.Client, nil
+	}
 	kubeconfig := os.Getenv("KUBECONFIG")
 	if kubeconfig == "" {
 		kubeconfig = clientcmd.RecommendedHomeFile
@@ -25,12 +39,70 @@ func (a *VLLMAPI) getDynamicClient() (dynamic.Interface, error) {
 	return dynamic.NewForConfig(config)
 }
 
+// listVLLMs returns the VLLM resources in namespace, or in all namespaces
+// when it is empty, from the Lister when one is set and otherwise from the API
+// server. Resources that do not decode into the typed API are skipped. The
+// results are shared with the cache and must not be modified.
+func (a *VLLMAPI) listVLLMs(ctx context.Context, namespace string) ([]*vllmv1.VLLM, error) {
+	if a.Lister != nil {
+		var vllms []*vllmv1.VLLM
+		var err error
+		if namespace == metav1.NamespaceAll {
+			vllms, err = a.Lister.List(labels.Everything())
+		} else {
+			vllms, err = a.Lister.VLLMs(namespace).List(labels.Everything())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list VLLM resources: %w", err)
+		}
+		return vllms, nil
+	}
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	list, err := newTracedResource(dynamicClient, namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VLLM resources: %w", err)
+	}
+	vllms := make([]*vllmv1.VLLM, 0, len(list.Items))
+	for i := range list.Items {
+		v, err := typedVLLM(&list.Items[i])
+		if err != nil {
+			slog.WarnContext(ctx, "skipping undecodable VLLM resource", "namespace", list.Items[i].GetNamespace(), "resource", list.Items[i].GetName(), "error", err)
+			continue
+		}
+		vllms = append(vllms, v)
+	}
+	return vllms, nil
+}
+
+// typedVLLM decodes a VLLM resource read through the dynamic client.
+func typedVLLM(obj *unstructured.Unstructured) (*vllmv1.VLLM, error) {
+	v := &vllmv1.VLLM{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, v); err != nil {
+		return nil, fmt.Errorf("failed to decode VLLM resource %q: %w", obj.GetName(), err)
+	}
+	return v, nil
+}
+
+// templatePath returns the sample CR used as the template for a model.
+func templatePath(model string) string {
+	return fmt.Sprintf("config/samples/%s.yaml", model)
+}
+
+// specOf returns the spec of a VLLM object, or nil if it has none.
+func specOf(obj *unstructured.Unstructured) map[string]interface{} {
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	return spec
+}
+
 // loadAndValidateYAML loads and decodes the model-specific YAML file, validating the Kind.
 func loadAndValidateYAML(model string) (*unstructured.Unstructured, error) {
 	if model == "" {
 		return nil, fmt.Errorf("model name is required")
 	}
-	yamlFileName := fmt.Sprintf("config/samples/%s.yaml", model)
+	yamlFileName := templatePath(model)
 	yamlFile, err := os.ReadFile(yamlFileName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read YAML file %q: %w", yamlFileName, err)
@@ -46,3 +118,76 @@ func loadAndValidateYAML(model string) (*unstructured.Unstructured, error) {
 	}
 	return obj, nil
 }
+
+// tracedResource wraps the namespaced VLLM client so every Get, List, Create,
+// Update, Patch and Delete shows up as a client span under the calling layer's span.
+type tracedResource struct {
+	dynamic.ResourceInterface
+	namespace string
+}
+
+func newTracedResource(client dynamic.Interface, namespace string) tracedResource {
+	return tracedResource{
+		ResourceInterface: client.Resource(vllmGVR).Namespace(namespace),
+		namespace:         namespace,
+	}
+}
+
+func (r tracedResource) Get(ctx context.Context, name string, opts metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	ctx, span := r.startSpan(ctx, "Get", name)
+	obj, err := r.ResourceInterface.Get(ctx, name, opts, subresources...)
+	r.endSpan(span, err)
+	return obj, err
+}
+
+func (r tracedResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	ctx, span := r.startSpan(ctx, "List", "")
+	list, err := r.ResourceInterface.List(ctx, opts)
+	r.endSpan(span, err)
+	return list, err
+}
+
+func (r tracedResource) Create(ctx context.Context, obj *unstructured.Unstructured, opts metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	ctx, span := r.startSpan(ctx, "Create", obj.GetName())
+	created, err := r.ResourceInterface.Create(ctx, obj, opts, subresources...)
+	r.endSpan(span, err)
+	return created, err
+}
+
+func (r tracedResource) Update(ctx context.Context, obj *unstructured.Unstructured, opts metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	ctx, span := r.startSpan(ctx, "Update", obj.GetName())
+	updated, err := r.ResourceInterface.Update(ctx, obj, opts, subresources...)
+	r.endSpan(span, err)
+	return updated, err
+}
+
+func (r tracedResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	ctx, span := r.startSpan(ctx, "Patch", name)
+	span.SetAttributes(attribute.String("k8s.patch_type", string(pt)))
+	patched, err := r.ResourceInterface.Patch(ctx, name, pt, data, opts, subresources...)
+	r.endSpan(span, err)
+	return patched, err
+}
+
+func (r tracedResource) Delete(ctx context.Context, name string, opts metav1.DeleteOptions, subresources ...string) error {
+	ctx, span := r.startSpan(ctx, "Delete", name)
+	err := r.ResourceInterface.Delete(ctx, name, opts, subresources...)
+	r.endSpan(span, err)
+	return err
+}
+
+func (r tracedResource) startSpan(ctx context.Context, verb, name string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "kubernetes."+verb, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("k8s.resource", vllmGVR.Resource),
+		attribute.String("k8s.namespace", r.namespace),
+		attribute.String("k8s.name", name),
+	))
+}
+
+// endSpan does not mark NotFound as a failure; callers use it to decide between create and patch.
+func (r tracedResource) endSpan(span trace.Span, err error) {
+	if errors.IsNotFound(err) {
+		err = nil
+	}
+	tracing.End(span, err)
+}
@@ -0,0 +1,105 @@
+package vllm
+
+import (
+	vllmlisters "connect-go/api/vllm/client/listers/vllm/v1"
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newLister(t *testing.T, vllms ...*vllmv1.VLLM) vllmlisters.VLLMLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, v := range vllms {
+		if err := indexer.Add(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return vllmlisters.NewVLLMLister(indexer)
+}
+
+func TestListsServedFromLister(t *testing.T) {
+	scaled := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	replicas, cooldown := int32(2), int64(30)
+	autoscaled := &vllmv1.VLLM{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama"},
+		Spec: vllmv1.VLLMSpec{
+			Model:    "meta-llama/Llama-3.1-8B",
+			Replicas: &replicas,
+			Autoscaling: &vllmv1.AutoscalingSpec{
+				Enabled:                true,
+				MinReplicas:            1,
+				MaxReplicas:            4,
+				Target:                 5,
+				ScaleUpCooldownSeconds: &cooldown,
+			},
+		},
+		Status: vllmv1.VLLMStatus{Phase: "Running", LastScaleTime: &metav1.Time{Time: scaled}},
+	}
+	stopped := &vllmv1.VLLM{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "qwen"},
+		Spec:       vllmv1.VLLMSpec{Model: "Qwen/Qwen2.5-7B", Action: vllmv1.ActionStop},
+		Status:     vllmv1.VLLMStatus{Phase: "Stopped"},
+	}
+	// Without a Client, any call to the API server would fail to build one.
+	api := &VLLMAPI{Lister: newLister(t, autoscaled, stopped)}
+
+	targets, err := api.ListAutoscaled(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 1 {
+		t.Fatalf("got %d autoscale targets, want 1", len(targets))
+	}
+	got := targets[0]
+	if got.Name != "llama" || got.Replicas != 2 || got.Autoscaling.Metric != domain.AutoscaleMetricQueueDepth {
+		t.Errorf("unexpected target %+v", got)
+	}
+	if got.Autoscaling.ScaleUpCooldown != 30*time.Second || got.Autoscaling.ScaleDownCooldown != 5*time.Minute {
+		t.Errorf("cooldowns = %v/%v, want 30s/5m", got.Autoscaling.ScaleUpCooldown, got.Autoscaling.ScaleDownCooldown)
+	}
+	if !got.LastScaleTime.Equal(scaled) {
+		t.Errorf("LastScaleTime = %v, want %v", got.LastScaleTime, scaled)
+	}
+
+	running, err := api.Get(t.Context(), "team-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(running) != 0 {
+		t.Errorf("Get(team-a) = %+v, want no running runtimes", running)
+	}
+	routes, err := api.ListRouterTargets(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(routes) != 2 {
+		t.Fatalf("got %d router targets, want 2", len(routes))
+	}
+	for _, r := range routes {
+		if r.Serving {
+			t.Errorf("%s is serving without a Ready condition", r.Name)
+		}
+	}
+}
+
+func TestListVLLMsSkipsUndecodable(t *testing.T) {
+	broken := runningVLLM("default", "broken")
+	if err := unstructured.SetNestedField(broken.Object, "two", "spec", "replicas"); err != nil {
+		t.Fatal(err)
+	}
+	api := &VLLMAPI{Client: newFakeDynamic(runningVLLM("default", "llama"), broken)}
+
+	vllms, err := api.listVLLMs(t.Context(), metav1.NamespaceAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vllms) != 1 || vllms[0].Name != "llama" || vllms[0].Spec.VLLMConfig.Port != 8000 {
+		t.Errorf("listVLLMs = %+v, want only llama", vllms)
+	}
+}
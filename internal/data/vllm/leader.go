@@ -0,0 +1,112 @@
+package vllm
+
+import (
+	metricsCore "connect-go/internal/core/metrics"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// LeaderElector elects one replica of the server through a Lease to run the
+// background controllers, which must not run twice. Requests are served by
+// every replica regardless.
+type LeaderElector struct {
+	clientset kubernetes.Interface
+	namespace string
+	name      string
+	identity  string
+	// LeaseDuration is how long the Lease holds after its last renewal before
+	// another replica may take it; RenewDeadline is how long the leader keeps
+	// trying to renew before it gives up, and RetryPeriod how often it tries.
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+func NewLeaderElector(clientset kubernetes.Interface, namespace, name, identity string) *LeaderElector {
+	return &LeaderElector{
+		clientset:     clientset,
+		namespace:     namespace,
+		name:          name,
+		identity:      identity,
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+	}
+}
+
+// Run campaigns for the Lease until ctx is cancelled. Whenever this replica
+// becomes leader it calls lead with a context that is cancelled once the Lease
+// is lost or ctx is, and waits for lead to return before campaigning again,
+// so the controllers of one term are stopped before the next starts. On
+// shutdown the Lease is released as soon as lead returns, letting another
+// replica take over at once.
+func (e *LeaderElector) Run(ctx context.Context, lead func(ctx context.Context)) error {
+	// term is held while lead runs, so the controllers of two terms never
+	// overlap.
+	term := make(chan struct{}, 1)
+	// The election outlives ctx until lead has returned, since cancelling it
+	// releases the Lease.
+	electCtx, stopElecting := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopElecting()
+	stop := context.AfterFunc(ctx, func() {
+		term <- struct{}{}
+		stopElecting()
+		<-term
+	})
+	defer stop()
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Namespace: e.namespace, Name: e.name},
+			Client:     e.clientset.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: e.identity},
+		},
+		LeaseDuration:   e.LeaseDuration,
+		RenewDeadline:   e.RenewDeadline,
+		RetryPeriod:     e.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            e.name,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(termCtx context.Context) {
+				term <- struct{}{}
+				defer func() { <-term }()
+				if termCtx.Err() != nil {
+					// The term ended before this callback got to run.
+					return
+				}
+				leadCtx, cancel := context.WithCancel(termCtx)
+				defer cancel()
+				defer context.AfterFunc(ctx, cancel)()
+				slog.InfoContext(ctx, "became leader", "lease", e.namespace+"/"+e.name, "identity", e.identity)
+				metricsCore.Leader.Set(1)
+				defer metricsCore.Leader.Set(0)
+				lead(leadCtx)
+			},
+			OnStoppedLeading: func() {},
+			OnNewLeader: func(identity string) {
+				if identity != e.identity {
+					slog.Info("following leader", "lease", e.namespace+"/"+e.name, "leader", identity)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to configure leader election: %w", err)
+	}
+	for electCtx.Err() == nil {
+		elector.Run(electCtx)
+		term <- struct{}{}
+		<-term
+		if electCtx.Err() == nil {
+			slog.WarnContext(ctx, "lost leadership", "lease", e.namespace+"/"+e.name, "identity", e.identity)
+		}
+	}
+	return nil
+}
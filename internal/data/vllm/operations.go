@@ -0,0 +1,153 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// OperationStore persists long-running operations.
+type OperationStore interface {
+	Create(ctx context.Context, op *domain.Operation) error
+	Get(ctx context.Context, namespace, id string) (*domain.Operation, error)
+	// List returns the operations of namespace, or of all namespaces when it
+	// is empty.
+	List(ctx context.Context, namespace string) ([]domain.Operation, error)
+	// Update applies change to the stored operation and writes it back,
+	// retrying on conflict. change may return an error to leave it as it is.
+	Update(ctx context.Context, namespace, id string, change func(*domain.Operation) error) (*domain.Operation, error)
+	Delete(ctx context.Context, namespace, id string) error
+}
+
+const (
+	// labelOperation marks the ConfigMaps holding operations and carries the
+	// name of the VLLM resource the operation is about.
+	labelOperation = "vllm.ai/operation-of"
+	operationKey   = "operation.json"
+)
+
+// ConfigMapOperationStore keeps each operation as a ConfigMap in the
+// operation's namespace, so operations outlive the server and are shared by
+// its replicas. The ConfigMaps are not owned by the VLLM resource: an
+// operation stays readable after the resource is gone.
+type ConfigMapOperationStore struct {
+	clientset kubernetes.Interface
+}
+
+func NewConfigMapOperationStore(clientset kubernetes.Interface) *ConfigMapOperationStore {
+	return &ConfigMapOperationStore{clientset: clientset}
+}
+
+func operationConfigMapName(id string) string {
+	return "vllm-operation-" + id
+}
+
+func (s *ConfigMapOperationStore) Create(ctx context.Context, op *domain.Operation) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      operationConfigMapName(op.ID),
+			Namespace: op.Namespace,
+			Labels: map[string]string{
+				labelOperation:                 op.Resource,
+				"app.kubernetes.io/managed-by": "connect-go",
+			},
+		},
+	}
+	if err := encodeOperation(cm, op); err != nil {
+		return err
+	}
+	if _, err := s.clientset.CoreV1().ConfigMaps(op.Namespace).Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to store operation %s: %w", op.ID, err)
+	}
+	return nil
+}
+
+func (s *ConfigMapOperationStore) Get(ctx context.Context, namespace, id string) (*domain.Operation, error) {
+	cm, err := s.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, operationConfigMapName(id), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s/%s", domain.ErrOperationNotFound, namespace, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operation %s: %w", id, err)
+	}
+	return decodeOperation(cm)
+}
+
+func (s *ConfigMapOperationStore) List(ctx context.Context, namespace string) ([]domain.Operation, error) {
+	list, err := s.clientset.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelOperation})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list operations: %w", err)
+	}
+	ops := make([]domain.Operation, 0, len(list.Items))
+	for i := range list.Items {
+		op, err := decodeOperation(&list.Items[i])
+		if err != nil {
+			continue
+		}
+		ops = append(ops, *op)
+	}
+	return ops, nil
+}
+
+func (s *ConfigMapOperationStore) Update(ctx context.Context, namespace, id string, change func(*domain.Operation) error) (*domain.Operation, error) {
+	configMaps := s.clientset.CoreV1().ConfigMaps(namespace)
+	var op *domain.Operation
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := configMaps.Get(ctx, operationConfigMapName(id), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("%w: %s/%s", domain.ErrOperationNotFound, namespace, id)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get operation %s: %w", id, err)
+		}
+		if op, err = decodeOperation(cm); err != nil {
+			return err
+		}
+		if err := change(op); err != nil {
+			return err
+		}
+		if err := encodeOperation(cm, op); err != nil {
+			return err
+		}
+		// The Update carries the resourceVersion of the Get, so two replicas
+		// cannot both take over the same operation.
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (s *ConfigMapOperationStore) Delete(ctx context.Context, namespace, id string) error {
+	err := s.clientset.CoreV1().ConfigMaps(namespace).Delete(ctx, operationConfigMapName(id), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete operation %s: %w", id, err)
+	}
+	return nil
+}
+
+func encodeOperation(cm *corev1.ConfigMap, op *domain.Operation) error {
+	b, err := json.Marshal(op)
+	if err != nil {
+		return fmt.Errorf("failed to encode operation %s: %w", op.ID, err)
+	}
+	cm.Data = map[string]string{operationKey: string(b)}
+	return nil
+}
+
+func decodeOperation(cm *corev1.ConfigMap) (*domain.Operation, error) {
+	var op domain.Operation
+	if err := json.Unmarshal([]byte(cm.Data[operationKey]), &op); err != nil {
+		return nil, fmt.Errorf("failed to decode operation in ConfigMap %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	return &op, nil
+}
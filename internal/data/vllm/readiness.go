@@ -0,0 +1,190 @@
+package vllm
+
+import (
+	logCore "connect-go/internal/core/logging"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ReadinessChecker reports how far a VLLM resource is from serving requests.
+type ReadinessChecker interface {
+	Check(ctx context.Context, namespace, resource string) (domain.ReadinessProgress, error)
+}
+
+// PodReadinessChecker inspects the pods behind a runtime's Service and, once
+// they are ready, probes the engine's /health and /v1/models endpoints.
+type PodReadinessChecker struct {
+	clientset kubernetes.Interface
+	client    dynamic.Interface
+	http      *http.Client
+}
+
+func NewPodReadinessChecker(clientset kubernetes.Interface, client dynamic.Interface, httpClient *http.Client) *PodReadinessChecker {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 5 * time.Second, Transport: logCore.RequestIDTransport(nil)}
+	}
+	return &PodReadinessChecker{
+		clientset: clientset,
+		client:    client,
+		http:      httpClient,
+	}
+}
+
+// Check returns the current readiness phase of a runtime. It wraps
+// domain.ErrRuntimeFailed when the runtime cannot become ready on its own.
+func (c *PodReadinessChecker) Check(ctx context.Context, namespace, resource string) (domain.ReadinessProgress, error) {
+	now := time.Now()
+	obj, err := newTracedResource(c.client, namespace).Get(ctx, resource, metav1.GetOptions{})
+	if err != nil {
+		return domain.ReadinessProgress{}, fmt.Errorf("failed to get VLLM resource %q: %w", resource, err)
+	}
+	v, err := typedVLLM(obj)
+	if err != nil {
+		return domain.ReadinessProgress{}, err
+	}
+	if v.Status.Phase == string(domain.StatusFailed) {
+		return domain.ReadinessProgress{}, fmt.Errorf("%w: %s", domain.ErrRuntimeFailed, v.Status.Message)
+	}
+
+	svc, err := c.clientset.CoreV1().Services(namespace).Get(ctx, resource, metav1.GetOptions{})
+	if errors.IsNotFound(err) || (err == nil && len(svc.Spec.Selector) == 0) {
+		return domain.ReadinessProgress{Phase: domain.ReadinessScheduling, Message: "waiting for the runtime service", Time: now}, nil
+	}
+	if err != nil {
+		return domain.ReadinessProgress{}, fmt.Errorf("failed to get service %q: %w", resource, err)
+	}
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+	})
+	if err != nil {
+		return domain.ReadinessProgress{}, fmt.Errorf("failed to list pods of %q: %w", resource, err)
+	}
+	progress, err := podsProgress(pods.Items)
+	if err != nil || progress.Phase != domain.ReadinessReady {
+		progress.Time = now
+		return progress, err
+	}
+
+	if err := c.probeEngine(ctx, engineEndpoint(v)); err != nil {
+		return domain.ReadinessProgress{Phase: domain.ReadinessLoadingWeights, Message: err.Error(), Time: now}, nil
+	}
+	return domain.ReadinessProgress{Phase: domain.ReadinessReady, Message: fmt.Sprintf("%d pod(s) ready and serving", len(pods.Items)), Time: now}, nil
+}
+
+// podsProgress reports the least advanced phase across the runtime's pods.
+func podsProgress(pods []corev1.Pod) (domain.ReadinessProgress, error) {
+	if len(pods) == 0 {
+		return domain.ReadinessProgress{Phase: domain.ReadinessScheduling, Message: "waiting for pods to be created"}, nil
+	}
+	ready := 0
+	progress := domain.ReadinessProgress{Phase: domain.ReadinessReady}
+	lower := func(phase domain.ReadinessPhase, message string) {
+		if readinessRank[phase] < readinessRank[progress.Phase] {
+			progress = domain.ReadinessProgress{Phase: phase, Message: message}
+		}
+	}
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if !podConditionTrue(pod, corev1.PodScheduled) {
+			lower(domain.ReadinessScheduling, fmt.Sprintf("pod %s is not scheduled: %s", pod.Name, podConditionMessage(pod, corev1.PodScheduled)))
+			continue
+		}
+		for _, cs := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			if w := cs.State.Waiting; w != nil {
+				switch w.Reason {
+				case "InvalidImageName", "ErrImageNeverPull", "CreateContainerConfigError":
+					return domain.ReadinessProgress{}, fmt.Errorf("%w: pod %s: %s: %s", domain.ErrRuntimeFailed, pod.Name, w.Reason, w.Message)
+				case "ContainerCreating", "PodInitializing", "ErrImagePull", "ImagePullBackOff":
+					lower(domain.ReadinessPullingImage, fmt.Sprintf("pod %s: %s", pod.Name, w.Reason))
+				default:
+					lower(domain.ReadinessLoadingWeights, fmt.Sprintf("pod %s: %s", pod.Name, w.Reason))
+				}
+			}
+		}
+		if podConditionTrue(pod, corev1.PodReady) {
+			ready++
+		} else {
+			lower(domain.ReadinessLoadingWeights, fmt.Sprintf("pod %s is running but not ready", pod.Name))
+		}
+	}
+	if ready == 0 && progress.Phase == domain.ReadinessReady {
+		return domain.ReadinessProgress{Phase: domain.ReadinessScheduling, Message: "waiting for pods to be created"}, nil
+	}
+	return progress, nil
+}
+
+var readinessRank = map[domain.ReadinessPhase]int{
+	domain.ReadinessScheduling:     0,
+	domain.ReadinessPullingImage:   1,
+	domain.ReadinessLoadingWeights: 2,
+	domain.ReadinessReady:          3,
+}
+
+func podConditionTrue(pod corev1.Pod, t corev1.PodConditionType) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == t {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podConditionMessage(pod corev1.Pod, t corev1.PodConditionType) string {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == t && c.Message != "" {
+			return c.Message
+		}
+	}
+	return "pending"
+}
+
+// probeEngine succeeds once the engine is healthy and lists at least one model.
+func (c *PodReadinessChecker) probeEngine(ctx context.Context, endpoint string) error {
+	if err := c.get(ctx, endpoint+"/health", nil); err != nil {
+		return fmt.Errorf("engine health check failed: %w", err)
+	}
+	var models struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, endpoint+"/v1/models", &models); err != nil {
+		return fmt.Errorf("engine model listing failed: %w", err)
+	}
+	if len(models.Data) == 0 {
+		return fmt.Errorf("engine is not serving any model yet")
+	}
+	return nil
+}
+
+func (c *PodReadinessChecker) get(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -5,9 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -53,13 +53,6 @@ func (r *K8sVLLMRepository) UpdateCRStatusToStart(namespace, name, model string)
 			"phase":     "Starting",
 			"message":   fmt.Sprintf("vLLM model '%s' is starting", model),
 			"startTime": now,
-			"condition": map[string]interface{}{
-				"type":               "Starting",
-				"status":             "True",
-				"lastTransitionTime": now,
-				"reason":             "ModelStartRequested",
-				"message":            "vLLM model start operation initiated",
-			},
 		},
 	}
 
@@ -76,16 +69,22 @@ func (r *K8sVLLMRepository) UpdateCRStatusToStart(namespace, name, model string)
 	}
 
 	// Patch the CR status
-	_, err = dynamicClient.Resource(r.getVLLMGVR()).
+	_, err = dynamicClient.Resource(vllmGVR).
 		Namespace(namespace).
 		Patch(ctx, name, types.MergePatchType, patchBytes, metav1.PatchOptions{}, "status")
 	if err != nil {
 		return fmt.Errorf("failed to patch CR status: %w", err)
 	}
+	if err := setConditions(ctx, dynamicClient, namespace, name, metav1.Condition{
+		Type:    vllm.ConditionProgressing,
+		Status:  metav1.ConditionTrue,
+		Reason:  vllm.ReasonStartRequested,
+		Message: "vLLM model start operation initiated",
+	}); err != nil {
+		return fmt.Errorf("failed to set CR conditions: %w", err)
+	}
 
-	// Log the successful update
-	fmt.Printf("Successfully updated CR %s in namespace %s to status: Starting for model %s\n",
-		name, namespace, model)
+	slog.InfoContext(ctx, "updated VLLM status", "namespace", namespace, "resource", name, "phase", "Starting", "model", model)
 
 	return nil
 }
@@ -97,11 +96,3 @@ func (r *K8sVLLMRepository) getDynamicClient() (dynamic.Interface, error) {
 	}
 	return dynamicClient, nil
 }
-
-func (r *K8sVLLMRepository) getVLLMGVR() schema.GroupVersionResource {
-	return schema.GroupVersionResource{
-		Group:    "vllm.ai",
-		Version:  "v1",
-		Resource: "vllms",
-	}
-}
@@ -0,0 +1,191 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// RevisionParams is what a new revision changes relative to the stable one.
+// Empty fields are copied from the stable revision.
+type RevisionParams struct {
+	Model string
+	Image string
+}
+
+// CreateRevision starts a copy of the stable VLLM resource with the given
+// changes applied, under a new name, and returns the created revision.
+func (a *VLLMAPI) CreateRevision(ctx context.Context, namespace, stable string, p RevisionParams) (*domain.SpecChange, error) {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	resourceClient := newTracedResource(dynamicClient, namespace)
+	existing, err := resourceClient.Get(ctx, stable, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VLLM resource %q: %w", stable, err)
+	}
+
+	base := existing.GetLabels()[domain.LabelRevisionOf]
+	if base == "" {
+		base = stable
+	}
+	spec := specOf(existing)
+	if spec == nil {
+		return nil, fmt.Errorf("VLLM resource %q has no spec", stable)
+	}
+	revision := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": existing.GetAPIVersion(),
+		"kind":       existing.GetKind(),
+		"spec":       spec,
+	}}
+	revision.SetName(fmt.Sprintf("%s-%s", base, rand.String(5)))
+	revision.SetNamespace(namespace)
+	labels := existing.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[domain.LabelRevisionOf] = base
+	revision.SetLabels(labels)
+
+	if p.Model != "" {
+		if err := unstructured.SetNestedField(revision.Object, p.Model, "spec", "model"); err != nil {
+			return nil, fmt.Errorf("failed to set spec.model: %w", err)
+		}
+	}
+	if p.Image != "" {
+		if err := unstructured.SetNestedField(revision.Object, p.Image, "spec", "deploymentConfig", "image", "name"); err != nil {
+			return nil, fmt.Errorf("failed to set spec.deploymentConfig.image.name: %w", err)
+		}
+	}
+	// A revision only takes traffic through the rollout, never on its own schedule.
+	unstructured.RemoveNestedField(revision.Object, "spec", "schedule")
+	if err := unstructured.SetNestedField(revision.Object, domain.ActionStart, "spec", "action"); err != nil {
+		return nil, fmt.Errorf("failed to set spec.action: %w", err)
+	}
+
+	created, err := resourceClient.Create(ctx, revision, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create revision of %q: %w", stable, err)
+	}
+	slog.InfoContext(ctx, "created VLLM revision", "namespace", namespace, "stable", stable, "revision", created.GetName())
+	return &domain.SpecChange{Resource: created.GetName(), Before: spec, After: specOf(created)}, nil
+}
+
+// GetRollout returns the rollout recorded on a stable VLLM resource.
+func (a *VLLMAPI) GetRollout(ctx context.Context, namespace, stable string) (*domain.Rollout, error) {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	obj, err := newTracedResource(dynamicClient, namespace).Get(ctx, stable, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: VLLM resource %q not found", domain.ErrNoRollout, stable)
+		}
+		return nil, fmt.Errorf("failed to get VLLM resource %q: %w", stable, err)
+	}
+	v, err := typedVLLM(obj)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := rolloutOf(v)
+	if !ok {
+		return nil, fmt.Errorf("%w for %s/%s", domain.ErrNoRollout, namespace, stable)
+	}
+	return r, nil
+}
+
+// ListRollouts returns every progressing rollout in all namespaces.
+func (a *VLLMAPI) ListRollouts(ctx context.Context) ([]domain.Rollout, error) {
+	list, err := a.listVLLMs(ctx, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	var rollouts []domain.Rollout
+	for _, v := range list {
+		if r, ok := rolloutOf(v); ok && r.Phase == domain.RolloutProgressing {
+			rollouts = append(rollouts, *r)
+		}
+	}
+	return rollouts, nil
+}
+
+// SetRolloutStatus records a rollout in status.rollout of its stable resource.
+func (a *VLLMAPI) SetRolloutStatus(ctx context.Context, r *domain.Rollout) error {
+	return a.patchStatus(ctx, r.Namespace, r.Stable, map[string]interface{}{
+		"rollout": map[string]interface{}{
+			"canary":              r.Canary,
+			"strategy":            string(r.Spec.Strategy),
+			"route":               r.Spec.Route,
+			"steps":               r.Spec.Steps,
+			"stepSeconds":         int64(r.Spec.StepDuration / time.Second),
+			"maxErrorRate":        r.Spec.MaxErrorRate,
+			"maxTTFTSeconds":      r.Spec.MaxTTFTSeconds,
+			"readyTimeoutSeconds": int64(r.Spec.ReadyTimeout / time.Second),
+			"step":                r.Step,
+			"weight":              r.Weight(),
+			"phase":               string(r.Phase),
+			"message":             r.Message,
+			"startedAt":           r.StartedAt.UTC().Format(time.RFC3339),
+			"stepStartedAt":       r.StepStartedAt.UTC().Format(time.RFC3339),
+		},
+	})
+}
+
+// RuntimePort returns the port a runtime's Service listens on.
+func (a *VLLMAPI) RuntimePort(ctx context.Context, namespace, name string) (int32, error) {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return 0, err
+	}
+	obj, err := newTracedResource(dynamicClient, namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+	}
+	v, err := typedVLLM(obj)
+	if err != nil {
+		return 0, err
+	}
+	return enginePort(v), nil
+}
+
+func rolloutOf(v *vllmv1.VLLM) (*domain.Rollout, bool) {
+	st := v.Status.Rollout
+	if st == nil {
+		return nil, false
+	}
+	r := &domain.Rollout{
+		Namespace: v.Namespace,
+		Stable:    v.Name,
+		Canary:    st.Canary,
+		Spec: domain.RolloutSpec{
+			Strategy:       domain.RolloutStrategy(st.Strategy),
+			Route:          st.Route,
+			Steps:          slices.Clone(st.Steps),
+			StepDuration:   time.Duration(st.StepSeconds) * time.Second,
+			MaxErrorRate:   st.MaxErrorRate,
+			MaxTTFTSeconds: st.MaxTTFTSeconds,
+			ReadyTimeout:   time.Duration(st.ReadyTimeoutSeconds) * time.Second,
+		},
+		Step:    int(st.Step),
+		Phase:   domain.RolloutPhase(st.Phase),
+		Message: st.Message,
+	}
+	if st.StartedAt != nil {
+		r.StartedAt = st.StartedAt.Time
+	}
+	if st.StepStartedAt != nil {
+		r.StepStartedAt = st.StepStartedAt.Time
+	}
+	return r, true
+}
@@ -0,0 +1,306 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// ModelRouter is the service discovery of the router in front of the
+// runtimes. Registering tells it to send requests for the backend's model to
+// the backend; deregistering stops that. Both are idempotent.
+type ModelRouter interface {
+	Register(ctx context.Context, b domain.RouterBackend) error
+	Deregister(ctx context.Context, namespace, name string) error
+}
+
+// Keys of the ConfigMap a StaticRouter writes.
+const (
+	// routerConfigKey holds the router's dynamic config, which the router
+	// reads from the file given with --dynamic-config-json.
+	routerConfigKey = "dynamic_config.json"
+	// routerBackendsKey holds the registered backends by runtime, so the
+	// comma-separated lists in the dynamic config can be rebuilt.
+	routerBackendsKey = "backends.json"
+)
+
+// StaticRouter registers runtimes with a router using static service
+// discovery. It keeps static_backends and static_models of the router's
+// dynamic config in a ConfigMap mounted into the router, which picks up
+// changes without a restart. Other settings in the config are kept.
+type StaticRouter struct {
+	clientset kubernetes.Interface
+	namespace string
+	name      string
+}
+
+func NewStaticRouter(clientset kubernetes.Interface, namespace, name string) *StaticRouter {
+	return &StaticRouter{
+		clientset: clientset,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+func (r *StaticRouter) Register(ctx context.Context, b domain.RouterBackend) (err error) {
+	ctx, span := tracing.Start(ctx, "StaticRouter.Register", trace.WithAttributes(
+		attribute.String("vllm.namespace", b.Namespace),
+		attribute.String("vllm.resource", b.Name),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	return r.update(ctx, func(backends map[string]domain.RouterBackend) {
+		backends[b.Key()] = b
+	})
+}
+
+func (r *StaticRouter) Deregister(ctx context.Context, namespace, name string) (err error) {
+	ctx, span := tracing.Start(ctx, "StaticRouter.Deregister", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.resource", name),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	return r.update(ctx, func(backends map[string]domain.RouterBackend) {
+		delete(backends, domain.RouterBackend{Namespace: namespace, Name: name}.Key())
+	})
+}
+
+// update applies change to the registered backends and rewrites the
+// ConfigMap when they differ, creating it on first use. The Update carries
+// the resourceVersion of the Get, so concurrent writers retry instead of
+// dropping each other's backends.
+func (r *StaticRouter) update(ctx context.Context, change func(map[string]domain.RouterBackend)) error {
+	configMaps := r.clientset.CoreV1().ConfigMaps(r.namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := configMaps.Get(ctx, r.name, metav1.GetOptions{})
+		create := apierrors.IsNotFound(err)
+		if create {
+			cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: r.name, Namespace: r.namespace}}
+		} else if err != nil {
+			return fmt.Errorf("failed to get router ConfigMap %q: %w", r.name, err)
+		}
+		backends := map[string]domain.RouterBackend{}
+		if raw := cm.Data[routerBackendsKey]; raw != "" {
+			if err := json.Unmarshal([]byte(raw), &backends); err != nil {
+				return fmt.Errorf("failed to decode %s of router ConfigMap %q: %w", routerBackendsKey, r.name, err)
+			}
+		}
+		before := maps.Clone(backends)
+		change(backends)
+		if maps.Equal(before, backends) {
+			return nil
+		}
+
+		config, err := staticRouterConfig(cm.Data[routerConfigKey], backends)
+		if err != nil {
+			return fmt.Errorf("failed to render config of router ConfigMap %q: %w", r.name, err)
+		}
+		encoded, err := json.Marshal(backends)
+		if err != nil {
+			return fmt.Errorf("failed to encode router backends: %w", err)
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[routerConfigKey] = config
+		cm.Data[routerBackendsKey] = string(encoded)
+		if create {
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		} else {
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		return err
+	})
+}
+
+// staticRouterConfig sets static service discovery with backends in the
+// router's dynamic config raw, in the order of their keys so the config only
+// changes when the backends do. A router with no routing logic set gets
+// round-robin.
+func staticRouterConfig(raw string, backends map[string]domain.RouterBackend) (string, error) {
+	config := map[string]interface{}{}
+	if raw != "" {
+		if err := json.Unmarshal([]byte(raw), &config); err != nil {
+			return "", fmt.Errorf("failed to decode %s: %w", routerConfigKey, err)
+		}
+	}
+	var urls, models []string
+	for _, key := range slices.Sorted(maps.Keys(backends)) {
+		urls = append(urls, backends[key].Endpoint)
+		models = append(models, backends[key].Model)
+	}
+	config["service_discovery"] = domain.RouterDiscoveryStatic
+	if _, ok := config["routing_logic"]; !ok {
+		config["routing_logic"] = "roundrobin"
+	}
+	config["static_backends"] = strings.Join(urls, ",")
+	config["static_models"] = strings.Join(models, ",")
+	b, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// LabelRouter registers runtimes with a router using Kubernetes service
+// discovery, which sends traffic to the ready pods matching its label
+// selector and asks each for the models it serves. Registering puts the
+// selector's labels on the runtime's pods; deregistering takes them off.
+// The pods are labelled directly rather than through the Deployment so the
+// runtime is not rolled; pods started later are labelled on the next
+// Register.
+type LabelRouter struct {
+	clientset kubernetes.Interface
+	labels    map[string]string
+}
+
+// NewLabelRouter returns a LabelRouter for a router started with
+// --k8s-label-selector set to the given labels.
+func NewLabelRouter(clientset kubernetes.Interface, labels map[string]string) *LabelRouter {
+	return &LabelRouter{
+		clientset: clientset,
+		labels:    labels,
+	}
+}
+
+func (r *LabelRouter) Register(ctx context.Context, b domain.RouterBackend) (err error) {
+	ctx, span := tracing.Start(ctx, "LabelRouter.Register", trace.WithAttributes(
+		attribute.String("vllm.namespace", b.Namespace),
+		attribute.String("vllm.resource", b.Name),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	return r.relabel(ctx, b.Namespace, b.Name, true)
+}
+
+func (r *LabelRouter) Deregister(ctx context.Context, namespace, name string) (err error) {
+	ctx, span := tracing.Start(ctx, "LabelRouter.Deregister", trace.WithAttributes(
+		attribute.String("vllm.namespace", namespace),
+		attribute.String("vllm.resource", name),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	return r.relabel(ctx, namespace, name, false)
+}
+
+// relabel adds or removes the router labels on each pod of the runtime that
+// needs it, and returns every failure.
+func (r *LabelRouter) relabel(ctx context.Context, namespace, name string, add bool) error {
+	pods := r.clientset.CoreV1().Pods(namespace)
+	list, err := pods.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{domain.LabelRuntime: name}).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pods of runtime %q: %w", name, err)
+	}
+	var errs []error
+	for _, pod := range list.Items {
+		changes := map[string]interface{}{}
+		for k, v := range r.labels {
+			have, ok := pod.Labels[k]
+			switch {
+			case add && (!ok || have != v):
+				changes[k] = v
+			case !add && ok:
+				changes[k] = nil
+			}
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		patchBytes, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{"labels": changes},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal label patch: %w", err)
+		}
+		_, err = pods.Patch(ctx, pod.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to label pod %q: %w", pod.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// MemoryRouter keeps registrations in memory. It stands in for a router in
+// tests and local runs.
+type MemoryRouter struct {
+	mu       sync.RWMutex
+	backends map[string]domain.RouterBackend
+}
+
+func NewMemoryRouter() *MemoryRouter {
+	return &MemoryRouter{backends: map[string]domain.RouterBackend{}}
+}
+
+func (r *MemoryRouter) Register(_ context.Context, b domain.RouterBackend) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.backends[b.Key()] = b
+	return nil
+}
+
+func (r *MemoryRouter) Deregister(_ context.Context, namespace, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.backends, domain.RouterBackend{Namespace: namespace, Name: name}.Key())
+	return nil
+}
+
+// Backends returns the registered backends ordered by namespace and name.
+func (r *MemoryRouter) Backends() []domain.RouterBackend {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	backends := make([]domain.RouterBackend, 0, len(r.backends))
+	for _, key := range slices.Sorted(maps.Keys(r.backends)) {
+		backends = append(backends, r.backends[key])
+	}
+	return backends
+}
+
+// ListRouterTargets returns every VLLM resource in all namespaces that is not
+// being deleted, with the backend it registers as. A runtime serves while it
+// is not asked to stop and its Ready condition is True.
+func (a *VLLMAPI) ListRouterTargets(ctx context.Context) ([]domain.RouterTarget, error) {
+	list, err := a.listVLLMs(ctx, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]domain.RouterTarget, 0, len(list))
+	for _, v := range list {
+		if v.DeletionTimestamp != nil {
+			// The cleanup controller deregisters deleted runtimes.
+			continue
+		}
+		targets = append(targets, domain.RouterTarget{
+			RouterBackend: domain.RouterBackend{
+				Namespace: v.Namespace,
+				Name:      v.Name,
+				Endpoint:  engineEndpoint(v),
+				Model:     v.Spec.Model,
+			},
+			Serving: v.Spec.Action != vllmv1.ActionStop && meta.IsStatusConditionTrue(v.Status.Conditions, domain.ConditionReady),
+		})
+	}
+	return targets, nil
+}
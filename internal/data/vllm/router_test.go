@@ -0,0 +1,126 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"encoding/json"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func routerConfig(t *testing.T, clientset *fake.Clientset) map[string]interface{} {
+	t.Helper()
+	cm, err := clientset.CoreV1().ConfigMaps("router").Get(t.Context(), "dynamic-config", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(cm.Data[routerConfigKey]), &config); err != nil {
+		t.Fatal(err)
+	}
+	return config
+}
+
+func TestStaticRouter(t *testing.T) {
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "router", Name: "dynamic-config"},
+		Data:       map[string]string{routerConfigKey: `{"routing_logic": "session", "session_key": "x-user-id"}`},
+	}
+	clientset := fake.NewSimpleClientset(existing)
+	router := NewStaticRouter(clientset, "router", "dynamic-config")
+
+	for _, b := range []domain.RouterBackend{
+		{Namespace: "default", Name: "qwen", Endpoint: "http://qwen:8000", Model: "Qwen/Qwen2.5-7B"},
+		{Namespace: "default", Name: "llama", Endpoint: "http://llama:8000", Model: "meta-llama/Llama-3.1-8B"},
+	} {
+		if err := router.Register(t.Context(), b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config := routerConfig(t, clientset)
+	if config["static_backends"] != "http://llama:8000,http://qwen:8000" || config["static_models"] != "meta-llama/Llama-3.1-8B,Qwen/Qwen2.5-7B" {
+		t.Errorf("backends %v, models %v; want both runtimes ordered by name", config["static_backends"], config["static_models"])
+	}
+	if config["service_discovery"] != domain.RouterDiscoveryStatic || config["routing_logic"] != "session" || config["session_key"] != "x-user-id" {
+		t.Errorf("other router settings were not kept: %v", config)
+	}
+
+	// Registering again changes nothing and does not write.
+	clientset.ClearActions()
+	if err := router.Register(t.Context(), domain.RouterBackend{Namespace: "default", Name: "qwen", Endpoint: "http://qwen:8000", Model: "Qwen/Qwen2.5-7B"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range clientset.Actions() {
+		if a.GetVerb() != "get" {
+			t.Errorf("repeated Register sent %s", a.GetVerb())
+		}
+	}
+
+	if err := router.Deregister(t.Context(), "default", "qwen"); err != nil {
+		t.Fatal(err)
+	}
+	if err := router.Deregister(t.Context(), "default", "missing"); err != nil {
+		t.Fatal(err)
+	}
+	config = routerConfig(t, clientset)
+	if config["static_backends"] != "http://llama:8000" || config["static_models"] != "meta-llama/Llama-3.1-8B" {
+		t.Errorf("backends %v, models %v; want only llama", config["static_backends"], config["static_models"])
+	}
+}
+
+func TestStaticRouterCreatesConfigMap(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	router := NewStaticRouter(clientset, "router", "dynamic-config")
+	if err := router.Register(t.Context(), domain.RouterBackend{Namespace: "default", Name: "llama", Endpoint: "http://llama:8000", Model: "llama"}); err != nil {
+		t.Fatal(err)
+	}
+	if config := routerConfig(t, clientset); config["routing_logic"] != "roundrobin" || config["static_backends"] != "http://llama:8000" {
+		t.Errorf("unexpected config %v", config)
+	}
+}
+
+func TestLabelRouter(t *testing.T) {
+	pod := func(name, runtime string, labels map[string]string) *corev1.Pod {
+		l := map[string]string{domain.LabelRuntime: runtime}
+		for k, v := range labels {
+			l[k] = v
+		}
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: l}}
+	}
+	clientset := fake.NewSimpleClientset(
+		pod("llama-a", "llama", nil),
+		pod("llama-b", "llama", map[string]string{"release": "old"}),
+		pod("qwen-a", "qwen", nil),
+	)
+	router := NewLabelRouter(clientset, map[string]string{"environment": "prod", "release": "router"})
+	podLabels := func(name string) map[string]string {
+		p, err := clientset.CoreV1().Pods("default").Get(t.Context(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p.Labels
+	}
+
+	if err := router.Register(t.Context(), domain.RouterBackend{Namespace: "default", Name: "llama"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"llama-a", "llama-b"} {
+		if l := podLabels(name); l["environment"] != "prod" || l["release"] != "router" || l[domain.LabelRuntime] != "llama" {
+			t.Errorf("%s labels = %v, want the router selector", name, l)
+		}
+	}
+	if l := podLabels("qwen-a"); l["environment"] != "" {
+		t.Errorf("pod of another runtime was labelled: %v", l)
+	}
+
+	if err := router.Deregister(t.Context(), "default", "llama"); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"llama-a", "llama-b"} {
+		if l := podLabels(name); l["environment"] != "" || l["release"] != "" || l[domain.LabelRuntime] != "llama" {
+			t.Errorf("%s labels = %v, want only the runtime label", name, l)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ListScheduled returns every VLLM resource, in all namespaces, that declares a
+// valid spec.schedule.
+func (a *VLLMAPI) ListScheduled(ctx context.Context) ([]domain.ScheduleTarget, error) {
+	list, err := a.listVLLMs(ctx, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []domain.ScheduleTarget
+	for _, v := range list {
+		schedule, found := scheduleOf(v)
+		if !found {
+			continue
+		}
+		if err := schedule.Validate(); err != nil {
+			slog.WarnContext(ctx, "ignoring invalid schedule", "namespace", v.Namespace, "resource", v.Name, "error", err)
+			continue
+		}
+		target := domain.ScheduleTarget{
+			Namespace:      v.Namespace,
+			Name:           v.Name,
+			Model:          v.Spec.Model,
+			Schedule:       schedule,
+			NextTransition: nextTransitionOf(v),
+		}
+		if st := v.Status.Schedule; st != nil && st.LastScheduleTime != nil {
+			target.LastScheduleTime = st.LastScheduleTime.Time
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// SetScheduleStatus records the last handled transition and the next one in
+// status.schedule. A nil next clears the next transition.
+func (a *VLLMAPI) SetScheduleStatus(ctx context.Context, namespace, name string, last time.Time, next *domain.ScheduledTransition) error {
+	schedule := map[string]interface{}{
+		"lastScheduleTime":   last.UTC().Format(time.RFC3339),
+		"nextAction":         nil,
+		"nextTransitionTime": nil,
+	}
+	if next != nil {
+		schedule["nextAction"] = next.Action
+		schedule["nextTransitionTime"] = next.Time.UTC().Format(time.RFC3339)
+	}
+	return a.patchStatus(ctx, namespace, name, map[string]interface{}{
+		"schedule": schedule,
+	})
+}
+
+func scheduleOf(v *vllmv1.VLLM) (domain.Schedule, bool) {
+	spec := v.Spec.Schedule
+	if spec == nil {
+		return domain.Schedule{}, false
+	}
+	s := domain.Schedule{
+		TimeZone: spec.TimeZone,
+		Start:    spec.Start,
+		Stop:     spec.Stop,
+		Suspend:  spec.Suspend,
+	}
+	if spec.OverrideUntil != nil {
+		s.OverrideUntil = spec.OverrideUntil.Time
+	}
+	return s, true
+}
+
+func nextTransitionOf(v *vllmv1.VLLM) *domain.ScheduledTransition {
+	st := v.Status.Schedule
+	if st == nil || st.NextAction == "" || st.NextTransitionTime == nil {
+		return nil
+	}
+	return &domain.ScheduledTransition{Action: string(st.NextAction), Time: st.NextTransitionTime.Time}
+}
+
+// SetAction patches spec.action on an existing VLLM resource without touching
+// the rest of its spec.
+func (a *VLLMAPI) SetAction(ctx context.Context, namespace, name, action string) (*domain.SpecChange, error) {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	resourceClient := newTracedResource(dynamicClient, namespace)
+	existing, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+	}
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"action": action,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patch: %w", err)
+	}
+	patched, err := resourceClient.Patch(ctx, name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch VLLM resource %q: %w", name, err)
+	}
+	slog.InfoContext(ctx, "patched VLLM resource", "namespace", namespace, "resource", name, "action", action)
+	return &domain.SpecChange{Resource: name, Before: specOf(existing), After: specOf(patched)}, nil
+}
@@ -0,0 +1,217 @@
+package vllm
+
+import (
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// artifactVolume is the volume the resolver adds; it is replaced on every resolve.
+	artifactVolume      = "model-artifact"
+	artifactInitName    = "fetch-model"
+	artifactMountPath   = "/mnt/models"
+	defaultS3FetchImage = "amazon/aws-cli:2.17.0"
+)
+
+// ArtifactStore checks that an artifact exists and reports its size.
+type ArtifactStore interface {
+	Stat(ctx context.Context, namespace string, loc domain.StorageLocation) (*domain.Artifact, error)
+}
+
+// StorageResolver validates spec.storageUri, checks the artifact with the store
+// for its scheme and generates what the runtime needs to reach it: a PVC volume,
+// or an emptyDir filled by an init container for S3 and Hub artifacts.
+type StorageResolver struct {
+	Stores map[string]ArtifactStore
+	// S3Endpoint is passed to the download container for S3-compatible stores.
+	S3Endpoint string
+	// S3FetchImage runs the S3 download; Hub downloads use the runtime image.
+	S3FetchImage string
+	// S3Secret and HFSecret name Secrets exposed to the download container as env.
+	S3Secret string
+	HFSecret string
+	// NodeCacheDir, when set, makes downloads land in a hostPath cache on the
+	// node instead of an emptyDir, so restarts and warmed nodes skip them.
+	NodeCacheDir string
+}
+
+// Resolve checks the artifact behind spec.storageUri of obj and rewrites
+// spec.deploymentConfig and spec.modelPath so the runtime finds it. Objects
+// without a storageUri are left alone.
+func (r *StorageResolver) Resolve(ctx context.Context, namespace string, obj *unstructured.Unstructured) (_ *domain.Artifact, err error) {
+	uri, _, _ := unstructured.NestedString(obj.Object, "spec", "storageUri")
+	if uri == "" {
+		return nil, nil
+	}
+	ctx, span := tracing.Start(ctx, "StorageResolver.Resolve", trace.WithAttributes(attribute.String("vllm.storage_uri", uri)))
+	defer func() { tracing.End(span, err) }()
+
+	loc, err := domain.ParseStorageURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	artifact := &domain.Artifact{Location: loc, SizeBytes: -1}
+	if store, ok := r.Stores[loc.Scheme]; ok {
+		if artifact, err = store.Stat(ctx, namespace, loc); err != nil {
+			return nil, err
+		}
+	} else {
+		slog.DebugContext(ctx, "no store configured; artifact not verified", "storage_uri", uri)
+	}
+
+	volumes, _, _ := unstructured.NestedSlice(obj.Object, "spec", "deploymentConfig", "volumes")
+	mounts, _, _ := unstructured.NestedSlice(obj.Object, "spec", "deploymentConfig", "volumeMounts")
+	inits, _, _ := unstructured.NestedSlice(obj.Object, "spec", "deploymentConfig", "initContainers")
+	volumes = withoutNamed(volumes, artifactVolume)
+	mounts = withoutNamed(mounts, artifactVolume)
+	inits = withoutNamed(inits, artifactInitName)
+
+	switch loc.Scheme {
+	case domain.SchemeFile:
+		artifact.LocalPath = loc.Path
+	case domain.SchemePVC:
+		volumes = append(volumes, map[string]interface{}{
+			"name": artifactVolume,
+			"persistentVolumeClaim": map[string]interface{}{
+				"claimName": loc.Claim,
+				"readOnly":  true,
+			},
+		})
+		mounts = append(mounts, map[string]interface{}{"name": artifactVolume, "mountPath": artifactMountPath, "readOnly": true})
+		artifact.LocalPath = path.Join(artifactMountPath, loc.Path)
+	case domain.SchemeS3, domain.SchemeHF:
+		volumes = append(volumes, r.fetchVolume(loc, artifact.SizeBytes))
+		mounts = append(mounts, map[string]interface{}{"name": artifactVolume, "mountPath": artifactMountPath, "readOnly": true})
+		artifact.LocalPath = path.Join(artifactMountPath, "model")
+		inits = append(inits, r.fetchContainer(obj, loc, artifact.LocalPath))
+	}
+
+	for field, value := range map[string][]interface{}{"volumes": volumes, "volumeMounts": mounts, "initContainers": inits} {
+		if len(value) == 0 {
+			unstructured.RemoveNestedField(obj.Object, "spec", "deploymentConfig", field)
+			continue
+		}
+		if err := unstructured.SetNestedSlice(obj.Object, value, "spec", "deploymentConfig", field); err != nil {
+			return nil, fmt.Errorf("failed to set spec.deploymentConfig.%s: %w", field, err)
+		}
+	}
+	if err := unstructured.SetNestedField(obj.Object, artifact.LocalPath, "spec", "modelPath"); err != nil {
+		return nil, fmt.Errorf("failed to set spec.modelPath: %w", err)
+	}
+	slog.InfoContext(ctx, "resolved model artifact", "storage_uri", uri, "size_bytes", artifact.SizeBytes, "files", artifact.Files, "path", artifact.LocalPath)
+	return artifact, nil
+}
+
+// fetchVolume is where downloaded artifacts are stored: the node cache when
+// configured, otherwise an emptyDir sized for the artifact.
+func (r *StorageResolver) fetchVolume(loc domain.StorageLocation, size int64) map[string]interface{} {
+	if r.NodeCacheDir != "" {
+		return map[string]interface{}{
+			"name": artifactVolume,
+			"hostPath": map[string]interface{}{
+				"path": r.CachePath(loc),
+				"type": "DirectoryOrCreate",
+			},
+		}
+	}
+	emptyDir := map[string]interface{}{}
+	if size > 0 {
+		// Leave headroom for temporary files written during the download.
+		emptyDir["sizeLimit"] = resource.NewQuantity(size+size/10, resource.BinarySI).String()
+	}
+	return map[string]interface{}{"name": artifactVolume, "emptyDir": emptyDir}
+}
+
+// CachePath is the node-local cache directory for an artifact.
+func (r *StorageResolver) CachePath(loc domain.StorageLocation) string {
+	sum := sha256.Sum256([]byte(loc.URI))
+	return path.Join(r.NodeCacheDir, hex.EncodeToString(sum[:8]))
+}
+
+// Cacheable reports whether an artifact is downloaded, and so can be warmed
+// into the node cache.
+func (r *StorageResolver) Cacheable(loc domain.StorageLocation) bool {
+	return r.NodeCacheDir != "" && (loc.Scheme == domain.SchemeS3 || loc.Scheme == domain.SchemeHF)
+}
+
+// fetchContainer downloads an S3 or Hub artifact into the artifact volume.
+func (r *StorageResolver) fetchContainer(obj *unstructured.Unstructured, loc domain.StorageLocation, dest string) map[string]interface{} {
+	var image, secret string
+	var command []interface{}
+	switch loc.Scheme {
+	case domain.SchemeS3:
+		image, secret = r.S3FetchImage, r.S3Secret
+		if image == "" {
+			image = defaultS3FetchImage
+		}
+		command = []interface{}{"aws", "s3", "sync", fmt.Sprintf("s3://%s/%s", loc.Bucket, loc.Path), dest, "--only-show-errors"}
+		if r.S3Endpoint != "" {
+			command = append(command, "--endpoint-url", r.S3Endpoint)
+		}
+	case domain.SchemeHF:
+		image, secret = runtimeImage(obj), r.HFSecret
+		command = []interface{}{"huggingface-cli", "download", loc.Repo, "--revision", loc.Revision, "--local-dir", dest}
+	}
+	c := map[string]interface{}{
+		"name":         artifactInitName,
+		"image":        image,
+		"command":      command,
+		"volumeMounts": []interface{}{map[string]interface{}{"name": artifactVolume, "mountPath": artifactMountPath}},
+	}
+	if secret != "" {
+		c["envFrom"] = []interface{}{map[string]interface{}{"secretRef": map[string]interface{}{"name": secret}}}
+	}
+	return c
+}
+
+func runtimeImage(obj *unstructured.Unstructured) string {
+	registry, _, _ := unstructured.NestedString(obj.Object, "spec", "deploymentConfig", "image", "registry")
+	name, _, _ := unstructured.NestedString(obj.Object, "spec", "deploymentConfig", "image", "name")
+	return imageRef(registry, name)
+}
+
+func imageRef(registry, name string) string {
+	if registry == "" {
+		return name
+	}
+	return strings.TrimSuffix(registry, "/") + "/" + name
+}
+
+func withoutNamed(items []interface{}, name string) []interface{} {
+	out := items[:0:0]
+	for _, item := range items {
+		if m, ok := item.(map[string]interface{}); ok && m["name"] == name {
+			continue
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// recordArtifact notes the resolved artifact in status.artifact. It is best
+// effort: the start has already been applied.
+func (a *VLLMAPI) recordArtifact(ctx context.Context, namespace, name string, artifact *domain.Artifact) {
+	err := a.patchStatus(ctx, namespace, name, map[string]interface{}{
+		"artifact": map[string]interface{}{
+			"storageUri": artifact.Location.URI,
+			"sizeBytes":  artifact.SizeBytes,
+			"files":      artifact.Files,
+			"path":       artifact.LocalPath,
+		},
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record artifact status", "namespace", namespace, "resource", name, "error", err)
+	}
+}
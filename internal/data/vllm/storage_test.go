@@ -0,0 +1,190 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func location(t *testing.T, uri string) domain.StorageLocation {
+	t.Helper()
+	loc, err := domain.ParseStorageURI(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+func TestFileStoreStat(t *testing.T) {
+	root := t.TempDir()
+	model := filepath.Join(root, "models", "llama")
+	if err := os.MkdirAll(filepath.Join(model, "tokenizer"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, size := range map[string]int{"model.safetensors": 100, "config.json": 20, "tokenizer/tokenizer.json": 5} {
+		if err := os.WriteFile(filepath.Join(model, name), make([]byte, size), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(root, "models", "empty"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	store := FileStore{Root: root}
+
+	artifact, err := store.Stat(t.Context(), "default", location(t, "file:///models/llama"))
+	if err != nil {
+		t.Fatalf("Stat() = %v", err)
+	}
+	if artifact.SizeBytes != 125 || artifact.Files != 3 {
+		t.Errorf("Stat() = %d bytes in %d files, want 125 in 3", artifact.SizeBytes, artifact.Files)
+	}
+	for _, uri := range []string{"file:///models/missing", "file:///models/empty"} {
+		if _, err := store.Stat(t.Context(), "default", location(t, uri)); !errors.Is(err, domain.ErrArtifactNotFound) {
+			t.Errorf("Stat(%s) = %v, want ErrArtifactNotFound", uri, err)
+		}
+	}
+}
+
+// fakeS3 answers ListObjectsV2 like MinIO for the objects it holds, keyed by
+// bucket and then object key.
+func fakeS3(t *testing.T, buckets map[string]map[string]int64) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bucket := strings.Trim(r.URL.Path, "/")
+		objects, ok := buckets[bucket]
+		w.Header().Set("Content-Type", "application/xml")
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message><BucketName>%s</BucketName></Error>`, bucket)
+			return
+		}
+		prefix := r.URL.Query().Get("prefix")
+		var contents strings.Builder
+		for key, size := range objects {
+			if strings.HasPrefix(key, prefix) {
+				fmt.Fprintf(&contents, `<Contents><Key>%s</Key><Size>%d</Size></Contents>`, key, size)
+			}
+		}
+		fmt.Fprintf(w, `<ListBucketResult><Name>%s</Name><Prefix>%s</Prefix><IsTruncated>false</IsTruncated>%s</ListBucketResult>`, bucket, prefix, contents.String())
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestS3StoreStat(t *testing.T) {
+	server := fakeS3(t, map[string]map[string]int64{
+		"weights": {
+			"llama/8b/model.safetensors": 1000,
+			"llama/8b/config.json":       24,
+			"llama/8b-instruct/x":        7,
+		},
+	})
+	store, err := NewS3Store(strings.TrimPrefix(server.URL, "http://"), "access", "secret", "us-east-1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	artifact, err := store.Stat(t.Context(), "default", location(t, "s3://weights/llama/8b"))
+	if err != nil {
+		t.Fatalf("Stat() = %v", err)
+	}
+	if artifact.SizeBytes != 1024 || artifact.Files != 2 {
+		t.Errorf("Stat() = %d bytes in %d files, want 1024 in 2", artifact.SizeBytes, artifact.Files)
+	}
+	for _, uri := range []string{"s3://weights/mistral", "s3://missing/llama"} {
+		if _, err := store.Stat(t.Context(), "default", location(t, uri)); !errors.Is(err, domain.ErrArtifactNotFound) {
+			t.Errorf("Stat(%s) = %v, want ErrArtifactNotFound", uri, err)
+		}
+	}
+}
+
+func TestPVCStoreStat(t *testing.T) {
+	store := PVCStore{Clientset: fake.NewSimpleClientset(
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "models"},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Phase:    corev1.ClaimBound,
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("100Gi")},
+			},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pending"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+		},
+	)}
+	artifact, err := store.Stat(t.Context(), "default", location(t, "pvc://models/llama"))
+	if err != nil {
+		t.Fatalf("Stat() = %v", err)
+	}
+	if artifact.SizeBytes != 100<<30 {
+		t.Errorf("SizeBytes = %d, want the claim capacity", artifact.SizeBytes)
+	}
+	for _, uri := range []string{"pvc://pending/llama", "pvc://missing/llama"} {
+		if _, err := store.Stat(t.Context(), "default", location(t, uri)); !errors.Is(err, domain.ErrArtifactNotFound) {
+			t.Errorf("Stat(%s) = %v, want ErrArtifactNotFound", uri, err)
+		}
+	}
+}
+
+func TestStorageResolverResolve(t *testing.T) {
+	server := fakeS3(t, map[string]map[string]int64{"weights": {"llama/8b/model.safetensors": 1000}})
+	s3, err := NewS3Store(strings.TrimPrefix(server.URL, "http://"), "access", "secret", "us-east-1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := &StorageResolver{Stores: map[string]ArtifactStore{domain.SchemeS3: s3}, S3Endpoint: server.URL, S3Secret: "s3-credentials"}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"storageUri": "s3://weights/llama/8b",
+			"deploymentConfig": map[string]interface{}{
+				// A volume left by an earlier resolve is replaced, others are kept.
+				"volumes": []interface{}{
+					map[string]interface{}{"name": artifactVolume, "emptyDir": map[string]interface{}{}},
+					map[string]interface{}{"name": "shm", "emptyDir": map[string]interface{}{"medium": "Memory"}},
+				},
+			},
+		},
+	}}
+
+	artifact, err := resolver.Resolve(t.Context(), "default", obj)
+	if err != nil {
+		t.Fatalf("Resolve() = %v", err)
+	}
+	if artifact.SizeBytes != 1000 || artifact.LocalPath != "/mnt/models/model" {
+		t.Errorf("Resolve() = %+v", artifact)
+	}
+	if path, _, _ := unstructured.NestedString(obj.Object, "spec", "modelPath"); path != "/mnt/models/model" {
+		t.Errorf("spec.modelPath = %q", path)
+	}
+	volumes, _, _ := unstructured.NestedSlice(obj.Object, "spec", "deploymentConfig", "volumes")
+	if len(volumes) != 2 || volumes[0].(map[string]interface{})["name"] != "shm" {
+		t.Fatalf("volumes = %v, want shm and one artifact volume", volumes)
+	}
+	if limit, _, _ := unstructured.NestedString(volumes[1].(map[string]interface{}), "emptyDir", "sizeLimit"); limit != "1100" {
+		t.Errorf("artifact volume sizeLimit = %q, want the size plus headroom", limit)
+	}
+	inits, _, _ := unstructured.NestedSlice(obj.Object, "spec", "deploymentConfig", "initContainers")
+	if len(inits) != 1 {
+		t.Fatalf("initContainers = %v, want the download", inits)
+	}
+	command, _, _ := unstructured.NestedSlice(inits[0].(map[string]interface{}), "command")
+	if got := fmt.Sprint(command); !strings.Contains(got, "s3://weights/llama/8b /mnt/models/model") || !strings.Contains(got, server.URL) {
+		t.Errorf("download command = %v", got)
+	}
+
+	obj.Object["spec"].(map[string]interface{})["storageUri"] = "s3://weights/missing"
+	if _, err := resolver.Resolve(t.Context(), "default", obj); !errors.Is(err, domain.ErrArtifactNotFound) {
+		t.Errorf("Resolve(missing) = %v, want ErrArtifactNotFound", err)
+	}
+}
@@ -0,0 +1,316 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"path"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	defaultPauseImage = "registry.k8s.io/pause:3.10"
+	warmupPullName    = "pull-image"
+	// warmupFailureRestarts is how many times a warm-up init container may fail
+	// on a node before the warm-up is reported as failed.
+	warmupFailureRestarts = 3
+)
+
+// defaultGPUNodeSelector targets nodes labelled by the NVIDIA GPU operator.
+var defaultGPUNodeSelector = map[string]string{"nvidia.com/gpu.present": "true"}
+
+// Warmer pre-pulls runtime images and caches weights on GPU nodes with a
+// DaemonSet whose init containers do the work and whose pods then idle.
+type Warmer struct {
+	clientset kubernetes.Interface
+	api       *VLLMAPI
+	storage   *StorageResolver
+	// PauseImage keeps warmed pods running until the warm-up is cleaned up.
+	PauseImage string
+}
+
+func NewWarmer(clientset kubernetes.Interface, api *VLLMAPI, storage *StorageResolver) *Warmer {
+	return &Warmer{
+		clientset:  clientset,
+		api:        api,
+		storage:    storage,
+		PauseImage: defaultPauseImage,
+	}
+}
+
+// Start creates the warm-up DaemonSet for a VLLM resource. nodeSelector
+// overrides spec.deploymentConfig.nodeSelector and the GPU node default.
+func (w *Warmer) Start(ctx context.Context, namespace, name string, nodeSelector map[string]string) (*domain.Warmup, error) {
+	dynamicClient, err := w.api.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	obj, err := newTracedResource(dynamicClient, namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+	}
+	if len(nodeSelector) == 0 {
+		nodeSelector, _, _ = unstructured.NestedStringMap(obj.Object, "spec", "deploymentConfig", "nodeSelector")
+	}
+	if len(nodeSelector) == 0 {
+		nodeSelector = defaultGPUNodeSelector
+	}
+	warmup := &domain.Warmup{
+		Namespace:    namespace,
+		Name:         name,
+		Image:        runtimeImage(obj),
+		NodeSelector: nodeSelector,
+		Phase:        domain.WarmupPending,
+		StartedAt:    time.Now(),
+	}
+	if warmup.Image == "" {
+		return nil, fmt.Errorf("VLLM resource %q has no spec.deploymentConfig.image", name)
+	}
+	pullPolicy, _, _ := unstructured.NestedString(obj.Object, "spec", "deploymentConfig", "image", "pullPolicy")
+
+	pod := corev1.PodSpec{
+		NodeSelector: nodeSelector,
+		Tolerations: []corev1.Toleration{{
+			Key:      "nvidia.com/gpu",
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		}},
+		InitContainers: []corev1.Container{{
+			Name:            warmupPullName,
+			Image:           warmup.Image,
+			ImagePullPolicy: corev1.PullPolicy(pullPolicy),
+			Command:         []string{"/bin/sh", "-c", "exit 0"},
+		}},
+		Containers: []corev1.Container{{
+			Name:  "idle",
+			Image: w.PauseImage,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("1m"),
+					corev1.ResourceMemory: resource.MustParse("8Mi"),
+				},
+			},
+		}},
+	}
+	if uri, _, _ := unstructured.NestedString(obj.Object, "spec", "storageUri"); uri != "" && w.storage != nil {
+		loc, err := domain.ParseStorageURI(uri)
+		if err != nil {
+			return nil, err
+		}
+		if w.storage.Cacheable(loc) {
+			if err := w.addFetch(&pod, obj, loc); err != nil {
+				return nil, err
+			}
+			warmup.CachePath = w.storage.CachePath(loc)
+		}
+	}
+
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      warmupName(name),
+			Namespace: namespace,
+			Labels:    map[string]string{domain.LabelWarmup: name},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{domain.LabelWarmup: name}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{domain.LabelWarmup: name}},
+				Spec:       pod,
+			},
+		},
+	}
+	daemonSets := w.clientset.AppsV1().DaemonSets(namespace)
+	// A finished warm-up may still have its DaemonSet if cleanup failed.
+	if err := daemonSets.Delete(ctx, ds.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to delete previous warm-up DaemonSet %q: %w", ds.Name, err)
+	}
+	if _, err := daemonSets.Create(ctx, ds, metav1.CreateOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to create warm-up DaemonSet %q: %w", ds.Name, err)
+	}
+	slog.InfoContext(ctx, "started warm-up", "namespace", namespace, "resource", name, "image", warmup.Image, "cache", warmup.CachePath, "nodes", nodeSelector)
+	return warmup, nil
+}
+
+// addFetch adds the artifact download, writing into the node cache, to the pod.
+func (w *Warmer) addFetch(pod *corev1.PodSpec, obj *unstructured.Unstructured, loc domain.StorageLocation) error {
+	var volume corev1.Volume
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(w.storage.fetchVolume(loc, -1), &volume); err != nil {
+		return fmt.Errorf("failed to build cache volume: %w", err)
+	}
+	var fetch corev1.Container
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(w.storage.fetchContainer(obj, loc, path.Join(artifactMountPath, "model")), &fetch); err != nil {
+		return fmt.Errorf("failed to build fetch container: %w", err)
+	}
+	pod.Volumes = append(pod.Volumes, volume)
+	pod.InitContainers = append(pod.InitContainers, fetch)
+	return nil
+}
+
+// Progress updates a running warm-up from its DaemonSet and pods.
+func (w *Warmer) Progress(ctx context.Context, warmup *domain.Warmup) error {
+	ds, err := w.clientset.AppsV1().DaemonSets(warmup.Namespace).Get(ctx, warmupName(warmup.Name), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		warmup.Phase, warmup.Message = domain.WarmupFailed, "warm-up DaemonSet was deleted"
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get warm-up DaemonSet: %w", err)
+	}
+	pods, err := w.clientset.CoreV1().Pods(warmup.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{domain.LabelWarmup: warmup.Name}).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list warm-up pods: %w", err)
+	}
+
+	warmup.NodesDesired = ds.Status.DesiredNumberScheduled
+	warmup.NodesReady = ds.Status.NumberReady
+	warmup.NodesPulled = 0
+	warmup.Message = ""
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.InitContainerStatuses {
+			switch {
+			case cs.Name == warmupPullName && cs.State.Terminated != nil && cs.State.Terminated.ExitCode == 0,
+				cs.Name == warmupPullName && cs.Ready:
+				warmup.NodesPulled++
+			case cs.RestartCount >= warmupFailureRestarts:
+				warmup.Phase = domain.WarmupFailed
+				warmup.Message = fmt.Sprintf("%s failed on node %s after %d attempts", cs.Name, pod.Spec.NodeName, cs.RestartCount)
+				return nil
+			case cs.State.Waiting != nil && cs.State.Waiting.Reason != "" && cs.State.Waiting.Reason != "PodInitializing":
+				warmup.Message = fmt.Sprintf("%s on node %s: %s", cs.Name, pod.Spec.NodeName, cs.State.Waiting.Reason)
+			}
+		}
+		if len(pod.Status.InitContainerStatuses) == 0 && podConditionTrue(pod, corev1.PodReady) {
+			warmup.NodesPulled++
+		}
+	}
+
+	switch {
+	case warmup.NodesDesired == 0:
+		warmup.Phase = domain.WarmupPending
+		if warmup.Message == "" {
+			warmup.Message = fmt.Sprintf("no nodes match %v", warmup.NodeSelector)
+		}
+	case warmup.NodesReady >= warmup.NodesDesired:
+		warmup.Phase = domain.WarmupCompleted
+		warmup.Message = fmt.Sprintf("warmed %d node(s)", warmup.NodesReady)
+	case warmup.NodesPulled < warmup.NodesDesired:
+		warmup.Phase = domain.WarmupPullingImage
+	default:
+		warmup.Phase = domain.WarmupCopyingWeights
+	}
+	return nil
+}
+
+// Cleanup removes the warm-up DaemonSet. The pulled image and cached weights
+// stay on the nodes.
+func (w *Warmer) Cleanup(ctx context.Context, namespace, name string) error {
+	err := w.clientset.AppsV1().DaemonSets(namespace).Delete(ctx, warmupName(name), metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete warm-up DaemonSet: %w", err)
+	}
+	return nil
+}
+
+func warmupName(name string) string {
+	return name + "-warmup"
+}
+
+// GetWarmup returns the warm-up recorded on a VLLM resource.
+func (a *VLLMAPI) GetWarmup(ctx context.Context, namespace, name string) (*domain.Warmup, error) {
+	dynamicClient, err := a.getDynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	obj, err := newTracedResource(dynamicClient, namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: VLLM resource %q not found", domain.ErrNoWarmup, name)
+		}
+		return nil, fmt.Errorf("failed to get VLLM resource %q: %w", name, err)
+	}
+	v, err := typedVLLM(obj)
+	if err != nil {
+		return nil, err
+	}
+	w, ok := warmupOf(v)
+	if !ok {
+		return nil, fmt.Errorf("%w for %s/%s", domain.ErrNoWarmup, namespace, name)
+	}
+	return w, nil
+}
+
+// ListWarmups returns every unfinished warm-up in all namespaces.
+func (a *VLLMAPI) ListWarmups(ctx context.Context) ([]domain.Warmup, error) {
+	list, err := a.listVLLMs(ctx, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	var warmups []domain.Warmup
+	for _, v := range list {
+		if w, ok := warmupOf(v); ok && !w.Done() {
+			warmups = append(warmups, *w)
+		}
+	}
+	return warmups, nil
+}
+
+// SetWarmupStatus records a warm-up in status.warmup.
+func (a *VLLMAPI) SetWarmupStatus(ctx context.Context, w *domain.Warmup) error {
+	status := map[string]interface{}{
+		"image":        w.Image,
+		"cachePath":    w.CachePath,
+		"nodeSelector": w.NodeSelector,
+		"phase":        string(w.Phase),
+		"message":      w.Message,
+		"nodesDesired": w.NodesDesired,
+		"nodesPulled":  w.NodesPulled,
+		"nodesReady":   w.NodesReady,
+		"startedAt":    w.StartedAt.UTC().Format(time.RFC3339),
+		"completedAt":  nil,
+	}
+	if !w.CompletedAt.IsZero() {
+		status["completedAt"] = w.CompletedAt.UTC().Format(time.RFC3339)
+	}
+	return a.patchStatus(ctx, w.Namespace, w.Name, map[string]interface{}{"warmup": status})
+}
+
+func warmupOf(v *vllmv1.VLLM) (*domain.Warmup, bool) {
+	st := v.Status.Warmup
+	if st == nil {
+		return nil, false
+	}
+	w := &domain.Warmup{
+		Namespace:    v.Namespace,
+		Name:         v.Name,
+		Image:        st.Image,
+		CachePath:    st.CachePath,
+		NodeSelector: maps.Clone(st.NodeSelector),
+		Phase:        domain.WarmupPhase(st.Phase),
+		Message:      st.Message,
+		NodesDesired: st.NodesDesired,
+		NodesPulled:  st.NodesPulled,
+		NodesReady:   st.NodesReady,
+	}
+	if st.StartedAt != nil {
+		w.StartedAt = st.StartedAt.Time
+	}
+	if st.CompletedAt != nil {
+		w.CompletedAt = st.CompletedAt.Time
+	}
+	return w, true
+}
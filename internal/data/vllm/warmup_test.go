@@ -0,0 +1,214 @@
+package vllm
+
+import (
+	domain "connect-go/internal/core/vllm"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// warmableVLLM is a runtime with an image and an S3 artifact.
+func warmableVLLM(nodeSelector map[string]interface{}) *unstructured.Unstructured {
+	obj := runningVLLM("default", "llama")
+	spec := obj.Object["spec"].(map[string]interface{})
+	spec["storageUri"] = "s3://weights/llama/8b"
+	spec["deploymentConfig"] = map[string]interface{}{
+		"image": map[string]interface{}{"registry": "docker.io", "name": "vllm/vllm-openai:v0.10.0", "pullPolicy": "IfNotPresent"},
+	}
+	if nodeSelector != nil {
+		spec["deploymentConfig"].(map[string]interface{})["nodeSelector"] = nodeSelector
+	}
+	return obj
+}
+
+func initContainerNames(pod corev1.PodSpec) []string {
+	var names []string
+	for _, c := range pod.InitContainers {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func TestWarmerStart(t *testing.T) {
+	// A DaemonSet left by an earlier warm-up is replaced.
+	stale := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama-warmup", Labels: map[string]string{"stale": "true"}}}
+	clientset := fake.NewSimpleClientset(stale)
+	api := &VLLMAPI{Client: newFakeDynamic(warmableVLLM(map[string]interface{}{"gpu": "h100"}))}
+	storage := &StorageResolver{NodeCacheDir: "/var/cache/vllm"}
+
+	warmup, err := NewWarmer(clientset, api, storage).Start(t.Context(), "default", "llama", nil)
+	if err != nil {
+		t.Fatalf("Start() = %v", err)
+	}
+	if warmup.Image != "docker.io/vllm/vllm-openai:v0.10.0" || warmup.Phase != domain.WarmupPending {
+		t.Errorf("warm-up = %+v, want the runtime image, pending", warmup)
+	}
+	if !strings.HasPrefix(warmup.CachePath, "/var/cache/vllm/") {
+		t.Errorf("cache path = %q, want one under the node cache", warmup.CachePath)
+	}
+
+	ds, err := clientset.AppsV1().DaemonSets("default").Get(t.Context(), "llama-warmup", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ds.Labels["stale"] != "" || ds.Labels[domain.LabelWarmup] != "llama" {
+		t.Errorf("labels = %v, want the new warm-up's", ds.Labels)
+	}
+	if ds.Spec.Selector.MatchLabels[domain.LabelWarmup] != "llama" || ds.Spec.Template.Labels[domain.LabelWarmup] != "llama" {
+		t.Errorf("selector %v does not match template labels %v", ds.Spec.Selector.MatchLabels, ds.Spec.Template.Labels)
+	}
+	pod := ds.Spec.Template.Spec
+	if pod.NodeSelector["gpu"] != "h100" {
+		t.Errorf("node selector = %v, want the runtime's", pod.NodeSelector)
+	}
+	if got := initContainerNames(pod); !slices.Equal(got, []string{warmupPullName, artifactInitName}) {
+		t.Fatalf("init containers = %v, want the image pull then the download", got)
+	}
+	pull := pod.InitContainers[0]
+	if pull.Image != warmup.Image || pull.ImagePullPolicy != corev1.PullIfNotPresent {
+		t.Errorf("pull container runs %s with %s, want the runtime image with its pull policy", pull.Image, pull.ImagePullPolicy)
+	}
+	if len(pod.Volumes) != 1 || pod.Volumes[0].HostPath == nil || pod.Volumes[0].HostPath.Path != warmup.CachePath {
+		t.Errorf("volumes = %+v, want the node cache at %s", pod.Volumes, warmup.CachePath)
+	}
+	if len(pod.Containers) != 1 || pod.Containers[0].Image != defaultPauseImage {
+		t.Errorf("containers = %+v, want one idling on the pause image", pod.Containers)
+	}
+}
+
+func TestWarmerStartNodeSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		runtime  map[string]interface{}
+		override map[string]string
+		want     map[string]string
+	}{
+		{"override", map[string]interface{}{"gpu": "h100"}, map[string]string{"gpu": "a100"}, map[string]string{"gpu": "a100"}},
+		{"runtime", map[string]interface{}{"gpu": "h100"}, nil, map[string]string{"gpu": "h100"}},
+		{"default", nil, nil, defaultGPUNodeSelector},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			api := &VLLMAPI{Client: newFakeDynamic(warmableVLLM(tt.runtime))}
+			// Without a node cache only the image is warmed.
+			warmup, err := NewWarmer(clientset, api, &StorageResolver{}).Start(t.Context(), "default", "llama", tt.override)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ds, err := clientset.AppsV1().DaemonSets("default").Get(t.Context(), "llama-warmup", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			pod := ds.Spec.Template.Spec
+			if !maps.Equal(pod.NodeSelector, tt.want) || !maps.Equal(warmup.NodeSelector, tt.want) {
+				t.Errorf("node selector = %v, want %v", pod.NodeSelector, tt.want)
+			}
+			if got := initContainerNames(pod); !slices.Equal(got, []string{warmupPullName}) || warmup.CachePath != "" {
+				t.Errorf("init containers = %v with cache %q, want only the image pull", got, warmup.CachePath)
+			}
+		})
+	}
+}
+
+func warmupPod(node string, statuses ...corev1.ContainerStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama-warmup-" + node, Labels: map[string]string{domain.LabelWarmup: "llama"}},
+		Spec:       corev1.PodSpec{NodeName: node},
+		Status:     corev1.PodStatus{InitContainerStatuses: statuses},
+	}
+}
+
+func pulled() corev1.ContainerStatus {
+	return corev1.ContainerStatus{Name: warmupPullName, State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}}
+}
+
+func TestWarmerProgress(t *testing.T) {
+	waiting := func(name, reason string, restarts int32) corev1.ContainerStatus {
+		return corev1.ContainerStatus{Name: name, RestartCount: restarts, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}}}
+	}
+	tests := []struct {
+		name        string
+		desired     int32
+		ready       int32
+		pods        []*corev1.Pod
+		wantPhase   domain.WarmupPhase
+		wantMessage string
+	}{
+		{"no nodes", 0, 0, nil, domain.WarmupPending, "no nodes match"},
+		{"pulling", 2, 0, []*corev1.Pod{
+			warmupPod("gpu-1", pulled()),
+			warmupPod("gpu-2", waiting(warmupPullName, "ErrImagePull", 1)),
+		}, domain.WarmupPullingImage, "pull-image on node gpu-2: ErrImagePull"},
+		{"copying", 2, 0, []*corev1.Pod{
+			warmupPod("gpu-1", pulled(), waiting(artifactInitName, "PodInitializing", 0)),
+			warmupPod("gpu-2", pulled()),
+		}, domain.WarmupCopyingWeights, ""},
+		{"completed", 2, 2, nil, domain.WarmupCompleted, "warmed 2 node(s)"},
+		{"failed", 2, 0, []*corev1.Pod{
+			warmupPod("gpu-1", pulled(), waiting(artifactInitName, "CrashLoopBackOff", warmupFailureRestarts)),
+		}, domain.WarmupFailed, artifactInitName + " failed on node gpu-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama-warmup"},
+				Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: tt.desired, NumberReady: tt.ready},
+			}
+			clientset := fake.NewSimpleClientset(ds)
+			for _, pod := range tt.pods {
+				if _, err := clientset.CoreV1().Pods("default").Create(t.Context(), pod, metav1.CreateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			warmup := &domain.Warmup{Namespace: "default", Name: "llama", Phase: domain.WarmupPending}
+			if err := NewWarmer(clientset, nil, nil).Progress(t.Context(), warmup); err != nil {
+				t.Fatal(err)
+			}
+			if warmup.Phase != tt.wantPhase || !strings.HasPrefix(warmup.Message, tt.wantMessage) {
+				t.Errorf("warm-up is %s (%q), want %s (%q)", warmup.Phase, warmup.Message, tt.wantPhase, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestWarmerProgressDaemonSetDeleted(t *testing.T) {
+	warmup := &domain.Warmup{Namespace: "default", Name: "llama", Phase: domain.WarmupPullingImage}
+	if err := NewWarmer(fake.NewSimpleClientset(), nil, nil).Progress(t.Context(), warmup); err != nil {
+		t.Fatal(err)
+	}
+	if warmup.Phase != domain.WarmupFailed {
+		t.Errorf("phase = %s, want Failed once the DaemonSet is gone", warmup.Phase)
+	}
+}
+
+func TestCleanerRemovesWarmup(t *testing.T) {
+	warmup := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama-warmup"}}
+	other := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "qwen-warmup"}}
+	clientset := fake.NewSimpleClientset(warmup, other)
+	cleaner := NewCleaner(clientset, NewWarmer(clientset, nil, nil), nil)
+	target := domain.CleanupTarget{Namespace: "default", Name: "llama", UID: "llama-uid", Deleting: true}
+
+	if err := cleaner.Cleanup(t.Context(), target); err != nil {
+		t.Fatalf("Cleanup() = %v", err)
+	}
+	daemonSets := clientset.AppsV1().DaemonSets("default")
+	if _, err := daemonSets.Get(t.Context(), "llama-warmup", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("warm-up DaemonSet still exists: %v", err)
+	}
+	if _, err := daemonSets.Get(t.Context(), "qwen-warmup", metav1.GetOptions{}); err != nil {
+		t.Errorf("another runtime's warm-up was removed: %v", err)
+	}
+	// A runtime that was never warmed up cleans up the same way.
+	if err := cleaner.Cleanup(t.Context(), target); err != nil {
+		t.Errorf("second Cleanup() = %v", err)
+	}
+}
@@ -0,0 +1,335 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	"connect-go/internal/core/tracing"
+	domain "connect-go/internal/core/vllm"
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+// runtimeContainerName is the engine container of a rendered Deployment.
+const runtimeContainerName = "vllm"
+
+// WorkloadSyncer renders the Deployment, Service and PodDisruptionBudget of a
+// VLLM resource and creates or updates them to match. Each object is named
+// after the resource, labelled with domain.LabelRuntime and controlled by the
+// resource, so Kubernetes garbage-collects it with the resource.
+type WorkloadSyncer struct {
+	clientset kubernetes.Interface
+}
+
+func NewWorkloadSyncer(clientset kubernetes.Interface) *WorkloadSyncer {
+	return &WorkloadSyncer{clientset: clientset}
+}
+
+// Sync brings the runtime's workload in line with v. An object of the same
+// name that v does not control is left alone and reported with
+// domain.ErrWorkloadNotOwned.
+func (s *WorkloadSyncer) Sync(ctx context.Context, v *vllmv1.VLLM) (err error) {
+	ctx, span := tracing.Start(ctx, "WorkloadSyncer.Sync", trace.WithAttributes(
+		attribute.String("vllm.namespace", v.Namespace),
+		attribute.String("vllm.resource", v.Name),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.syncDeployment(ctx, v, RenderDeployment(v)); err != nil {
+		return err
+	}
+	if err := s.syncService(ctx, v, RenderService(v)); err != nil {
+		return err
+	}
+	return s.syncPodDisruptionBudget(ctx, v, RenderPodDisruptionBudget(v))
+}
+
+func (s *WorkloadSyncer) syncDeployment(ctx context.Context, v *vllmv1.VLLM, desired *appsv1.Deployment) error {
+	client := s.clientset.AppsV1().Deployments(v.Namespace)
+	existing, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = client.Create(ctx, desired, metav1.CreateOptions{})
+		return wrapSyncErr("create", "Deployment", desired.Name, err)
+	}
+	if err != nil {
+		return wrapSyncErr("get", "Deployment", desired.Name, err)
+	}
+	if !metav1.IsControlledBy(existing, v) {
+		return fmt.Errorf("%w: Deployment %s/%s", domain.ErrWorkloadNotOwned, v.Namespace, desired.Name)
+	}
+	if upToDate(existing.Labels, desired.Labels) && equality.Semantic.DeepDerivative(desired.Spec, existing.Spec) {
+		return nil
+	}
+	updated := existing.DeepCopy()
+	maps.Copy(labelsOf(&updated.ObjectMeta), desired.Labels)
+	updated.Spec = desired.Spec
+	_, err = client.Update(ctx, updated, metav1.UpdateOptions{})
+	return wrapSyncErr("update", "Deployment", desired.Name, err)
+}
+
+func (s *WorkloadSyncer) syncService(ctx context.Context, v *vllmv1.VLLM, desired *corev1.Service) error {
+	client := s.clientset.CoreV1().Services(v.Namespace)
+	existing, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = client.Create(ctx, desired, metav1.CreateOptions{})
+		return wrapSyncErr("create", "Service", desired.Name, err)
+	}
+	if err != nil {
+		return wrapSyncErr("get", "Service", desired.Name, err)
+	}
+	if !metav1.IsControlledBy(existing, v) {
+		return fmt.Errorf("%w: Service %s/%s", domain.ErrWorkloadNotOwned, v.Namespace, desired.Name)
+	}
+	if upToDate(existing.Labels, desired.Labels) && equality.Semantic.DeepDerivative(desired.Spec, existing.Spec) {
+		return nil
+	}
+	updated := existing.DeepCopy()
+	maps.Copy(labelsOf(&updated.ObjectMeta), desired.Labels)
+	// The cluster IP is allocated by the API server and cannot change.
+	clusterIP, clusterIPs := updated.Spec.ClusterIP, updated.Spec.ClusterIPs
+	updated.Spec = desired.Spec
+	updated.Spec.ClusterIP, updated.Spec.ClusterIPs = clusterIP, clusterIPs
+	_, err = client.Update(ctx, updated, metav1.UpdateOptions{})
+	return wrapSyncErr("update", "Service", desired.Name, err)
+}
+
+func (s *WorkloadSyncer) syncPodDisruptionBudget(ctx context.Context, v *vllmv1.VLLM, desired *policyv1.PodDisruptionBudget) error {
+	client := s.clientset.PolicyV1().PodDisruptionBudgets(v.Namespace)
+	existing, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = client.Create(ctx, desired, metav1.CreateOptions{})
+		return wrapSyncErr("create", "PodDisruptionBudget", desired.Name, err)
+	}
+	if err != nil {
+		return wrapSyncErr("get", "PodDisruptionBudget", desired.Name, err)
+	}
+	if !metav1.IsControlledBy(existing, v) {
+		return fmt.Errorf("%w: PodDisruptionBudget %s/%s", domain.ErrWorkloadNotOwned, v.Namespace, desired.Name)
+	}
+	if upToDate(existing.Labels, desired.Labels) && equality.Semantic.DeepDerivative(desired.Spec, existing.Spec) {
+		return nil
+	}
+	updated := existing.DeepCopy()
+	maps.Copy(labelsOf(&updated.ObjectMeta), desired.Labels)
+	updated.Spec = desired.Spec
+	_, err = client.Update(ctx, updated, metav1.UpdateOptions{})
+	return wrapSyncErr("update", "PodDisruptionBudget", desired.Name, err)
+}
+
+func wrapSyncErr(verb, kind, name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("failed to %s %s %q: %w", verb, kind, name, err)
+}
+
+// upToDate reports whether have carries every label in want.
+func upToDate(have, want map[string]string) bool {
+	for k, v := range want {
+		if have[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func labelsOf(meta *metav1.ObjectMeta) map[string]string {
+	if meta.Labels == nil {
+		meta.Labels = map[string]string{}
+	}
+	return meta.Labels
+}
+
+// RenderDeployment builds the runtime Deployment: one engine container with
+// the spec's image, args, env, resources and volume mounts, scaled to zero
+// while the resource is stopped.
+func RenderDeployment(v *vllmv1.VLLM) *appsv1.Deployment {
+	spec, dc := v.Spec, v.Spec.DeploymentConfig
+	port := enginePort(v)
+
+	replicas := int32(1)
+	if spec.Replicas != nil {
+		replicas = *spec.Replicas
+	}
+	if spec.Action == vllmv1.ActionStop {
+		replicas = 0
+	}
+
+	var env []corev1.EnvVar
+	for _, e := range spec.VLLMConfig.Env {
+		env = append(env, corev1.EnvVar{Name: e.Name, Value: e.Value})
+	}
+	if spec.VLLMConfig.V1 {
+		env = append(env, corev1.EnvVar{Name: "VLLM_USE_V1", Value: "1"})
+	}
+
+	container := corev1.Container{
+		Name:            runtimeContainerName,
+		Image:           imageRef(dc.Image.Registry, dc.Image.Name),
+		ImagePullPolicy: dc.Image.PullPolicy,
+		Args:            engineArgs(v, port),
+		Env:             env,
+		Ports:           []corev1.ContainerPort{{Name: "http", ContainerPort: port, Protocol: corev1.ProtocolTCP}},
+		Resources:       dc.Resources,
+		VolumeMounts:    dc.VolumeMounts,
+		// Every integer is spelled out: the API server defaults zeros, and a
+		// zero here would then never match the live object.
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler:     corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/health", Port: intstr.FromString("http")}},
+			PeriodSeconds:    10,
+			TimeoutSeconds:   1,
+			SuccessThreshold: 1,
+			FailureThreshold: 3,
+		},
+	}
+	pod := corev1.PodSpec{
+		NodeSelector:   dc.NodeSelector,
+		InitContainers: dc.InitContainers,
+		Containers:     []corev1.Container{container},
+		Volumes:        dc.Volumes,
+	}
+	if q, ok := dc.Resources.Limits[domain.ResourceGPU]; ok && !q.IsZero() {
+		pod.Tolerations = []corev1.Toleration{{
+			Key:      domain.ResourceGPU,
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		}}
+	}
+
+	strategy := appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}
+	if dc.DeploymentStrategy == string(appsv1.RecreateDeploymentStrategyType) {
+		strategy.Type = appsv1.RecreateDeploymentStrategyType
+	}
+	labels := workloadLabels(v)
+	return &appsv1.Deployment{
+		ObjectMeta: workloadMeta(v),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: selectorOf(v)},
+			Strategy: strategy,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       pod,
+			},
+		},
+	}
+}
+
+// RenderService builds the ClusterIP Service in front of the runtime on
+// vllmConfig.port.
+func RenderService(v *vllmv1.VLLM) *corev1.Service {
+	port := enginePort(v)
+	return &corev1.Service{
+		ObjectMeta: workloadMeta(v),
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: selectorOf(v),
+			Ports: []corev1.ServicePort{{
+				Name:       "http",
+				Port:       port,
+				TargetPort: intstr.FromString("http"),
+				Protocol:   corev1.ProtocolTCP,
+			}},
+		},
+	}
+}
+
+// RenderPodDisruptionBudget lets voluntary disruptions take down at most one
+// runtime pod at a time.
+func RenderPodDisruptionBudget(v *vllmv1.VLLM) *policyv1.PodDisruptionBudget {
+	maxUnavailable := intstr.FromInt32(1)
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: workloadMeta(v),
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector:       &metav1.LabelSelector{MatchLabels: selectorOf(v)},
+		},
+	}
+}
+
+// engineArgs serves the resolved artifact when there is one, under the
+// requested model name, on port. Flags already in spec.args win.
+func engineArgs(v *vllmv1.VLLM, port int32) []string {
+	var args []string
+	if !hasFlag(v.Spec.Args, "--model") {
+		if v.Spec.ModelPath != "" {
+			args = append(args, "--model", v.Spec.ModelPath)
+			if !hasFlag(v.Spec.Args, "--served-model-name") {
+				args = append(args, "--served-model-name", v.Spec.Model)
+			}
+		} else {
+			args = append(args, "--model", v.Spec.Model)
+		}
+	}
+	if !hasFlag(v.Spec.Args, "--port") {
+		args = append(args, "--port", strconv.Itoa(int(port)))
+	}
+	return append(args, v.Spec.Args...)
+}
+
+func hasFlag(args []string, flag string) bool {
+	for _, arg := range args {
+		if arg == flag || strings.HasPrefix(arg, flag+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+func enginePort(v *vllmv1.VLLM) int32 {
+	if v.Spec.VLLMConfig.Port > 0 {
+		return v.Spec.VLLMConfig.Port
+	}
+	return domain.DefaultPort
+}
+
+func selectorOf(v *vllmv1.VLLM) map[string]string {
+	return map[string]string{domain.LabelRuntime: v.Name}
+}
+
+func workloadLabels(v *vllmv1.VLLM) map[string]string {
+	return map[string]string{
+		domain.LabelRuntime:            v.Name,
+		"app.kubernetes.io/name":       "vllm",
+		"app.kubernetes.io/instance":   v.Name,
+		"app.kubernetes.io/managed-by": "connect-go",
+	}
+}
+
+func workloadMeta(v *vllmv1.VLLM) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            v.Name,
+		Namespace:       v.Namespace,
+		Labels:          workloadLabels(v),
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(v, vllmv1.SchemeGroupVersion.WithKind("VLLM"))},
+	}
+}
+
+// ListVLLMs returns every VLLM resource in all namespaces that is not being
+// deleted, decoded into the typed API. Resources that do not decode are
+// skipped.
+func (a *VLLMAPI) ListVLLMs(ctx context.Context) ([]vllmv1.VLLM, error) {
+	list, err := a.listVLLMs(ctx, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	vllms := make([]vllmv1.VLLM, 0, len(list))
+	for _, v := range list {
+		if v.DeletionTimestamp == nil {
+			vllms = append(vllms, *v.DeepCopy())
+		}
+	}
+	return vllms, nil
+}
@@ -0,0 +1,162 @@
+package vllm
+
+import (
+	vllmv1 "connect-go/api/vllm/v1"
+	domain "connect-go/internal/core/vllm"
+	"errors"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func workloadVLLM() *vllmv1.VLLM {
+	replicas := int32(2)
+	return &vllmv1.VLLM{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama", UID: types.UID("llama-uid")},
+		Spec: vllmv1.VLLMSpec{
+			Model:      "meta-llama/Llama-3.1-8B",
+			ModelPath:  "/models/llama",
+			Replicas:   &replicas,
+			Args:       []string{"--max-model-len=4096"},
+			Action:     vllmv1.ActionStart,
+			VLLMConfig: vllmv1.VLLMConfig{Port: 8080, V1: true, Env: []vllmv1.EnvVar{{Name: "HF_HOME", Value: "/data"}}},
+			DeploymentConfig: vllmv1.DeploymentConfig{
+				Image:              vllmv1.Image{Registry: "docker.io", Name: "vllm/vllm-openai:v0.10.0"},
+				DeploymentStrategy: "Recreate",
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{domain.ResourceGPU: resource.MustParse("1")},
+				},
+			},
+		},
+	}
+}
+
+// writes returns the create and update actions the syncer sent.
+func writes(clientset *fake.Clientset) []string {
+	var verbs []string
+	for _, a := range clientset.Actions() {
+		if a.GetVerb() == "create" || a.GetVerb() == "update" {
+			verbs = append(verbs, a.GetVerb()+" "+a.GetResource().Resource)
+		}
+	}
+	return verbs
+}
+
+func TestWorkloadSyncerCreates(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	v := workloadVLLM()
+	if err := NewWorkloadSyncer(clientset).Sync(t.Context(), v); err != nil {
+		t.Fatal(err)
+	}
+
+	deploy, err := clientset.AppsV1().Deployments("default").Get(t.Context(), "llama", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !metav1.IsControlledBy(deploy, v) {
+		t.Errorf("Deployment owners = %+v, want controlled by llama", deploy.OwnerReferences)
+	}
+	if *deploy.Spec.Replicas != 2 || deploy.Spec.Strategy.Type != "Recreate" {
+		t.Errorf("replicas %d, strategy %q; want 2, Recreate", *deploy.Spec.Replicas, deploy.Spec.Strategy.Type)
+	}
+	container := deploy.Spec.Template.Spec.Containers[0]
+	wantArgs := []string{"--model", "/models/llama", "--served-model-name", "meta-llama/Llama-3.1-8B", "--port", "8080", "--max-model-len=4096"}
+	if !slices.Equal(container.Args, wantArgs) {
+		t.Errorf("args = %v, want %v", container.Args, wantArgs)
+	}
+	if container.Image != "docker.io/vllm/vllm-openai:v0.10.0" || container.Ports[0].ContainerPort != 8080 {
+		t.Errorf("image %q, port %d", container.Image, container.Ports[0].ContainerPort)
+	}
+	if !slices.Contains(container.Env, corev1.EnvVar{Name: "VLLM_USE_V1", Value: "1"}) {
+		t.Errorf("env = %v, want VLLM_USE_V1", container.Env)
+	}
+	if tolerations := deploy.Spec.Template.Spec.Tolerations; len(tolerations) != 1 || tolerations[0].Key != domain.ResourceGPU {
+		t.Errorf("tolerations = %+v, want the GPU taint", tolerations)
+	}
+
+	svc, err := clientset.CoreV1().Services("default").Get(t.Context(), "llama", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svc.Spec.Ports[0].Port != 8080 || svc.Spec.Selector[domain.LabelRuntime] != "llama" || !metav1.IsControlledBy(svc, v) {
+		t.Errorf("unexpected Service %+v", svc.Spec)
+	}
+	pdb, err := clientset.PolicyV1().PodDisruptionBudgets("default").Get(t.Context(), "llama", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pdb.Spec.MaxUnavailable.IntValue() != 1 || !metav1.IsControlledBy(pdb, v) {
+		t.Errorf("unexpected PodDisruptionBudget %+v", pdb.Spec)
+	}
+}
+
+func TestWorkloadSyncerUpdates(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	syncer := NewWorkloadSyncer(clientset)
+	v := workloadVLLM()
+	if err := syncer.Sync(t.Context(), v); err != nil {
+		t.Fatal(err)
+	}
+	// The API server allocates the cluster IP.
+	svc, _ := clientset.CoreV1().Services("default").Get(t.Context(), "llama", metav1.GetOptions{})
+	svc.Spec.ClusterIP, svc.Spec.ClusterIPs = "10.0.0.7", []string{"10.0.0.7"}
+	if _, err := clientset.CoreV1().Services("default").Update(t.Context(), svc, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	clientset.ClearActions()
+	if err := syncer.Sync(t.Context(), v); err != nil {
+		t.Fatal(err)
+	}
+	if w := writes(clientset); len(w) != 0 {
+		t.Errorf("an unchanged spec wrote %v", w)
+	}
+
+	v.Spec.Action = vllmv1.ActionStop
+	v.Spec.VLLMConfig.Port = 9000
+	clientset.ClearActions()
+	if err := syncer.Sync(t.Context(), v); err != nil {
+		t.Fatal(err)
+	}
+	if w, want := writes(clientset), []string{"update deployments", "update services"}; !slices.Equal(w, want) {
+		t.Errorf("writes = %v, want %v", w, want)
+	}
+	deploy, _ := clientset.AppsV1().Deployments("default").Get(t.Context(), "llama", metav1.GetOptions{})
+	if *deploy.Spec.Replicas != 0 {
+		t.Errorf("stopped runtime has %d replicas, want 0", *deploy.Spec.Replicas)
+	}
+	svc, _ = clientset.CoreV1().Services("default").Get(t.Context(), "llama", metav1.GetOptions{})
+	if svc.Spec.Ports[0].Port != 9000 || svc.Spec.ClusterIP != "10.0.0.7" {
+		t.Errorf("Service port %d, cluster IP %q; want 9000 and the allocated IP", svc.Spec.Ports[0].Port, svc.Spec.ClusterIP)
+	}
+}
+
+func TestWorkloadSyncerLeavesForeignObjects(t *testing.T) {
+	foreign := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "llama", Labels: map[string]string{"team": "a"}},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 80}}},
+	}
+	clientset := fake.NewSimpleClientset(foreign)
+	err := NewWorkloadSyncer(clientset).Sync(t.Context(), workloadVLLM())
+	if !errors.Is(err, domain.ErrWorkloadNotOwned) {
+		t.Fatalf("Sync = %v, want ErrWorkloadNotOwned", err)
+	}
+	for _, a := range clientset.Actions() {
+		if u, ok := a.(k8stesting.UpdateAction); ok && u.GetResource().Resource == "services" {
+			t.Errorf("foreign Service was updated")
+		}
+	}
+	svc, _ := clientset.CoreV1().Services("default").Get(t.Context(), "llama", metav1.GetOptions{})
+	if svc.Spec.Ports[0].Port != 80 {
+		t.Errorf("foreign Service port = %d, want 80", svc.Spec.Ports[0].Port)
+	}
+	if _, err := clientset.PolicyV1().PodDisruptionBudgets("default").Get(t.Context(), "llama", metav1.GetOptions{}); err == nil {
+		t.Error("PodDisruptionBudget was created after the Service conflict")
+	}
+}